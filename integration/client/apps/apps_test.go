@@ -13,6 +13,7 @@ import (
 	kclient "github.com/acorn-io/runtime/pkg/k8sclient"
 	"github.com/acorn-io/z"
 	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
 func TestAppStartStop(t *testing.T) {
@@ -465,6 +466,63 @@ func TestAppRun(t *testing.T) {
 	assert.Equal(t, "value", app.Spec.DeployArgs.Data["key"])
 }
 
+func TestAppRunDryRun(t *testing.T) {
+	helper.EnsureCRDs(t)
+	restConfig := helper.StartAPI(t)
+
+	ctx := helper.GetCTX(t)
+	kclient := helper.MustReturn(kclient.Default)
+	project := helper.TempProject(t, kclient)
+
+	imageID := client2.NewImage(t, project.Name)
+	imageID2 := client2.NewImage2(t, project.Name)
+
+	c, err := client.New(restConfig, "", project.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	app, err := c.AppRun(ctx, imageID, &client.AppRunOptions{
+		Name:   "dry-run",
+		DryRun: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "dry-run", app.Name)
+	assert.Equal(t, imageID, app.Spec.Image)
+
+	_, err = c.AppGet(ctx, "dry-run")
+	assert.True(t, apierrors.IsNotFound(err), "app was created by a dry run: %v", err)
+
+	// The app is validated even though it is not created
+	_, err = c.AppRun(ctx, imageID, &client.AppRunOptions{
+		Region: "does-not-exist",
+		DryRun: true,
+	})
+	assert.ErrorContains(t, err, "region does-not-exist is not supported")
+
+	app, err = c.AppRun(ctx, imageID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updated, err := c.AppUpdate(ctx, app.Name, &client.AppUpdateOptions{
+		Image:  imageID2,
+		DryRun: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, imageID2, updated.Spec.Image)
+
+	app, err = c.AppGet(ctx, app.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, imageID, app.Spec.Image)
+}
+
 func TestAppRunImageVariations(t *testing.T) {
 	helper.EnsureCRDs(t)
 	restConfig := helper.StartAPI(t)
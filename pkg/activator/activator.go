@@ -0,0 +1,52 @@
+package activator
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/typed"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/ports"
+)
+
+const (
+	// Name is the name of the activator deployment, service account and role in the namespace of an app
+	Name = "acorn-activator"
+
+	DefaultIdleTimeout = 5 * time.Minute
+
+	// basePort is the port the activator listens on for the first function, the other functions of the app get the
+	// ports after it in the order of their names
+	basePort = 8081
+)
+
+// Enabled returns true if the function is scaled from zero by the activator on request. Functions with a fixed scale and
+// functions of apps in dev mode keep running.
+func Enabled(app *v1.AppInstance, function v1.Container) bool {
+	return function.Scale == nil && !app.Status.GetDevMode()
+}
+
+// Ports returns the port the activator of the app listens on for each of the functions it activates.
+func Ports(app *v1.AppInstance) map[string]int32 {
+	result := map[string]int32{}
+	for _, entry := range typed.Sorted(app.Status.AppSpec.Functions) {
+		if ports.IsLinked(app, entry.Key) || !Enabled(app, entry.Value) {
+			continue
+		}
+		result[entry.Key] = basePort + int32(len(result))
+	}
+	return result
+}
+
+// IdleTimeout returns the amount of time a function receives no requests before it is scaled to zero.
+func IdleTimeout(cfg *apiv1.Config) (time.Duration, error) {
+	if cfg.FunctionIdleTimeout == nil || *cfg.FunctionIdleTimeout == "" {
+		return DefaultIdleTimeout, nil
+	}
+	timeout, err := time.ParseDuration(*cfg.FunctionIdleTimeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid function idle timeout [%s]: %w", *cfg.FunctionIdleTimeout, err)
+	}
+	return timeout, nil
+}
@@ -0,0 +1,44 @@
+package activator
+
+import (
+	"testing"
+	"time"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/z"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPorts(t *testing.T) {
+	app := &v1.AppInstance{
+		Status: v1.AppInstanceStatus{
+			EmbeddedAppStatus: v1.EmbeddedAppStatus{
+				AppSpec: v1.AppSpec{
+					Functions: map[string]v1.Container{
+						"b":     {},
+						"a":     {},
+						"fixed": {Scale: z.Pointer[int32](1)},
+					},
+				},
+			},
+		},
+	}
+	assert.Equal(t, map[string]int32{"a": 8081, "b": 8082}, Ports(app))
+
+	app.Status.DevSession = &v1.DevSessionInstanceSpec{}
+	assert.Empty(t, Ports(app))
+}
+
+func TestIdleTimeout(t *testing.T) {
+	timeout, err := IdleTimeout(&apiv1.Config{})
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultIdleTimeout, timeout)
+
+	timeout, err = IdleTimeout(&apiv1.Config{FunctionIdleTimeout: z.Pointer("30s")})
+	assert.NoError(t, err)
+	assert.Equal(t, 30*time.Second, timeout)
+
+	_, err = IdleTimeout(&apiv1.Config{FunctionIdleTimeout: z.Pointer("soon")})
+	assert.Error(t, err)
+}
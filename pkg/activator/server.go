@@ -0,0 +1,294 @@
+package activator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/ports"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	klabels "k8s.io/apimachinery/pkg/labels"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	// activationTimeout bounds how long a request waits for its function to become ready
+	activationTimeout = 2 * time.Minute
+	pollInterval      = 250 * time.Millisecond
+)
+
+// Server holds the requests for the functions of an app until their deployments are scaled up and ready, proxies them
+// to the ready replicas and scales the deployments to zero once they are idle. The pods of the functions are read
+// through pods, which is expected to be backed by an informer cache since it is listed on every request.
+type Server struct {
+	client      kclient.Client
+	pods        kclient.Reader
+	namespace   string
+	idleTimeout time.Duration
+	functions   map[string]*function
+}
+
+type function struct {
+	name string
+	port int32
+
+	lock        sync.Mutex
+	inFlight    int
+	lastRequest time.Time
+	next        int
+}
+
+func NewServer(c kclient.Client, pods kclient.Reader, namespace string, idleTimeout time.Duration, functionPorts map[string]int32) *Server {
+	s := &Server{
+		client:      c,
+		pods:        pods,
+		namespace:   namespace,
+		idleTimeout: idleTimeout,
+		functions:   map[string]*function{},
+	}
+	for name, port := range functionPorts {
+		s.functions[name] = &function{
+			name:        name,
+			port:        port,
+			lastRequest: time.Now(),
+		}
+	}
+	return s
+}
+
+// Start listens on the port of each function and scales idle functions to zero until the context is done.
+func (s *Server) Start(ctx context.Context) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	for _, f := range s.functions {
+		f := f
+		server := &http.Server{
+			Addr:              net.JoinHostPort("", strconv.Itoa(int(f.port))),
+			Handler:           s.handler(f),
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		eg.Go(func() error {
+			logrus.Infof("Activating function %s/%s on %s", s.namespace, f.name, server.Addr)
+			if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+				return err
+			}
+			return nil
+		})
+		eg.Go(func() error {
+			<-ctx.Done()
+			return server.Close()
+		})
+	}
+	eg.Go(func() error {
+		s.scaleIdleToZero(ctx)
+		return nil
+	})
+	return eg.Wait()
+}
+
+func (s *Server) handler(f *function) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		f.begin()
+		defer f.end()
+
+		target, err := s.activate(req.Context(), f)
+		if err != nil {
+			logrus.Errorf("Failed to activate function %s/%s: %v", s.namespace, f.name, err)
+			http.Error(rw, fmt.Sprintf("function %s is not available", f.name), http.StatusServiceUnavailable)
+			return
+		}
+
+		proxy := &httputil.ReverseProxy{
+			Rewrite: func(r *httputil.ProxyRequest) {
+				r.SetURL(target)
+				r.Out.Host = r.In.Host
+				r.SetXForwarded()
+			},
+		}
+		proxy.ServeHTTP(rw, req)
+	})
+}
+
+// activate returns the address of a ready replica of the function, scaling the function from zero and waiting for it
+// to be ready if it has none.
+func (s *Server) activate(ctx context.Context, f *function) (*url.URL, error) {
+	ctx, cancel := context.WithTimeout(ctx, activationTimeout)
+	defer cancel()
+
+	var (
+		start     = time.Now()
+		scaled    bool
+		scaledUp  bool
+		targetURL = func(address string) *url.URL {
+			port := ports.FunctionPortDefs(false)[0].TargetPort
+			return &url.URL{Scheme: "http", Host: net.JoinHostPort(address, strconv.Itoa(int(port)))}
+		}
+	)
+	for {
+		address, err := s.readyAddress(ctx, f)
+		if err != nil {
+			return nil, err
+		}
+		if address != "" {
+			if scaledUp {
+				s.recordColdStart(ctx, f, start, time.Since(start))
+			}
+			return targetURL(address), nil
+		}
+
+		if !scaled {
+			if scaledUp, err = s.scale(ctx, f, 1); err != nil {
+				return nil, err
+			}
+			scaled = true
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for a ready replica: %w", ctx.Err())
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+func (s *Server) readyAddress(ctx context.Context, f *function) (string, error) {
+	pods := &corev1.PodList{}
+	if err := s.pods.List(ctx, pods, &kclient.ListOptions{
+		Namespace: s.namespace,
+		LabelSelector: klabels.SelectorFromSet(map[string]string{
+			labels.AcornManaged:      "true",
+			labels.AcornFunctionName: f.name,
+		}),
+	}); err != nil {
+		return "", err
+	}
+
+	var addresses []string
+	for _, pod := range pods.Items {
+		if pod.DeletionTimestamp.IsZero() && pod.Status.PodIP != "" && podReady(pod) {
+			addresses = append(addresses, pod.Status.PodIP)
+		}
+	}
+	if len(addresses) == 0 {
+		return "", nil
+	}
+	// The cache doesn't list the pods in a stable order, sort them so that requests are spread round-robin
+	sort.Strings(addresses)
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.next = (f.next + 1) % len(addresses)
+	return addresses[f.next], nil
+}
+
+// scale sets the replicas of the deployment of the function, it returns false if the deployment already had replicas
+// when scaling up or had none when scaling down.
+func (s *Server) scale(ctx context.Context, f *function, replicas int32) (bool, error) {
+	dep := &appsv1.Deployment{}
+	if err := s.client.Get(ctx, kclient.ObjectKey{Namespace: s.namespace, Name: f.name}, dep); err != nil {
+		return false, err
+	}
+
+	current := int32(1)
+	if dep.Spec.Replicas != nil {
+		current = *dep.Spec.Replicas
+	}
+	if (replicas == 0) == (current == 0) {
+		return false, nil
+	}
+
+	patch := kclient.MergeFrom(dep.DeepCopy())
+	dep.Spec.Replicas = &replicas
+	if replicas == 0 {
+		dep.Annotations = labels.Merge(dep.Annotations, map[string]string{
+			labels.AcornScaledToZeroTime: time.Now().UTC().Format(time.RFC3339),
+		})
+	}
+	if err := s.client.Patch(ctx, dep, patch); err != nil {
+		return false, err
+	}
+
+	logrus.Infof("Scaled function %s/%s to %d", s.namespace, f.name, replicas)
+	return true, nil
+}
+
+func (s *Server) recordColdStart(ctx context.Context, f *function, start time.Time, duration time.Duration) {
+	dep := &appsv1.Deployment{}
+	if err := s.client.Get(ctx, kclient.ObjectKey{Namespace: s.namespace, Name: f.name}, dep); err != nil {
+		logrus.Warnf("Failed to record cold start of function %s/%s: %v", s.namespace, f.name, err)
+		return
+	}
+
+	patch := kclient.MergeFrom(dep.DeepCopy())
+	dep.Annotations = labels.Merge(dep.Annotations, map[string]string{
+		labels.AcornColdStartTime:     start.UTC().Format(time.RFC3339),
+		labels.AcornColdStartDuration: duration.Round(time.Millisecond).String(),
+	})
+	if err := s.client.Patch(ctx, dep, patch); err != nil {
+		logrus.Warnf("Failed to record cold start of function %s/%s: %v", s.namespace, f.name, err)
+	}
+}
+
+// scaleIdleToZero periodically scales the functions that have not received requests for the idle timeout to zero.
+func (s *Server) scaleIdleToZero(ctx context.Context) {
+	interval := min(max(s.idleTimeout/4, time.Second), 30*time.Second)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for _, f := range s.functions {
+			if !f.idle(s.idleTimeout) {
+				continue
+			}
+			if _, err := s.scale(ctx, f, 0); err != nil {
+				logrus.Errorf("Failed to scale function %s/%s to zero: %v", s.namespace, f.name, err)
+			}
+		}
+	}
+}
+
+func (f *function) begin() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.inFlight++
+	f.lastRequest = time.Now()
+}
+
+func (f *function) end() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.inFlight--
+	f.lastRequest = time.Now()
+}
+
+func (f *function) idle(timeout time.Duration) bool {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.inFlight == 0 && time.Since(f.lastRequest) >= timeout
+}
+
+func podReady(pod corev1.Pod) bool {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
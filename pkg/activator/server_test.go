@@ -0,0 +1,83 @@
+package activator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/acorn-io/z"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestActivate(t *testing.T) {
+	pollInterval = 10 * time.Millisecond
+	ctx := context.Background()
+
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(&appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "fn", Namespace: "app"},
+		Spec:       appsv1.DeploymentSpec{Replicas: z.Pointer[int32](0)},
+	}).Build()
+	s := NewServer(c, c, "app", time.Minute, map[string]int32{"fn": 8081})
+	f := s.functions["fn"]
+
+	// Start a ready replica once the function is scaled up
+	go func() {
+		for {
+			dep := &appsv1.Deployment{}
+			if err := c.Get(ctx, router.Key("app", "fn"), dep); err == nil && z.Dereference(dep.Spec.Replicas) == 1 {
+				_ = c.Create(ctx, &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fn-1",
+						Namespace: "app",
+						Labels:    map[string]string{labels.AcornManaged: "true", labels.AcornFunctionName: "fn"},
+					},
+					Status: corev1.PodStatus{
+						PodIP:      "10.0.0.1",
+						Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+					},
+				})
+				return
+			}
+			time.Sleep(pollInterval)
+		}
+	}()
+
+	target, err := s.activate(ctx, f)
+	require.NoError(t, err)
+	assert.Equal(t, "http://10.0.0.1:8080", target.String())
+
+	dep := &appsv1.Deployment{}
+	require.NoError(t, c.Get(ctx, router.Key("app", "fn"), dep))
+	assert.NotEmpty(t, dep.Annotations[labels.AcornColdStartTime])
+	_, err = time.ParseDuration(dep.Annotations[labels.AcornColdStartDuration])
+	assert.NoError(t, err)
+
+	// Not idle while a request is in flight
+	f.begin()
+	f.lastRequest = time.Now().Add(-time.Hour)
+	assert.False(t, f.idle(time.Minute))
+	f.end()
+	assert.False(t, f.idle(time.Minute))
+	f.lastRequest = time.Now().Add(-time.Hour)
+	assert.True(t, f.idle(time.Minute))
+
+	scaled, err := s.scale(ctx, f, 0)
+	require.NoError(t, err)
+	assert.True(t, scaled)
+	require.NoError(t, c.Get(ctx, router.Key("app", "fn"), dep))
+	assert.Equal(t, int32(0), z.Dereference(dep.Spec.Replicas))
+	assert.NotEmpty(t, dep.Annotations[labels.AcornScaledToZeroTime])
+
+	// Already scaled to zero
+	scaled, err = s.scale(ctx, f, 0)
+	require.NoError(t, err)
+	assert.False(t, scaled)
+}
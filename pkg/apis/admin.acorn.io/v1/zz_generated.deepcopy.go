@@ -7,6 +7,7 @@ package v1
 
 import (
 	internal_acorn_iov1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	internal_admin_acorn_iov1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -39,6 +40,11 @@ func (in *ClusterComputeClass) DeepCopyInto(out *ClusterComputeClass) {
 		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Price != nil {
+		in, out := &in.Price, &out.Price
+		*out = new(internal_admin_acorn_iov1.ComputeClassPrice)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterComputeClass.
@@ -166,6 +172,11 @@ func (in *ClusterVolumeClass) DeepCopyInto(out *ClusterVolumeClass) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Price != nil {
+		in, out := &in.Price, &out.Price
+		*out = new(internal_admin_acorn_iov1.VolumeClassPrice)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterVolumeClass.
@@ -305,6 +316,11 @@ func (in *ProjectComputeClass) DeepCopyInto(out *ProjectComputeClass) {
 		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Price != nil {
+		in, out := &in.Price, &out.Price
+		*out = new(internal_admin_acorn_iov1.ComputeClassPrice)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectComputeClass.
@@ -373,6 +389,11 @@ func (in *ProjectVolumeClass) DeepCopyInto(out *ProjectVolumeClass) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Price != nil {
+		in, out := &in.Price, &out.Price
+		*out = new(internal_admin_acorn_iov1.VolumeClassPrice)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectVolumeClass.
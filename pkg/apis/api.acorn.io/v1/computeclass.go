@@ -12,11 +12,12 @@ type ComputeClass struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
 
-	Memory           ComputeClassMemory           `json:"memory,omitempty"`
-	Resources        *corev1.ResourceRequirements `json:"resources,omitempty"`
-	Description      string                       `json:"description,omitempty"`
-	Default          bool                         `json:"default"`
-	SupportedRegions []string                     `json:"supportedRegions,omitempty"`
+	Memory           ComputeClassMemory                 `json:"memory,omitempty"`
+	Resources        *corev1.ResourceRequirements       `json:"resources,omitempty"`
+	Description      string                             `json:"description,omitempty"`
+	Default          bool                               `json:"default"`
+	SupportedRegions []string                           `json:"supportedRegions,omitempty"`
+	Price            *internaladminv1.ComputeClassPrice `json:"price,omitempty"`
 }
 
 type ComputeClassMemory struct {
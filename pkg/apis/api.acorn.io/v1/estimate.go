@@ -0,0 +1,94 @@
+package v1
+
+import (
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	internaladminv1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AppEstimate is the CPU, memory and volume storage an app needs, the monthly cost of those resources if the compute
+// and volume classes declare prices, and how the app fits in the quota of the project.
+type AppEstimate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Input Params
+	// Spec is the spec of the app to estimate. If not set, the existing app is estimated as it is deployed.
+	Spec *v1.AppInstanceSpec `json:"spec,omitempty"`
+
+	// Output Params
+	Workloads []WorkloadEstimate `json:"workloads,omitempty"`
+	Volumes   []VolumeEstimate   `json:"volumes,omitempty"`
+	Total     ResourceEstimate   `json:"total,omitempty"`
+	Quota     QuotaEstimate      `json:"quota,omitempty"`
+}
+
+// WorkloadEstimate is the resources of one container or sidecar. Jobs are not included because they do not run all
+// the time.
+type WorkloadEstimate struct {
+	Name         string `json:"name,omitempty"`
+	ComputeClass string `json:"computeClass,omitempty"`
+	// Replicas is the scale of the container, or the maximum replicas if it is autoscaled
+	Replicas int64 `json:"replicas,omitempty"`
+	// CPU is the millicores requested by each replica
+	CPU int64 `json:"cpu,omitempty"`
+	// Memory is the bytes of memory requested by each replica
+	Memory int64 `json:"memory,omitempty"`
+	// MonthlyCost is the cost of all the replicas, only set if the compute class has a price
+	MonthlyCost *float64 `json:"monthlyCost,omitempty"`
+}
+
+// VolumeEstimate is the storage of one volume. Volumes bound to existing volumes are not included.
+type VolumeEstimate struct {
+	Name        string `json:"name,omitempty"`
+	VolumeClass string `json:"volumeClass,omitempty"`
+	// Size is the bytes of storage of the volume
+	Size int64 `json:"size,omitempty"`
+	// MonthlyCost is the cost of the volume, only set if the volume class has a price
+	MonthlyCost *float64 `json:"monthlyCost,omitempty"`
+}
+
+// ResourceEstimate is the total resources of all the workloads and volumes of one or more apps.
+type ResourceEstimate struct {
+	// CPU is millicores
+	CPU int64 `json:"cpu,omitempty"`
+	// Memory is bytes
+	Memory int64 `json:"memory,omitempty"`
+	// Storage is bytes
+	Storage int64 `json:"storage,omitempty"`
+	// MonthlyCost is only set if at least one of the classes used has a price
+	MonthlyCost *float64 `json:"monthlyCost,omitempty"`
+}
+
+// Add adds the resources and cost of another estimate to this one.
+func (in *ResourceEstimate) Add(other ResourceEstimate) {
+	in.CPU += other.CPU
+	in.Memory += other.Memory
+	in.Storage += other.Storage
+	in.AddCost(other.MonthlyCost)
+}
+
+// AddCost adds a cost to the monthly cost of the estimate. A nil cost means the price is unknown and is ignored.
+func (in *ResourceEstimate) AddCost(cost *float64) {
+	if cost == nil {
+		return
+	}
+	if in.MonthlyCost == nil {
+		in.MonthlyCost = new(float64)
+	}
+	*in.MonthlyCost += *cost
+}
+
+// QuotaEstimate compares the resources an app requests against the quota allocated in its project.
+type QuotaEstimate struct {
+	// Enforced is true if the project has quota enforced. The other fields are only set if it is.
+	Enforced bool `json:"enforced,omitempty"`
+	// Requested is the quota the app would request
+	Requested internaladminv1.QuotaRequestResources `json:"requested,omitempty"`
+	// Allocated is the quota currently allocated to the app, zero if the app does not exist yet
+	Allocated internaladminv1.QuotaRequestResources `json:"allocated,omitempty"`
+	// ProjectAllocated is the quota currently allocated to all the apps in the project, including this one
+	ProjectAllocated internaladminv1.QuotaRequestResources `json:"projectAllocated,omitempty"`
+}
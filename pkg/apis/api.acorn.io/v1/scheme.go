@@ -30,7 +30,9 @@ func AddToSchemeWithGV(scheme *runtime.Scheme, schemeGroupVersion schema.GroupVe
 		&BuilderPortOptions{},
 		&BuilderList{},
 		&ConfirmUpgrade{},
+		&AppRollout{},
 		&AppPullImage{},
+		&AppEstimate{},
 		&IconOptions{},
 		&Image{},
 		&ImageList{},
@@ -74,6 +76,14 @@ func AddToSchemeWithGV(scheme *runtime.Scheme, schemeGroupVersion schema.GroupVe
 		&DevSession{},
 		&DevSessionList{},
 		&IgnoreCleanup{},
+		&AppRevision{},
+		&AppRevisionList{},
+		&VolumeSnapshot{},
+		&VolumeSnapshotList{},
+		&EventSubscription{},
+		&EventSubscriptionList{},
+		&SecretKey{},
+		&SecretKeyList{},
 	)
 
 	// Add common types
@@ -58,6 +58,7 @@ type ContainerReplicaStatus struct {
 	Columns              ContainerReplicaColumns `json:"columns,omitempty"`
 	State                corev1.ContainerState   `json:"state,omitempty"`
 	LastTerminationState corev1.ContainerState   `json:"lastState,omitempty"`
+	Usage                *v1.Usage               `json:"usage,omitempty"`
 	Ready                bool                    `json:"ready"`
 	RestartCount         int32                   `json:"restartCount"`
 	Image                string                  `json:"image"`
@@ -214,6 +215,17 @@ type ConfirmUpgrade struct {
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+type AppRollout struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Action            v1.RolloutAction `json:"action,omitempty"`
+	// Containers limits the action to the given containers. If empty, the action applies to all containers with a
+	// rollout in progress.
+	Containers []string `json:"containers,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 type IgnoreCleanup struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
@@ -521,8 +533,12 @@ type Config struct {
 	IngressControllerNamespace                 *string         `json:"ingressControllerNamespace" name:"ingress-controller-namespace" usage:"The namespace where the ingress controller runs - used to secure published HTTP ports with NetworkPolicies."`
 	AllowTrafficFromNamespace                  []string        `json:"allowTrafficFromNamespace" name:"allow-traffic-from-namespace" usage:"Namespaces that are allowed to send network traffic to all Acorn apps"`
 	ServiceLBAnnotations                       []string        `json:"serviceLBAnnotations" name:"service-lb-annotation" usage:"Annotation to add to the service of type LoadBalancer. Defaults to empty. (example key=value)"`
+	PublishBackend                             *string         `json:"publishBackend" name:"publish-backend" usage:"ingress|gateway. The backend used to publish ports. The gateway backend publishes ports with Gateway API resources instead of Ingresses and LoadBalancer Services (default ingress)"`
+	GatewayClassName                           *string         `json:"gatewayClassName" name:"gateway-class-name" usage:"The gateway class name to assign to all created Gateways when the publish backend is gateway (default '')"`
+	SecretBackendAllowedAddresses              []string        `json:"secretBackendAllowedAddresses" name:"secret-backend-allowed-address" usage:"Internal addresses or CIDRs that secret backends, like an in-cluster Vault, are allowed to connect to. Other internal addresses are refused"`
 	AWSIdentityProviderARN                     *string         `json:"awsIdentityProviderArn" name:"aws-identity-provider-arn" usage:"ARN of cluster's OpenID Connect provider registered in AWS"`
 	EventTTL                                   *string         `json:"eventTTL" name:"event-ttl" usage:"Amount of time an Acorn event will be stored before being deleted (default '168h' - 7 days)"`
+	FunctionIdleTimeout                        *string         `json:"functionIdleTimeout" name:"function-idle-timeout" usage:"Amount of time a function receives no requests before it is scaled to zero (default '5m')"`
 	Features                                   map[string]bool `json:"features" name:"features" boolmap:"true" usage:"Enable or disable features. (example foo=true,bar=false)"`
 	CertManagerIssuer                          *string         `json:"certManagerIssuer" name:"cert-manager-issuer" usage:"The name of the cert-manager cluster issuer to use for TLS certificates on custom domains" default:""`
 	Profile                                    *string         `json:"profile" name:"profile" usage:"The name of the profile to use for the installation. Profiles options are production (prod) and default. (default profile is default)"`
@@ -643,3 +659,60 @@ type DevSessionList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []DevSession `json:"items"`
 }
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type AppRevision v1.AppRevisionInstance
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type AppRevisionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AppRevision `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type VolumeSnapshot v1.VolumeSnapshotInstance
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type VolumeSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VolumeSnapshot `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type EventSubscription v1.EventSubscriptionInstance
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type EventSubscriptionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EventSubscription `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SecretKey is a key that encrypted data in a project can be decrypted with. The name of a SecretKey is its public key.
+type SecretKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Primary is true for the key that new data should be encrypted with
+	Primary bool `json:"primary,omitempty"`
+	// ReferencedBy is the names of the apps with encrypted data that can only be decrypted with this key
+	ReferencedBy []string `json:"referencedBy,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type SecretKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecretKey `json:"items"`
+}
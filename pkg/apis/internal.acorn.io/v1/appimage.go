@@ -29,6 +29,8 @@ type AppImageVersion struct {
 type VCS struct {
 	Remotes  []string `json:"remotes,omitempty"`
 	Revision string   `json:"revision,omitempty"`
+	// Branch the branch checked out in the vcs repository when building the running app, empty if HEAD was detached
+	Branch string `json:"branch,omitempty"`
 	// Clean a true value indicates the build contained no modified or untracked files according to git
 	Clean bool `json:"clean,omitempty"`
 	// Modified a true value indicates the build contained modified files according to git
@@ -4,6 +4,7 @@ package v1
 
 import (
 	"strings"
+	"time"
 
 	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
@@ -112,6 +113,58 @@ type AppInstanceSpec struct {
 	AutoUpgradeInterval     string           `json:"autoUpgradeInterval,omitempty"`
 	ComputeClasses          ComputeClassMap  `json:"computeClass,omitempty"`
 	Memory                  MemoryMap        `json:"memory,omitempty"`
+	Preview                 *AppPreview      `json:"preview,omitempty"`
+	Schedule                *AppSchedule     `json:"schedule,omitempty"`
+}
+
+// AppSchedule stops and starts the app on cron schedules, for example to stop it outside of working hours.
+type AppSchedule struct {
+	// Stop is the cron schedule the app is stopped on
+	Stop string `json:"stop,omitempty"`
+	// Start is the cron schedule the app is started on
+	Start string `json:"start,omitempty"`
+	// TimeZone is the IANA time zone the schedules are in, defaults to UTC
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+type AppScheduleAction string
+
+const (
+	AppScheduleActionStop  AppScheduleAction = "stop"
+	AppScheduleActionStart AppScheduleAction = "start"
+)
+
+type AppScheduleStatus struct {
+	// Schedule is the schedule of the app, or the default of the project, the transitions are computed from
+	Schedule AppSchedule `json:"schedule,omitempty"`
+	// LastAction is the last transition the schedule applied to the app
+	LastAction AppScheduleAction `json:"lastAction,omitempty"`
+	// LastTransition is when the last transition was scheduled
+	LastTransition metav1.Time `json:"lastTransition,omitempty"`
+	// NextAction is the next transition the schedule applies to the app
+	NextAction AppScheduleAction `json:"nextAction,omitempty"`
+	// NextTransition is when the next transition is scheduled
+	NextTransition metav1.Time `json:"nextTransition,omitempty"`
+}
+
+// AppPreview marks the app as the preview environment of a branch. Previews are removed once they expire.
+type AppPreview struct {
+	// Branch is the VCS branch the preview is running
+	Branch string `json:"branch,omitempty"`
+	// BaseApp is the app the bound secrets and volumes of the preview were cloned from
+	BaseApp string `json:"baseApp,omitempty"`
+	// TimeoutSeconds is how long after the preview was last deployed it expires, zero means never
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+	// RenewTime is when the preview was last deployed
+	RenewTime metav1.Time `json:"renewTime,omitempty"`
+}
+
+// ExpireTime returns when the preview expires, or the zero time if it never does.
+func (in *AppPreview) ExpireTime() time.Time {
+	if in.TimeoutSeconds <= 0 {
+		return time.Time{}
+	}
+	return in.RenewTime.Add(time.Duration(in.TimeoutSeconds) * time.Second)
 }
 
 // GetGrantedPermissions returns the permissions for the app as granted by the user or granted implicitly to the image.
@@ -196,6 +249,8 @@ type VolumeBinding struct {
 	Size        Quantity    `json:"size,omitempty"`
 	AccessModes AccessModes `json:"accessModes,omitempty"`
 	Class       string      `json:"class,omitempty"`
+	// Snapshot is the name of a volume snapshot the content of the volume is restored from
+	Snapshot string `json:"snapshot,omitempty"`
 }
 
 type AppColumns struct {
@@ -213,6 +268,7 @@ type AppInstanceStatus struct {
 
 type EmbeddedAppStatus struct {
 	DevSession             *DevSessionInstanceSpec `json:"devSession,omitempty"`
+	Schedule               *AppScheduleStatus      `json:"schedule,omitempty"`
 	ObservedGeneration     int64                   `json:"observedGeneration,omitempty"`
 	ObservedImageDigest    string                  `json:"observedImageDigest,omitempty"`
 	ObservedAutoUpgrade    bool                    `json:"observedAutoUpgrade,omitempty"`
@@ -0,0 +1,35 @@
+package v1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type AppRevisionInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AppRevisionInstance `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AppRevisionInstance is a snapshot of the spec and resolved image of an app at the time it was
+// successfully deployed. Revisions are used to roll an app back to a previous known-good state.
+type AppRevisionInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec AppRevisionInstanceSpec `json:"spec,omitempty"`
+}
+
+type AppRevisionInstanceSpec struct {
+	// AppName is the name of the app this revision belongs to
+	AppName string `json:"appName,omitempty"`
+	// Revision is a monotonically increasing number, starting at 1, for the app
+	Revision int64 `json:"revision,omitempty"`
+	// AppSpec is the spec of the app at this revision. The Stop field is never recorded.
+	AppSpec AppInstanceSpec `json:"appSpec,omitempty"`
+	// ImageID is the ID of the image the app was running at this revision
+	ImageID string `json:"imageID,omitempty"`
+	// ImageDigest is the digest of the image the app was running at this revision
+	ImageDigest string `json:"imageDigest,omitempty"`
+}
@@ -94,8 +94,9 @@ type PortBinding struct {
 	// The binding is ignored unless publish is also set to true (which is also deprecated)
 	Expose bool `json:"expose,omitempty"`
 	// Deprecated All ports are exposed by default
-	TargetPort        int32  `json:"targetPort,omitempty"`
-	TargetServiceName string `json:"targetServiceName,omitempty"`
+	TargetPort        int32       `json:"targetPort,omitempty"`
+	TargetServiceName string      `json:"targetServiceName,omitempty"`
+	Policy            *PortPolicy `json:"policy,omitempty"`
 }
 
 func (in PortBinding) Complete() PortBinding {
@@ -130,13 +131,35 @@ func (in PortDef) FormatString(serviceName string) string {
 }
 
 type PortDef struct {
-	Hostname   string   `json:"hostname,omitempty"`
-	Path       string   `json:"path,omitempty"`
-	Protocol   Protocol `json:"protocol,omitempty"`
-	Publish    bool     `json:"publish,omitempty"`
-	Dev        bool     `json:"dev,omitempty"`
-	Port       int32    `json:"port,omitempty"`
-	TargetPort int32    `json:"targetPort,omitempty"`
+	Hostname   string      `json:"hostname,omitempty"`
+	Path       string      `json:"path,omitempty"`
+	Protocol   Protocol    `json:"protocol,omitempty"`
+	Publish    bool        `json:"publish,omitempty"`
+	Dev        bool        `json:"dev,omitempty"`
+	Port       int32       `json:"port,omitempty"`
+	TargetPort int32       `json:"targetPort,omitempty"`
+	Policy     *PortPolicy `json:"policy,omitempty"`
+}
+
+// PortPolicy restricts who can reach a published HTTP port. It is enforced by the ingress controller the port is
+// published with.
+type PortPolicy struct {
+	Auth *PortAuth `json:"auth,omitempty"`
+	// AllowedCIDRs are the source IP ranges requests are accepted from, all sources are allowed if empty
+	AllowedCIDRs []string   `json:"allowedCIDRs,omitempty"`
+	RateLimit    *RateLimit `json:"rateLimit,omitempty"`
+}
+
+type PortAuth struct {
+	// BasicSecret is the name of a secret of type basic in the app whose username and password are required
+	BasicSecret string `json:"basicSecret,omitempty"`
+	// ForwardURL is the URL of a service, such as an OIDC proxy, that each request is forwarded to for authentication
+	ForwardURL string `json:"forwardURL,omitempty"`
+}
+
+type RateLimit struct {
+	RequestsPerSecond int32 `json:"requestsPerSecond,omitempty"`
+	Burst             int32 `json:"burst,omitempty"`
 }
 
 func (in PortDef) Complete() PortDef {
@@ -657,12 +680,32 @@ type Container struct {
 	// Scale is only available on containers, not sidecars or jobs
 	Scale *int32 `json:"scale,omitempty"`
 
+	// Autoscale is only available on containers, not sidecars or jobs. When set, Scale is ignored.
+	Autoscale *Autoscale `json:"autoscale,omitempty"`
+
+	// Rollout is only available on containers, not sidecars or jobs
+	Rollout *Rollout `json:"rollout,omitempty"`
+
 	// Schedule is only available on jobs
 	Schedule string `json:"schedule,omitempty"`
 
 	// Events is only available on jobs
 	Events []string `json:"events,omitempty"`
 
+	// Retries is only available on jobs, it is the number of times a failed job is retried
+	Retries *int32 `json:"retries,omitempty"`
+
+	// Backoff is only available on jobs, it is the delay before the first retry of a failed job, doubled for each
+	// retry after it. If empty the Kubernetes job controller backoff is used, or ten seconds if a timeout is set.
+	Backoff string `json:"backoff,omitempty"`
+
+	// Timeout is only available on jobs, it is how long each attempt of a job may run before it is failed. Jobs with a
+	// timeout are recreated for each attempt, so the timeout does not limit the total time of all attempts.
+	Timeout string `json:"timeout,omitempty"`
+
+	// ConcurrencyPolicy is only available on scheduled jobs
+	ConcurrencyPolicy JobConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
 	// Init is only available on sidecars
 	Init bool `json:"init,omitempty"`
 
@@ -671,6 +714,55 @@ type Container struct {
 
 	// InputSchema is only available on function
 	InputSchema *jsonschema.Schema `json:"inputSchema,omitempty"`
+
+	// OutputSchema is only available on jobs, it is the schema the named outputs of the job are validated against
+	OutputSchema *jsonschema.Schema `json:"outputSchema,omitempty"`
+}
+
+type JobConcurrencyPolicy string
+
+const (
+	JobConcurrencyPolicyAllow   JobConcurrencyPolicy = "allow"
+	JobConcurrencyPolicyForbid  JobConcurrencyPolicy = "forbid"
+	JobConcurrencyPolicyReplace JobConcurrencyPolicy = "replace"
+)
+
+type Autoscale struct {
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	MaxReplicas int32  `json:"maxReplicas,omitempty"`
+	// CPU is the target average CPU utilization, as a percentage of the requested CPU
+	CPU *int32 `json:"cpu,omitempty"`
+	// Memory is the target average memory utilization, as a percentage of the requested memory
+	Memory *int32 `json:"memory,omitempty"`
+	// Metric is a custom metric served by the metrics endpoint of the container
+	Metric *AutoscaleMetric `json:"metric,omitempty"`
+}
+
+type AutoscaleMetric struct {
+	Name string `json:"name,omitempty"`
+	// Target is the average value of the metric per replica, as a quantity such as "100" or "500m"
+	Target string `json:"target,omitempty"`
+}
+
+type RolloutStrategy string
+
+const (
+	RolloutStrategyCanary    RolloutStrategy = "canary"
+	RolloutStrategyBlueGreen RolloutStrategy = "blueGreen"
+)
+
+type Rollout struct {
+	Strategy RolloutStrategy `json:"strategy,omitempty"`
+	// Steps are only used by the canary strategy
+	Steps []RolloutStep `json:"steps,omitempty"`
+}
+
+type RolloutStep struct {
+	// Weight is the percentage of replicas, and therefore traffic, that run the new version during this step. The
+	// replicas of the container must be able to represent it, each version runs at least one replica.
+	Weight int32 `json:"weight,omitempty"`
+	// Pause is how long to wait before moving to the next step. If empty the rollout waits to be promoted.
+	Pause string `json:"pause,omitempty"`
 }
 
 type Image struct {
@@ -721,6 +813,68 @@ type Route struct {
 	TargetServiceName string   `json:"targetServiceName,omitempty"`
 	TargetPort        int      `json:"targetPort,omitempty"`
 	PathType          PathType `json:"pathType,omitempty"`
+
+	// Targets split the requests of the route across services by weight. When set, TargetServiceName and TargetPort
+	// are ignored.
+	Targets []RouteTarget `json:"targets,omitempty"`
+	// Match restricts the route to requests with the given headers, cookies and methods. Routes with the same path are
+	// matched in order and the first route that matches a request serves it.
+	Match *RouteMatch `json:"match,omitempty"`
+	// RequestHeaders modifies the headers of the requests sent to the targets
+	RequestHeaders *HeaderModifier `json:"requestHeaders,omitempty"`
+	// ResponseHeaders modifies the headers of the responses returned from the targets
+	ResponseHeaders *HeaderModifier `json:"responseHeaders,omitempty"`
+	// StripPrefix removes the path of the route from the requests sent to the targets
+	StripPrefix bool `json:"stripPrefix,omitempty"`
+}
+
+type RouteTarget struct {
+	TargetServiceName string `json:"targetServiceName,omitempty"`
+	TargetPort        int    `json:"targetPort,omitempty"`
+	// Weight is the share of the requests of the route sent to the target relative to the weights of the other targets
+	Weight int `json:"weight,omitempty"`
+}
+
+type RouteMatch struct {
+	Headers map[string]string `json:"headers,omitempty"`
+	Cookies map[string]string `json:"cookies,omitempty"`
+	Methods []string          `json:"methods,omitempty"`
+}
+
+type HeaderModifier struct {
+	Set    map[string]string `json:"set,omitempty"`
+	Remove []string          `json:"remove,omitempty"`
+}
+
+// GetTargets returns the targets of the route with their ports defaulted to 80. A route with a single target service
+// returns it with a weight of 100 and targets without weights share the requests equally.
+func (in Route) GetTargets() []RouteTarget {
+	targets := in.Targets
+	if len(targets) == 0 && in.TargetServiceName != "" {
+		targets = []RouteTarget{{TargetServiceName: in.TargetServiceName, TargetPort: in.TargetPort, Weight: 100}}
+	}
+
+	weighted := false
+	for _, target := range targets {
+		weighted = weighted || target.Weight > 0
+	}
+
+	result := make([]RouteTarget, 0, len(targets))
+	for _, target := range targets {
+		if target.TargetPort == 0 {
+			target.TargetPort = 80
+		}
+		if !weighted {
+			target.Weight = 1
+		}
+		result = append(result, target)
+	}
+	return result
+}
+
+// IsAdvanced returns true if the route does more than send the requests for its path to a single target.
+func (in Route) IsAdvanced() bool {
+	return len(in.Targets) > 0 || in.Match != nil || in.RequestHeaders != nil || in.ResponseHeaders != nil || in.StripPrefix
 }
 
 type Routes []Route
@@ -786,6 +940,14 @@ type VolumeRequest struct {
 	Class       string            `json:"class,omitempty"`
 	Size        Quantity          `json:"size,omitempty"`
 	AccessModes AccessModes       `json:"accessModes,omitempty"`
+	Backup      *VolumeBackup     `json:"backup,omitempty"`
+}
+
+type VolumeBackup struct {
+	// Schedule is the cron schedule snapshots of the volume are taken on
+	Schedule string `json:"schedule,omitempty"`
+	// Keep is the number of scheduled snapshots that are kept, older snapshots are deleted
+	Keep int32 `json:"keep,omitempty"`
 }
 
 // Workload to its memory
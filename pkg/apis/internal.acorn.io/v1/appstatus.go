@@ -19,6 +19,7 @@ type AppStatus struct {
 	Services   map[string]ServiceStatus   `json:"services,omitempty"`
 
 	Endpoints     []Endpoint `json:"endpoints,omitempty"`
+	Usage         *Usage     `json:"usage,omitempty"`
 	Stopped       bool       `json:"stopped,omitempty"`
 	Completed     bool       `json:"completed,omitempty"`
 	LoginRequired bool       `json:"loginRequired,omitempty"`
@@ -61,8 +62,12 @@ func (e *ExpressionError) String() string {
 type ReplicasSummary struct {
 	RunningCount           int
 	MaxReplicaRestartCount int32
-	TransitioningMessages  []string
-	ErrorMessages          []string
+	// DesiredCount and CurrentCount are the replicas the autoscaler wants and the replicas it last observed. They are
+	// only set for autoscaled containers.
+	DesiredCount          int32
+	CurrentCount          int32
+	TransitioningMessages []string
+	ErrorMessages         []string
 }
 
 type CommonSummary struct {
@@ -147,12 +152,124 @@ type ContainerStatus struct {
 	MaxReplicaRestartCount int32                       `json:"maxReplicaRestartCount,omitempty"`
 	Dependencies           map[string]DependencyStatus `json:"dependencies,omitempty"`
 	ExpressionErrors       []ExpressionError           `json:"expressionErrors,omitempty"`
+	Rollout                *RolloutStatus              `json:"rollout,omitempty"`
+	Autoscale              *AutoscaleStatus            `json:"autoscale,omitempty"`
+	Activation             *ActivationStatus           `json:"activation,omitempty"`
+	Usage                  *Usage                      `json:"usage,omitempty"`
+}
+
+// RepeatedOOMKills is the number of restarts at which a workload whose last restart was OOMKilled is reported. Kubernetes
+// only keeps the reason of the last termination, so the earlier restarts may have had other causes.
+const RepeatedOOMKills = 2
+
+// Usage is the CPU and memory used by the replicas of a container or job, or by all the workloads of an app, as reported
+// by the metrics.k8s.io API.
+type Usage struct {
+	// CPU is in millicores, Memory and MemoryLimit are in bytes. MemoryLimit is the sum of the limits of the containers
+	// that have one.
+	CPU         int64 `json:"cpu,omitempty"`
+	Memory      int64 `json:"memory,omitempty"`
+	MemoryLimit int64 `json:"memoryLimit,omitempty"`
+	// NearMemoryLimit is true if any container is using at least 90% of its memory limit
+	NearMemoryLimit bool `json:"nearMemoryLimit,omitempty"`
+	// OOMKilledRestarts is the number of restarts of the containers whose last termination was because they ran out of
+	// memory
+	OOMKilledRestarts int32       `json:"oomKilledRestarts,omitempty"`
+	Time              metav1.Time `json:"time,omitempty"`
+}
+
+// Add adds the usage of other to the usage, keeping the later time of the two.
+func (in *Usage) Add(other Usage) {
+	in.CPU += other.CPU
+	in.Memory += other.Memory
+	in.MemoryLimit += other.MemoryLimit
+	in.NearMemoryLimit = in.NearMemoryLimit || other.NearMemoryLimit
+	in.OOMKilledRestarts += other.OOMKilledRestarts
+	if in.Time.Before(&other.Time) {
+		in.Time = other.Time
+	}
+}
+
+func (in *Usage) RepeatedlyOOMKilled() bool {
+	return in != nil && in.OOMKilledRestarts >= RepeatedOOMKills
+}
+
+// ActivationStatus is the status of a function that is scaled from zero on request.
+type ActivationStatus struct {
+	// ScaledToZero is true while the function has no replicas
+	ScaledToZero     bool        `json:"scaledToZero,omitempty"`
+	ScaledToZeroTime metav1.Time `json:"scaledToZeroTime,omitempty"`
+	// LastColdStart is the time the last request that scaled the function from zero was received
+	LastColdStart metav1.Time `json:"lastColdStart,omitempty"`
+	// ColdStartLatency is the time the last request that scaled the function from zero waited for a ready replica
+	ColdStartLatency metav1.Duration `json:"coldStartLatency,omitempty"`
+}
+
+type AutoscaleStatus struct {
+	MinReplicas     int32 `json:"minReplicas,omitempty"`
+	MaxReplicas     int32 `json:"maxReplicas,omitempty"`
+	DesiredReplicas int32 `json:"desiredReplicas,omitempty"`
+	CurrentReplicas int32 `json:"currentReplicas,omitempty"`
 }
 
 func (in ContainerStatus) GetCommonStatus() CommonStatus {
 	return in.CommonStatus
 }
 
+type RolloutPhase string
+
+const (
+	RolloutPhaseProgressing RolloutPhase = "progressing"
+	RolloutPhasePaused      RolloutPhase = "paused"
+	RolloutPhasePromoting   RolloutPhase = "promoting"
+	RolloutPhaseAborted     RolloutPhase = "aborted"
+	RolloutPhaseCompleted   RolloutPhase = "completed"
+)
+
+const (
+	// RolloutTrackStable and RolloutTrackCanary are the values of the rollout-track label on the pods of a container
+	// that is being rolled out
+	RolloutTrackStable = "stable"
+	RolloutTrackCanary = "canary"
+)
+
+type RolloutAction string
+
+const (
+	RolloutActionPromote RolloutAction = "promote"
+	RolloutActionAbort   RolloutAction = "abort"
+)
+
+type RolloutStatus struct {
+	Strategy RolloutStrategy `json:"strategy,omitempty"`
+	Phase    RolloutPhase    `json:"phase,omitempty"`
+	// StableHash is the pod template hash of the version currently serving traffic
+	StableHash string `json:"stableHash,omitempty"`
+	// CanaryHash is the pod template hash of the version being rolled out
+	CanaryHash    string       `json:"canaryHash,omitempty"`
+	Step          int32        `json:"step,omitempty"`
+	Weight        int32        `json:"weight,omitempty"`
+	StepStartTime *metav1.Time `json:"stepStartTime,omitempty"`
+	// RequestedAction is set through the API and is consumed by the controller on the next reconcile
+	RequestedAction RolloutAction `json:"requestedAction,omitempty"`
+}
+
+func (in *RolloutStatus) InProgress() bool {
+	return in != nil && in.Phase != "" && in.Phase != RolloutPhaseCompleted && in.Phase != RolloutPhaseAborted
+}
+
+// ServiceTrack returns the track of pods that the service of the container should select. Only blue-green rollouts
+// restrict the service to one track, canary rollouts split traffic across both.
+func (in *RolloutStatus) ServiceTrack() string {
+	if !in.InProgress() || in.Strategy != RolloutStrategyBlueGreen {
+		return ""
+	}
+	if in.Phase == RolloutPhasePromoting {
+		return RolloutTrackCanary
+	}
+	return RolloutTrackStable
+}
+
 type JobStatus struct {
 	CommonStatus         `json:",inline"`
 	Schedule             string                      `json:"schedule,omitempty"`
@@ -169,6 +286,17 @@ type JobStatus struct {
 	Dependencies         map[string]DependencyStatus `json:"dependencies,omitempty"`
 	Skipped              bool                        `json:"skipped,omitempty"`
 	ExpressionErrors     []ExpressionError           `json:"expressionErrors,omitempty"`
+	// Step is the position of the job in the order the jobs of the app run in given their dependencies on each other,
+	// starting at 1, and Steps is the number of steps in that order
+	Step  int `json:"step,omitempty"`
+	Steps int `json:"steps,omitempty"`
+	// Attempt is the number of the current or last attempt to run the job, out of MaxAttempts
+	Attempt     int          `json:"attempt,omitempty"`
+	MaxAttempts int          `json:"maxAttempts,omitempty"`
+	NextAttempt *metav1.Time `json:"nextAttempt,omitempty"`
+	// Outputs are the named outputs of the last successful run of the job
+	Outputs map[string]string `json:"outputs,omitempty"`
+	Usage   *Usage            `json:"usage,omitempty"`
 }
 
 type DependencyStatus struct {
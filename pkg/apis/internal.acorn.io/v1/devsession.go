@@ -31,6 +31,8 @@ type DevSessionInstanceSpec struct {
 	SessionStartTime      metav1.Time              `json:"sessionStartTime,omitempty"`
 	SessionRenewTime      metav1.Time              `json:"sessionRenewTime,omitempty"`
 	SpecOverride          *AppInstanceSpec         `json:"specOverride,omitempty"`
+	// ExpireAction is applied to the app when the session expires without being released
+	ExpireAction *DevSessionInstanceExpireAction `json:"expireAction,omitempty"`
 }
 
 type DevSessionInstanceStatus struct {
@@ -49,5 +51,8 @@ type DevSessionImageSource struct {
 }
 
 type DevSessionInstanceExpireAction struct {
+	// Stop stops the app
 	Stop bool `json:"stop,omitempty"`
+	// Delete removes the app
+	Delete bool `json:"delete,omitempty"`
 }
@@ -0,0 +1,76 @@
+package v1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+type EventSubscriptionFormat string
+
+const (
+	// EventSubscriptionFormatAcorn payloads are the JSON of the event
+	EventSubscriptionFormatAcorn EventSubscriptionFormat = "acorn"
+	// EventSubscriptionFormatCloudEvents payloads are CloudEvents in the structured JSON format with the event as data
+	EventSubscriptionFormatCloudEvents EventSubscriptionFormat = "cloudevents"
+
+	// EventSubscriptionSigningSecretKey is the key of the signing secret that holds the HMAC key
+	EventSubscriptionSigningSecretKey = "key"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type EventSubscriptionInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EventSubscriptionInstance `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EventSubscriptionInstance delivers the events of a project that match its filters to an HTTP endpoint.
+type EventSubscriptionInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   EventSubscriptionInstanceSpec   `json:"spec,omitempty"`
+	Status EventSubscriptionInstanceStatus `json:"status,omitempty"`
+}
+
+type EventSubscriptionInstanceSpec struct {
+	// URL is the http or https endpoint events are POSTed to
+	URL string `json:"url,omitempty"`
+	// Types filters the events delivered by type, all types are delivered if empty
+	Types []string `json:"types,omitempty"`
+	// Severities filters the events delivered by severity, all severities are delivered if empty
+	Severities []EventSeverity `json:"severities,omitempty"`
+	// AppNames filters the events delivered by the app they are related to, all events are delivered if empty
+	AppNames []string `json:"appNames,omitempty"`
+	// SigningSecretName is the name of a secret in the project whose "key" is used to sign payloads with HMAC-SHA256
+	SigningSecretName string `json:"signingSecretName,omitempty"`
+	// Format is the format of the payload, acorn or cloudevents. The default is acorn.
+	Format EventSubscriptionFormat `json:"format,omitempty"`
+	// MaxRetries is the number of times the delivery of an event is retried before it is dead lettered. The default is 5.
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+}
+
+type EventSubscriptionInstanceStatus struct {
+	// LastObserved and LastEventName are the position of the last event that was delivered or dead lettered. Events
+	// observed before the subscription was created are not delivered.
+	LastObserved  MicroTime `json:"lastObserved,omitempty" wrangler:"type=string"`
+	LastEventName string    `json:"lastEventName,omitempty"`
+	// Delivered is the number of events delivered
+	Delivered int64 `json:"delivered,omitempty" column:"name=Delivered,jsonpath=.status.delivered"`
+	// LastDelivery is the time the last event was delivered
+	LastDelivery metav1.Time `json:"lastDelivery,omitempty"`
+	// Retry is the delivery that failed and is waiting to be retried
+	Retry *EventDelivery `json:"retry,omitempty"`
+	// DeadLetters are the most recent events that could not be delivered
+	DeadLetters []EventDelivery `json:"deadLetters,omitempty"`
+}
+
+// EventDelivery is a failed delivery of an event.
+type EventDelivery struct {
+	EventName   string      `json:"eventName,omitempty"`
+	EventType   string      `json:"eventType,omitempty"`
+	Attempts    int32       `json:"attempts,omitempty"`
+	LastAttempt metav1.Time `json:"lastAttempt,omitempty"`
+	NextAttempt metav1.Time `json:"nextAttempt,omitempty"`
+	Error       string      `json:"error,omitempty"`
+}
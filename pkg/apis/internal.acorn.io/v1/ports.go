@@ -230,13 +230,69 @@ func ParsePorts(args []string) (result []PortDef, _ error) {
 	return
 }
 
-func ParsePortBindings(args []string) (result []PortBinding, _ error) {
+// parsePortPolicy parses the comma separated options that can follow a port binding, for example
+// "basic-auth=creds,allow=10.0.0.0/8,allow=192.168.0.0/16,rate-limit=10,burst=20"
+func parsePortPolicy(opts string) (*PortPolicy, error) {
+	policy := &PortPolicy{}
+	for _, opt := range strings.Split(opts, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(opt), "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("invalid port option [%s] must be in the form key=value", opt)
+		}
+		switch key {
+		case "basic-auth":
+			if policy.Auth == nil {
+				policy.Auth = &PortAuth{}
+			}
+			policy.Auth.BasicSecret = value
+		case "forward-auth":
+			if policy.Auth == nil {
+				policy.Auth = &PortAuth{}
+			}
+			policy.Auth.ForwardURL = value
+		case "allow":
+			policy.AllowedCIDRs = append(policy.AllowedCIDRs, value)
+		case "rate-limit", "burst":
+			i, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid port option [%s]: %w", opt, err)
+			}
+			if policy.RateLimit == nil {
+				policy.RateLimit = &RateLimit{}
+			}
+			if key == "burst" {
+				policy.RateLimit.Burst = int32(i)
+			} else {
+				policy.RateLimit.RequestsPerSecond = int32(i)
+			}
+		default:
+			return nil, fmt.Errorf("invalid port option [%s], must be one of basic-auth, forward-auth, allow, rate-limit or burst", key)
+		}
+	}
+	return policy, nil
+}
+
+// joinPortOptions rejoins the options of port bindings with the binding they follow, they are split into separate
+// args when the bindings are passed as a comma separated list on the command line.
+func joinPortOptions(args []string) (result []string) {
 	for _, arg := range args {
+		if key, _, ok := strings.Cut(arg, "="); ok && len(result) > 0 && !strings.ContainsAny(key, ":/,") {
+			result[len(result)-1] += "," + arg
+			continue
+		}
+		result = append(result, arg)
+	}
+	return
+}
+
+func ParsePortBindings(args []string) (result []PortBinding, _ error) {
+	for _, arg := range joinPortOptions(args) {
 		var (
 			binding PortBinding
 			err     error
 		)
 
+		arg, opts, hasOpts := strings.Cut(arg, ",")
 		arg, proto, _ := strings.Cut(arg, "/")
 		parts := strings.Split(arg, ":")
 
@@ -272,6 +328,13 @@ func ParsePortBindings(args []string) (result []PortBinding, _ error) {
 			binding.Protocol = p
 		}
 
+		if hasOpts {
+			binding.Policy, err = parsePortPolicy(opts)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		result = append(result, binding)
 	}
 	return
@@ -47,6 +47,21 @@ func TestParseVolumes(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestParseVolumesWithSnapshot(t *testing.T) {
+	vs, err := ParseVolumes([]string{"data,snapshot=app-data-1234"}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, VolumeBinding{
+		Target:   "data",
+		Snapshot: "app-data-1234",
+	}, vs[0])
+
+	_, err = ParseVolumes([]string{"existing:data,snapshot=app-data-1234"}, true)
+	assert.Error(t, err)
+
+	_, err = ParseVolumes([]string{"data,snapshot=app-data-1234"}, false)
+	assert.Error(t, err)
+}
+
 func TestParseVolumesWithBinding(t *testing.T) {
 	input := []string{
 		"bar:bar",
@@ -176,6 +191,17 @@ func TestParsePorts(t *testing.T) {
 	}
 }
 
+func TestParsePortBindingsSplitOptions(t *testing.T) {
+	bindings, err := ParsePortBindings([]string{"example.com:web", "basic-auth=creds", "allow=10.0.0.0/8", "81:80"})
+	if assert.NoError(t, err) && assert.Len(t, bindings, 2) {
+		assert.Equal(t, &PortPolicy{
+			Auth:         &PortAuth{BasicSecret: "creds"},
+			AllowedCIDRs: []string{"10.0.0.0/8"},
+		}, bindings[0].Policy)
+		assert.Nil(t, bindings[1].Policy)
+	}
+}
+
 func TestParsePortBindings(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -286,6 +312,47 @@ func TestParsePortBindings(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			port: "example.com:bar:82,basic-auth=creds,allow=10.0.0.0/8,allow=192.168.1.1",
+			wantResult: PortBinding{
+				Protocol:          ProtocolHTTP,
+				TargetPort:        82,
+				Hostname:          "example.com",
+				TargetServiceName: "bar",
+				Policy: &PortPolicy{
+					Auth: &PortAuth{
+						BasicSecret: "creds",
+					},
+					AllowedCIDRs: []string{"10.0.0.0/8", "192.168.1.1"},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			port: "80/http,forward-auth=http://oauth2-proxy.auth/oauth2/auth,rate-limit=10,burst=20",
+			wantResult: PortBinding{
+				Protocol:   ProtocolHTTP,
+				TargetPort: 80,
+				Policy: &PortPolicy{
+					Auth: &PortAuth{
+						ForwardURL: "http://oauth2-proxy.auth/oauth2/auth",
+					},
+					RateLimit: &RateLimit{
+						RequestsPerSecond: 10,
+						Burst:             20,
+					},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			port:    "80,rate-limit=fast",
+			wantErr: assert.Error,
+		},
+		{
+			port:    "80,deny=10.0.0.0/8",
+			wantErr: assert.Error,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.port, func(t *testing.T) {
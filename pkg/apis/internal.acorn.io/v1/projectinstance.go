@@ -17,6 +17,60 @@ type ProjectInstance struct {
 type ProjectInstanceSpec struct {
 	DefaultRegion    string   `json:"defaultRegion,omitempty"`
 	SupportedRegions []string `json:"supportedRegions,omitempty"`
+	// SecretBackends are the backends that external secrets of the apps in the project are resolved from. A backend is
+	// selected by the scheme of the reference, for example "vault://path#key".
+	SecretBackends []SecretBackend `json:"secretBackends,omitempty"`
+	// DefaultAppSchedule stops and starts the apps in the project that don't have a schedule of their own
+	DefaultAppSchedule *AppSchedule `json:"defaultAppSchedule,omitempty"`
+}
+
+type SecretBackendType string
+
+const (
+	SecretBackendTypeVault             SecretBackendType = "vault"
+	SecretBackendTypeAWSSecretsManager SecretBackendType = "awsSecretsManager"
+	SecretBackendTypeFile              SecretBackendType = "file"
+)
+
+type SecretBackend struct {
+	// Name is the scheme used to reference the backend, defaults to the type
+	Name string            `json:"name,omitempty"`
+	Type SecretBackendType `json:"type,omitempty"`
+	// RefreshInterval is how often secrets resolved from the backend are refreshed, defaults to 5m
+	RefreshInterval   string                          `json:"refreshInterval,omitempty"`
+	Vault             *VaultSecretBackend             `json:"vault,omitempty"`
+	AWSSecretsManager *AWSSecretsManagerSecretBackend `json:"awsSecretsManager,omitempty"`
+	File              *FileSecretBackend              `json:"file,omitempty"`
+}
+
+func (in SecretBackend) GetName() string {
+	if in.Name == "" {
+		return string(in.Type)
+	}
+	return in.Name
+}
+
+type VaultSecretBackend struct {
+	Address string `json:"address,omitempty"`
+	// Mount is the path of the KV version 2 secrets engine, defaults to "secret"
+	Mount string `json:"mount,omitempty"`
+	// Namespace is the Vault Enterprise namespace
+	Namespace string `json:"namespace,omitempty"`
+	// TokenSecret is the name of a secret in the project with the Vault token in the "token" key
+	TokenSecret string `json:"tokenSecret,omitempty"`
+}
+
+type AWSSecretsManagerSecretBackend struct {
+	Region string `json:"region,omitempty"`
+	// CredentialsSecret is the name of a secret in the project with the "accessKeyID", "secretAccessKey" and optional
+	// "sessionToken" keys
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+}
+
+type FileSecretBackend struct {
+	// Directory is relative to the directory of the project, named after the project, in the secret backend directory
+	// mounted in the controller
+	Directory string `json:"directory,omitempty"`
 }
 
 type ProjectInstanceStatus struct {
@@ -22,6 +22,12 @@ func AddToScheme(scheme *runtime.Scheme) error {
 		&BuilderInstanceList{},
 		&AppInstance{},
 		&AppInstanceList{},
+		&AppRevisionInstance{},
+		&AppRevisionInstanceList{},
+		&VolumeSnapshotInstance{},
+		&VolumeSnapshotInstanceList{},
+		&EventSubscriptionInstance{},
+		&EventSubscriptionInstanceList{},
 		&ServiceInstance{},
 		&ServiceInstanceList{},
 		&ImageInstance{},
@@ -46,20 +46,24 @@ type ServiceConsumer struct {
 }
 
 type ServiceInstanceSpec struct {
-	Labels          map[string]string `json:"labels,omitempty"`
-	Annotations     map[string]string `json:"annotations,omitempty"`
-	Default         bool              `json:"default"`
-	External        string            `json:"external,omitempty"`
-	Alias           string            `json:"alias,omitempty"`
-	Address         string            `json:"address,omitempty"`
-	Ports           Ports             `json:"ports,omitempty"`
-	Container       string            `json:"container,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Default     bool              `json:"default"`
+	External    string            `json:"external,omitempty"`
+	Alias       string            `json:"alias,omitempty"`
+	Address     string            `json:"address,omitempty"`
+	Ports       Ports             `json:"ports,omitempty"`
+	Container   string            `json:"container,omitempty"`
+	// RolloutTrack restricts the service to the pods of one track of a blue-green rollout
+	RolloutTrack    string            `json:"rolloutTrack,omitempty"`
 	Function        string            `json:"function,omitempty"`
 	Job             string            `json:"job,omitempty"`
 	ContainerLabels map[string]string `json:"containerLabels,omitempty"`
 	Secrets         []string          `json:"secrets,omitempty"`
 	Data            *GenericMap       `json:"data,omitempty"`
 	Consumer        *ServiceConsumer  `json:"consumer,omitempty"`
+	// Activated services of functions route to the activator of the app, which scales the function from zero on request
+	Activated bool `json:"activated,omitempty"`
 
 	// Fields from app
 	AppName      string        `json:"appName,omitempty"`
@@ -70,11 +74,12 @@ type ServiceInstanceSpec struct {
 }
 
 type PortPublish struct {
-	Port       int32    `json:"port,omitempty"`
-	Protocol   Protocol `json:"protocol,omitempty"`
-	Hostname   string   `json:"hostname,omitempty"`
-	Path       string   `json:"path,omitempty"`
-	TargetPort int32    `json:"targetPort,omitempty"`
+	Port       int32       `json:"port,omitempty"`
+	Protocol   Protocol    `json:"protocol,omitempty"`
+	Hostname   string      `json:"hostname,omitempty"`
+	Path       string      `json:"path,omitempty"`
+	TargetPort int32       `json:"targetPort,omitempty"`
+	Policy     *PortPolicy `json:"policy,omitempty"`
 }
 
 func (in PortPublish) Complete() PortPublish {
@@ -27,9 +27,14 @@ var (
 )
 
 type routeTarget struct {
-	PathType          PathType `json:"pathType,omitempty"`
-	TargetPort        int      `json:"targetPort,omitempty"`
-	TargetServiceName string   `json:"targetServiceName,omitempty"`
+	PathType          PathType        `json:"pathType,omitempty"`
+	TargetPort        int             `json:"targetPort,omitempty"`
+	TargetServiceName string          `json:"targetServiceName,omitempty"`
+	Targets           []RouteTarget   `json:"targets,omitempty"`
+	Match             *RouteMatch     `json:"match,omitempty"`
+	RequestHeaders    *HeaderModifier `json:"requestHeaders,omitempty"`
+	ResponseHeaders   *HeaderModifier `json:"responseHeaders,omitempty"`
+	StripPrefix       bool            `json:"stripPrefix,omitempty"`
 }
 
 func (in *UserContext) UnmarshalJSON(data []byte) error {
@@ -76,6 +81,25 @@ func (in *UserContext) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (in *RouteTarget) UnmarshalJSON(data []byte) error {
+	if !isString(data) {
+		type routeTargetType RouteTarget
+		return json.Unmarshal(data, (*routeTargetType)(in))
+	}
+
+	s, err := parseString(data)
+	if err != nil {
+		return err
+	}
+	target := routeTarget{}
+	if err := target.UnmarshalJSON(data); err != nil {
+		return fmt.Errorf("failed to parse route target %s: %w", s, err)
+	}
+	in.TargetServiceName = target.TargetServiceName
+	in.TargetPort = target.TargetPort
+	return nil
+}
+
 func (in *routeTarget) UnmarshalJSON(data []byte) error {
 	if !isString(data) {
 		type routeTargetType routeTarget
@@ -118,6 +142,11 @@ func (in *Routes) UnmarshalJSON(data []byte) error {
 			TargetServiceName: v.TargetServiceName,
 			TargetPort:        v.TargetPort,
 			PathType:          v.PathType,
+			Targets:           v.Targets,
+			Match:             v.Match,
+			RequestHeaders:    v.RequestHeaders,
+			ResponseHeaders:   v.ResponseHeaders,
+			StripPrefix:       v.StripPrefix,
 		})
 	}
 	sort.Slice(routes, func(i, j int) bool {
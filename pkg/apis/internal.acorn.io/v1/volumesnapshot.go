@@ -0,0 +1,62 @@
+package v1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+type VolumeSnapshotMethod string
+
+const (
+	// VolumeSnapshotMethodCSI snapshots are CSI VolumeSnapshots of the volume
+	VolumeSnapshotMethodCSI VolumeSnapshotMethod = "csi"
+	// VolumeSnapshotMethodArchive snapshots are archives of the content of the volume pushed to the internal registry.
+	// They are used when the storage of the volume does not have a VolumeSnapshotClass.
+	VolumeSnapshotMethodArchive VolumeSnapshotMethod = "archive"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type VolumeSnapshotInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VolumeSnapshotInstance `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// VolumeSnapshotInstance is a point in time copy of the data of a volume that new volumes can be restored from.
+type VolumeSnapshotInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   VolumeSnapshotInstanceSpec   `json:"spec,omitempty"`
+	Status VolumeSnapshotInstanceStatus `json:"status,omitempty"`
+}
+
+type VolumeSnapshotInstanceSpec struct {
+	// Volume is the name of the PersistentVolume the snapshot is taken of
+	Volume string `json:"volume,omitempty"`
+	// AppName is the name of the app the volume belonged to when the snapshot was taken
+	AppName string `json:"appName,omitempty"`
+	// VolumeName is the name of the volume in the app
+	VolumeName string `json:"volumeName,omitempty"`
+}
+
+type VolumeSnapshotInstanceStatus struct {
+	Method VolumeSnapshotMethod `json:"method,omitempty" column:"name=Method,jsonpath=.status.method"`
+	// Ready is true once the snapshot can be restored from
+	Ready bool `json:"ready,omitempty" column:"name=Ready,jsonpath=.status.ready"`
+	// SourceNamespace is the namespace of the PersistentVolumeClaim the snapshot was taken from
+	SourceNamespace string `json:"sourceNamespace,omitempty"`
+	// SnapshotName is the name of the CSI VolumeSnapshot in the source namespace
+	SnapshotName string `json:"snapshotName,omitempty"`
+	// Driver is the CSI driver of the snapshot
+	Driver string `json:"driver,omitempty"`
+	// SnapshotContentName is the name of the CSI VolumeSnapshotContent that retains the snapshot
+	SnapshotContentName string `json:"snapshotContentName,omitempty"`
+	// SnapshotHandle is the handle of the snapshot in the CSI driver
+	SnapshotHandle string `json:"snapshotHandle,omitempty"`
+	// Artifact is the reference of the archive in the internal registry
+	Artifact string `json:"artifact,omitempty"`
+	// Size is the size of the volume the snapshot was taken of, used as the size of restored volumes
+	Size  Quantity `json:"size,omitempty"`
+	Error string   `json:"error,omitempty"`
+}
@@ -54,6 +54,7 @@ type ProjectComputeClassInstance struct {
 	PriorityClassName string                       `json:"priorityClassName,omitempty"`
 	RuntimeClassName  string                       `json:"runtimeClassName,omitempty"`
 	Resources         *corev1.ResourceRequirements `json:"resources,omitempty"`
+	Price             *ComputeClassPrice           `json:"price,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -71,3 +72,12 @@ type ComputeClassMemory struct {
 	RequestScaler float64  `json:"requestScaler,omitempty"`
 	Values        []string `json:"values,omitempty"`
 }
+
+// ComputeClassPrice is the optional unit price of the resources of a compute class, used to estimate the cost of apps.
+// Prices are per month in whatever currency the administrator chooses.
+type ComputeClassPrice struct {
+	// CPU is the price of one CPU core per month
+	CPU float64 `json:"cpu,omitempty"`
+	// Memory is the price of one GiB of memory per month
+	Memory float64 `json:"memory,omitempty"`
+}
@@ -12,13 +12,21 @@ type ProjectVolumeClassInstance struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	StorageClassName   string          `json:"storageClassName"`
-	Description        string          `json:"description"`
-	Default            bool            `json:"default,omitempty"`
-	AllowedAccessModes v1.AccessModes  `json:"allowedAccessModes,omitempty"`
-	Size               VolumeClassSize `json:"size,omitempty"`
-	Inactive           bool            `json:"inactive,omitempty"`
-	SupportedRegions   []string        `json:"supportedRegions,omitempty"`
+	StorageClassName   string            `json:"storageClassName"`
+	Description        string            `json:"description"`
+	Default            bool              `json:"default,omitempty"`
+	AllowedAccessModes v1.AccessModes    `json:"allowedAccessModes,omitempty"`
+	Size               VolumeClassSize   `json:"size,omitempty"`
+	Inactive           bool              `json:"inactive,omitempty"`
+	SupportedRegions   []string          `json:"supportedRegions,omitempty"`
+	Price              *VolumeClassPrice `json:"price,omitempty"`
+}
+
+// VolumeClassPrice is the optional unit price of the storage of a volume class, used to estimate the cost of apps.
+// Prices are per month in whatever currency the administrator chooses.
+type VolumeClassPrice struct {
+	// Storage is the price of one GiB of storage per month
+	Storage float64 `json:"storage,omitempty"`
 }
 
 type VolumeClassSize struct {
@@ -68,6 +68,11 @@ func (in *ClusterComputeClassInstance) DeepCopyInto(out *ClusterComputeClassInst
 		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Price != nil {
+		in, out := &in.Price, &out.Price
+		*out = new(ComputeClassPrice)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterComputeClassInstance.
@@ -195,6 +200,11 @@ func (in *ClusterVolumeClassInstance) DeepCopyInto(out *ClusterVolumeClassInstan
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Price != nil {
+		in, out := &in.Price, &out.Price
+		*out = new(VolumeClassPrice)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterVolumeClassInstance.
@@ -267,6 +277,21 @@ func (in *ComputeClassMemory) DeepCopy() *ComputeClassMemory {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComputeClassPrice) DeepCopyInto(out *ComputeClassPrice) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComputeClassPrice.
+func (in *ComputeClassPrice) DeepCopy() *ComputeClassPrice {
+	if in == nil {
+		return nil
+	}
+	out := new(ComputeClassPrice)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in ComputeClassResources) DeepCopyInto(out *ComputeClassResources) {
 	{
@@ -424,6 +449,11 @@ func (in *ProjectComputeClassInstance) DeepCopyInto(out *ProjectComputeClassInst
 		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Price != nil {
+		in, out := &in.Price, &out.Price
+		*out = new(ComputeClassPrice)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectComputeClassInstance.
@@ -492,6 +522,11 @@ func (in *ProjectVolumeClassInstance) DeepCopyInto(out *ProjectVolumeClassInstan
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Price != nil {
+		in, out := &in.Price, &out.Price
+		*out = new(VolumeClassPrice)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectVolumeClassInstance.
@@ -703,6 +738,21 @@ func (in *RoleRef) DeepCopy() *RoleRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeClassPrice) DeepCopyInto(out *VolumeClassPrice) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeClassPrice.
+func (in *VolumeClassPrice) DeepCopy() *VolumeClassPrice {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeClassPrice)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in VolumeClassResources) DeepCopyInto(out *VolumeClassResources) {
 	{
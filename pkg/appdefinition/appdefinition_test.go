@@ -193,6 +193,82 @@ routers: {
 	}, spec.Routers["foo"])
 }
 
+func TestParseAdvancedRoutes(t *testing.T) {
+	appImage, err := NewAppDefinition([]byte(`
+routers: {
+	api: {
+		routes: [
+			{
+				path: "/api"
+				targetServiceName: "canary"
+				"match": {
+					headers: "X-Canary": "true"
+					cookies: session: "beta"
+					methods: ["GET", "POST"]
+				}
+			},
+			{
+				path: "/api"
+				targets: [
+					{targetServiceName: "blue", weight: 90},
+					"green:8080",
+				]
+				requestHeaders: {
+					set: "X-Env": "prod"
+					remove: ["X-Debug"]
+				}
+				responseHeaders: remove: ["Server"]
+				stripPrefix: true
+			},
+		]
+	}
+}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spec, err := appImage.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, v1.Router{
+		Routes: []v1.Route{
+			{
+				Path:              "/api",
+				TargetServiceName: "canary",
+				PathType:          v1.PathTypePrefix,
+				Match: &v1.RouteMatch{
+					Headers: map[string]string{"X-Canary": "true"},
+					Cookies: map[string]string{"session": "beta"},
+					Methods: []string{"GET", "POST"},
+				},
+			},
+			{
+				Path:     "/api",
+				PathType: v1.PathTypePrefix,
+				Targets: []v1.RouteTarget{
+					{TargetServiceName: "blue", Weight: 90},
+					{TargetServiceName: "green", TargetPort: 8080},
+				},
+				RequestHeaders: &v1.HeaderModifier{
+					Set:    map[string]string{"X-Env": "prod"},
+					Remove: []string{"X-Debug"},
+				},
+				ResponseHeaders: &v1.HeaderModifier{
+					Remove: []string{"Server"},
+				},
+				StripPrefix: true,
+			},
+		},
+	}, spec.Routers["api"])
+
+	assert.Equal(t, []v1.RouteTarget{
+		{TargetServiceName: "blue", TargetPort: 80, Weight: 90},
+		{TargetServiceName: "green", TargetPort: 8080},
+	}, spec.Routers["api"].Routes[1].GetTargets())
+}
+
 func TestParse5GLiteralVolume(t *testing.T) {
 	appImage, err := NewAppDefinition([]byte(`
 volumes: {
@@ -918,6 +994,40 @@ containers: {
 	assert.Equal(t, appSpec.Containers["s"].Sidecars["right3"].Ports[1].Protocol, v1.Protocol(""))
 }
 
+func TestPortPolicy(t *testing.T) {
+	appImage, err := NewAppDefinition([]byte(`
+containers: admin: {
+  image: "x"
+  ports: publish: [{
+    port: 80
+    protocol: "http"
+    policy: {
+      auth: basicSecret: "admin-creds"
+      allowedCIDRs: ["10.0.0.0/8"]
+      rateLimit: requestsPerSecond: 10
+    }
+  }]
+}
+secrets: "admin-creds": type: "basic"
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := appImage.AppSpec()
+	if err != nil {
+		errors.Print(os.Stderr, err, nil)
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, &v1.PortPolicy{
+		Auth:         &v1.PortAuth{BasicSecret: "admin-creds"},
+		AllowedCIDRs: []string{"10.0.0.0/8"},
+		RateLimit:    &v1.RateLimit{RequestsPerSecond: 10},
+	}, appSpec.Containers["admin"].Ports[0].Policy)
+	assert.True(t, appSpec.Containers["admin"].Ports[0].Publish)
+}
+
 func TestPorts(t *testing.T) {
 	appImage, err := NewAppDefinition([]byte(`
 containers: {
@@ -1791,6 +1901,140 @@ containers: zero: scale: 0
 	assert.Equal(t, int32(0), *appSpec.Containers["zero"].Scale)
 }
 
+func TestRollout(t *testing.T) {
+	acornCue := `
+containers: none: {}
+containers: canary: rollout: steps: [{weight: 20, pause: "5m"}, {weight: 50}]
+containers: bluegreen: rollout: strategy: "blueGreen"
+`
+	def, err := NewAppDefinition([]byte(acornCue))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := def.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Nil(t, appSpec.Containers["none"].Rollout)
+	assert.Equal(t, &v1.Rollout{
+		Strategy: v1.RolloutStrategyCanary,
+		Steps: []v1.RolloutStep{
+			{Weight: 20, Pause: "5m"},
+			{Weight: 50},
+		},
+	}, appSpec.Containers["canary"].Rollout)
+	assert.Equal(t, &v1.Rollout{
+		Strategy: v1.RolloutStrategyBlueGreen,
+	}, appSpec.Containers["bluegreen"].Rollout)
+
+	_, err = NewAppDefinition([]byte(`containers: bad: rollout: steps: [{weight: 120}]`))
+	assert.Error(t, err)
+}
+
+func TestAutoscale(t *testing.T) {
+	acornCue := `
+containers: web: {
+	scale: 2
+	autoscale: {
+		minReplicas: 2
+		maxReplicas: 10
+		cpu: 80
+		metric: {
+			name: "http_requests_per_second"
+			target: "100"
+		}
+	}
+}
+`
+	def, err := NewAppDefinition([]byte(acornCue))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := def.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, &v1.Autoscale{
+		MinReplicas: z.Pointer[int32](2),
+		MaxReplicas: 10,
+		CPU:         z.Pointer[int32](80),
+		Metric: &v1.AutoscaleMetric{
+			Name:   "http_requests_per_second",
+			Target: "100",
+		},
+	}, appSpec.Containers["web"].Autoscale)
+
+	_, err = NewAppDefinition([]byte(`containers: bad: autoscale: minReplicas: 2`))
+	assert.Error(t, err)
+}
+
+func TestSecretRotation(t *testing.T) {
+	acornCue := `
+secrets: {
+	token: {
+		type: "token"
+		params: rotate: "720h"
+	}
+	creds: {
+		type: "basic"
+		params: {
+			rotate: "24h"
+			rotateGracePeriod: "2h"
+		}
+	}
+}
+`
+	def, err := NewAppDefinition([]byte(acornCue))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := def.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "720h", appSpec.Secrets["token"].Params.GetData()["rotate"])
+	assert.Equal(t, "24h", appSpec.Secrets["creds"].Params.GetData()["rotate"])
+	assert.Equal(t, "2h", appSpec.Secrets["creds"].Params.GetData()["rotateGracePeriod"])
+
+	_, err = NewAppDefinition([]byte(`secrets: bad: {type: "token", params: rotate: "monthly"}`))
+	assert.Error(t, err)
+}
+
+func TestVolumeBackup(t *testing.T) {
+	acornCue := `
+volumes: {
+	data: backup: schedule: "daily"
+	logs: backup: {
+		schedule: "0 */6 * * *"
+		keep: 2
+	}
+	cache: {}
+}
+`
+	def, err := NewAppDefinition([]byte(acornCue))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := def.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, &v1.VolumeBackup{Schedule: "daily", Keep: 7}, appSpec.Volumes["data"].Backup)
+	assert.Equal(t, &v1.VolumeBackup{Schedule: "0 */6 * * *", Keep: 2}, appSpec.Volumes["logs"].Backup)
+	assert.Nil(t, appSpec.Volumes["cache"].Backup)
+
+	_, err = NewAppDefinition([]byte(`volumes: data: backup: keep: 0`))
+	assert.Error(t, err)
+}
+
 func TestBuildProfileParameters(t *testing.T) {
 	acornCue := `
 args: {
@@ -2234,6 +2478,76 @@ jobs: foo: {
 	assert.Equal(t, "daily", appSpec.Jobs["foo"].Schedule)
 }
 
+func TestJobRetryPolicy(t *testing.T) {
+	acornCue := `
+jobs: {
+  migrate: {
+    image: "image"
+    retries: 3
+    backoff: "10s"
+    timeout: "5m"
+  }
+  report: {
+    image: "image"
+    schedule: "daily"
+    concurrencyPolicy: "forbid"
+    dependsOn: "migrate"
+  }
+}`
+
+	def, err := NewAppDefinition([]byte(acornCue))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := def.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, int32(3), *appSpec.Jobs["migrate"].Retries)
+	assert.Equal(t, "10s", appSpec.Jobs["migrate"].Backoff)
+	assert.Equal(t, "5m", appSpec.Jobs["migrate"].Timeout)
+	assert.Equal(t, v1.JobConcurrencyPolicyForbid, appSpec.Jobs["report"].ConcurrencyPolicy)
+	assert.Equal(t, v1.Dependencies{{TargetName: "migrate"}}, appSpec.Jobs["report"].Dependencies)
+
+	_, err = NewAppDefinition([]byte(`
+jobs: foo: {
+  image: "image"
+  backoff: "soon"
+}`))
+	if err == nil {
+		t.Fatal("expected invalid backoff to fail")
+	}
+}
+
+func TestJobOutputSchema(t *testing.T) {
+	acornCue := `
+jobs: migrate: {
+  image: "image"
+  outputSchema: define {
+    url: string
+    port: int
+  }
+}`
+
+	def, err := NewAppDefinition([]byte(acornCue))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	appSpec, err := def.AppSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schema := appSpec.Jobs["migrate"].OutputSchema
+	if assert.NotNil(t, schema) {
+		assert.Equal(t, "string", schema.Properties["url"].Type)
+		assert.Equal(t, "number", schema.Properties["port"].Type)
+	}
+}
+
 func TestInvalidPublishHostname(t *testing.T) {
 	acornCue := `
 containers: foo: {
@@ -0,0 +1,183 @@
+package appdiff
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/acorn-io/baaah/pkg/typed"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/imagedetails"
+	"github.com/acorn-io/runtime/pkg/ports"
+	"github.com/acorn-io/runtime/pkg/rulerequest"
+	"github.com/wI2L/jsondiff"
+)
+
+const (
+	OpAdd     = "add"
+	OpRemove  = "remove"
+	OpReplace = "replace"
+)
+
+// Change is one difference between a running app and the app it would be updated to. Path is the JSON pointer of the
+// changed field, and is empty for changes to sets like the permissions and endpoints of the app.
+type Change struct {
+	Op   string `json:"op"`
+	Path string `json:"path,omitempty"`
+	Old  any    `json:"old,omitempty"`
+	New  any    `json:"new,omitempty"`
+}
+
+// Diff is the difference between a running app and the app it would be updated to.
+type Diff struct {
+	Name        string   `json:"name"`
+	Image       *Change  `json:"image,omitempty"`
+	AppSpec     []Change `json:"appSpec,omitempty"`
+	DeployArgs  []Change `json:"deployArgs,omitempty"`
+	Permissions []Change `json:"permissions,omitempty"`
+	Endpoints   []Change `json:"endpoints,omitempty"`
+
+	// PermissionsNeedingConsent are the permissions requested by the new image, including its nested acorns and
+	// services, that are not granted to the app. Updating the app asks the user to consent to them.
+	PermissionsNeedingConsent []rulerequest.RuleRequest `json:"permissionsNeedingConsent,omitempty"`
+}
+
+// Empty returns true if updating the app changes nothing.
+func (d *Diff) Empty() bool {
+	return d.Image == nil &&
+		len(d.AppSpec) == 0 &&
+		len(d.DeployArgs) == 0 &&
+		len(d.Permissions) == 0 &&
+		len(d.Endpoints) == 0 &&
+		len(d.PermissionsNeedingConsent) == 0
+}
+
+// Compare returns the difference between the running app and the target it would be updated to. The details are those
+// of the target's image rendered with the target's deploy args and profiles.
+func Compare(app, target *apiv1.App, details *client.ImageDetails) (*Diff, error) {
+	if details.AppSpec == nil {
+		return nil, fmt.Errorf("image %s has no app spec", target.Spec.Image)
+	}
+
+	result := &Diff{
+		Name: app.Name,
+	}
+
+	if app.Spec.Image != target.Spec.Image {
+		result.Image = &Change{
+			Op:  OpReplace,
+			Old: app.Spec.Image,
+			New: target.Spec.Image,
+		}
+	}
+
+	var err error
+	if result.AppSpec, err = compare(app.Status.AppSpec, details.AppSpec); err != nil {
+		return nil, err
+	}
+	if result.DeployArgs, err = compare(deployArgs(app.Spec), deployArgs(target.Spec)); err != nil {
+		return nil, err
+	}
+
+	result.Permissions = compareSets(
+		rulerequest.ToRuleRequests(imagedetails.Permissions(&app.Status.AppSpec)),
+		rulerequest.ToRuleRequests(details.Permissions),
+		rulerequest.RuleRequest.String,
+	)
+
+	devMode := app.Status.GetDevMode()
+	result.Endpoints = compareSets(
+		endpoints(app.Spec, app.Status.AppSpec, devMode),
+		endpoints(target.Spec, *details.AppSpec, devMode),
+		func(endpoint string) string { return endpoint },
+	)
+
+	requested := details.Permissions
+	for _, nested := range details.NestedImages {
+		requested = append(requested, nested.Permissions...)
+	}
+	if missing, granted := v1.GrantsAll(app.Namespace, requested, target.Spec.GetGrantedPermissions()); !granted {
+		result.PermissionsNeedingConsent = rulerequest.ToRuleRequests(missing)
+	}
+
+	return result, nil
+}
+
+// compare returns the changes between the JSON of old and new.
+func compare(old, new any) ([]Change, error) {
+	patch, err := jsondiff.Compare(old, new)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Change
+	for _, op := range patch {
+		result = append(result, Change{
+			Op:   op.Type,
+			Path: op.Path.String(),
+			Old:  op.OldValue,
+			New:  op.Value,
+		})
+	}
+	return result, nil
+}
+
+// compareSets returns the elements added to and removed from a set, sorted by their key.
+func compareSets[T any](old, new []T, key func(T) string) (result []Change) {
+	oldByKey, newByKey := byKey(old, key), byKey(new, key)
+
+	for _, k := range typed.SortedKeys(oldByKey) {
+		if _, ok := newByKey[k]; !ok {
+			result = append(result, Change{Op: OpRemove, Old: oldByKey[k]})
+		}
+	}
+	for _, k := range typed.SortedKeys(newByKey) {
+		if _, ok := oldByKey[k]; !ok {
+			result = append(result, Change{Op: OpAdd, New: newByKey[k]})
+		}
+	}
+	return
+}
+
+func byKey[T any](values []T, key func(T) string) map[string]T {
+	result := make(map[string]T, len(values))
+	for _, v := range values {
+		result[key(v)] = v
+	}
+	return result
+}
+
+func deployArgs(spec v1.AppInstanceSpec) map[string]any {
+	if args := spec.DeployArgs.GetData(); args != nil {
+		return args
+	}
+	return map[string]any{}
+}
+
+// endpoints returns the ports of the containers of the app that are published, and where they are published to.
+func endpoints(spec v1.AppInstanceSpec, appSpec v1.AppSpec, devMode bool) (result []string) {
+	for _, entry := range typed.Sorted(appSpec.Containers) {
+		container := entry.Value
+		bound := ports.ApplyBindings(spec.PublishMode, ports.PortPublishForService(entry.Key, spec.Publish), ports.CollectContainerPorts(&container, devMode))
+		for listen, defs := range bound {
+			for _, port := range defs {
+				result = append(result, endpoint(entry.Key, listen, port))
+			}
+		}
+	}
+	sort.Strings(result)
+	return
+}
+
+func endpoint(name string, listen ports.ListenDef, port v1.PortDef) string {
+	target := fmt.Sprintf("%s:%d", name, port.Complete().TargetPort)
+	switch {
+	case listen.Hostname != "":
+		return fmt.Sprintf("%s://%s -> %s", listen.Protocol, listen.Hostname, target)
+	case listen.Port != 0:
+		return fmt.Sprintf("%s :%d -> %s", listen.Protocol, listen.Port, target)
+	default:
+		return fmt.Sprintf("%s generated hostname -> %s", listen.Protocol, target)
+	}
+}
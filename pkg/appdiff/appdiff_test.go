@@ -0,0 +1,115 @@
+package appdiff
+
+import (
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/rulerequest"
+	"github.com/stretchr/testify/assert"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func rbacRule(verb, resource string) rbacv1.PolicyRule {
+	return rbacv1.PolicyRule{
+		Verbs:     []string{verb},
+		APIGroups: []string{""},
+		Resources: []string{resource},
+	}
+}
+
+func testApp() *apiv1.App {
+	return &apiv1.App{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app",
+			Namespace: "acorn",
+		},
+		Spec: v1.AppInstanceSpec{
+			Image:      "foo:v1",
+			DeployArgs: v1.NewGenericMap(map[string]any{"replicas": float64(1)}),
+		},
+		Status: apiv1.AppStatus{
+			AppSpec: v1.AppSpec{
+				Containers: map[string]v1.Container{
+					"web": {
+						Image: "nginx",
+						Ports: []v1.PortDef{{Port: 80, Protocol: v1.ProtocolHTTP, Publish: true}},
+						Permissions: &v1.Permissions{
+							Rules: []v1.PolicyRule{{PolicyRule: rbacRule("get", "secrets")}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestCompare(t *testing.T) {
+	app := testApp()
+	target := app.DeepCopy()
+	target.Spec.Image = "foo:v2"
+	target.Spec.DeployArgs = v1.NewGenericMap(map[string]any{"replicas": float64(2)})
+
+	appSpec := app.Status.AppSpec.DeepCopy()
+	web := appSpec.Containers["web"]
+	web.Image = "nginx:latest"
+	web.Ports = []v1.PortDef{{Port: 5432, Protocol: v1.ProtocolTCP, Publish: true}}
+	web.Permissions = &v1.Permissions{
+		Rules: []v1.PolicyRule{{PolicyRule: rbacRule("list", "configmaps")}},
+	}
+	appSpec.Containers["web"] = web
+
+	webPermissions := []v1.Permissions{{
+		ServiceName: "web",
+		Rules:       []v1.PolicyRule{{PolicyRule: rbacRule("list", "configmaps")}},
+	}}
+
+	diff, err := Compare(app, target, &client.ImageDetails{
+		AppSpec:     appSpec,
+		Permissions: webPermissions,
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, &Change{Op: OpReplace, Old: "foo:v1", New: "foo:v2"}, diff.Image)
+	assert.Contains(t, diff.AppSpec, Change{Op: OpReplace, Path: "/containers/web/image", Old: "nginx", New: "nginx:latest"})
+	assert.Equal(t, []Change{{Op: OpReplace, Path: "/replicas", Old: float64(1), New: float64(2)}}, diff.DeployArgs)
+	assert.Equal(t, []Change{
+		{Op: OpRemove, Old: rulerequest.RuleRequest{Service: "web", Scope: "project", Verbs: "get", Resource: "secrets"}},
+		{Op: OpAdd, New: rulerequest.RuleRequest{Service: "web", Scope: "project", Verbs: "list", Resource: "configmaps"}},
+	}, diff.Permissions)
+	assert.Equal(t, []Change{
+		{Op: OpRemove, Old: "http generated hostname -> web:80"},
+		{Op: OpAdd, New: "tcp :5432 -> web:5432"},
+	}, diff.Endpoints)
+	assert.Equal(t, []rulerequest.RuleRequest{
+		{Service: "web", Scope: "project", Verbs: "list", Resource: "configmaps"},
+	}, diff.PermissionsNeedingConsent)
+	assert.False(t, diff.Empty())
+}
+
+func TestCompareUnchanged(t *testing.T) {
+	app := testApp()
+	app.Spec.GrantedPermissions = []v1.Permissions{{
+		ServiceName: "web",
+		Rules:       []v1.PolicyRule{{PolicyRule: rbacRule("get", "secrets")}},
+	}}
+
+	diff, err := Compare(app, app.DeepCopy(), &client.ImageDetails{
+		AppSpec:     app.Status.AppSpec.DeepCopy(),
+		Permissions: app.Spec.GrantedPermissions,
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, diff.Empty())
+}
+
+func TestCompareWithoutAppSpec(t *testing.T) {
+	app := testApp()
+	_, err := Compare(app, app, &client.ImageDetails{})
+	assert.Error(t, err)
+}
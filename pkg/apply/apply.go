@@ -0,0 +1,249 @@
+package apply
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/acorn-io/aml"
+	"github.com/acorn-io/baaah/pkg/typed"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+type Action string
+
+const (
+	ActionCreate    = Action("create")
+	ActionUpdate    = Action("update")
+	ActionDelete    = Action("delete")
+	ActionUnchanged = Action("unchanged")
+)
+
+// Manifest is the apps of a project that are deployed together, read from an AML file like
+//
+//	apps: {
+//		web: {
+//			image: "ghcr.io/acme/web:v1"
+//			deployArgs: replicas: 2
+//			links: ["db"]
+//		}
+//		db: image: "ghcr.io/acme/db:v1"
+//	}
+type Manifest struct {
+	Apps map[string]App `json:"apps,omitempty"`
+}
+
+// App is an app of a manifest. The bindings use the same syntax as the flags of 'acorn run'.
+type App struct {
+	Image          string         `json:"image,omitempty"`
+	DeployArgs     map[string]any `json:"deployArgs,omitempty"`
+	Profiles       []string       `json:"profiles,omitempty"`
+	Links          []string       `json:"links,omitempty"`
+	Secrets        []string       `json:"secrets,omitempty"`
+	Volumes        []string       `json:"volumes,omitempty"`
+	ComputeClasses []string       `json:"computeClasses,omitempty"`
+}
+
+// Step is what applying a manifest does to one app. Options are set for steps that create or update the app.
+type Step struct {
+	Action  Action
+	Name    string
+	Image   string
+	Options client.AppRunOptions
+	// Existing is the app before it is updated or deleted
+	Existing *apiv1.App
+}
+
+// ReadFile reads and validates the manifest in the AML file.
+func ReadFile(file string) (*Manifest, error) {
+	manifest := &Manifest{}
+	if err := aml.UnmarshalFile(file, manifest); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", file, err)
+	}
+	return manifest, manifest.Validate()
+}
+
+func (m *Manifest) Validate() error {
+	for _, name := range typed.SortedKeys(m.Apps) {
+		if errs := validation.IsDNS1035Label(name); len(errs) > 0 {
+			return fmt.Errorf("invalid app name %s: %s", name, strings.Join(errs, ","))
+		}
+		if m.Apps[name].Image == "" {
+			return fmt.Errorf("app %s has no image", name)
+		}
+		if _, err := m.Apps[name].ToRunOptions(name); err != nil {
+			return fmt.Errorf("app %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// ToRunOptions returns the options to create the app with. The app is labeled as applied, so it is pruned once it is
+// removed from the manifest.
+func (a App) ToRunOptions(name string) (opts client.AppRunOptions, err error) {
+	opts.Name = name
+	opts.DeployArgs = a.DeployArgs
+	opts.Profiles = a.Profiles
+	opts.Labels = []v1.ScopedLabel{{
+		ResourceType: v1.LabelTypeMeta,
+		Key:          labels.AcornApplied,
+		Value:        "true",
+	}}
+
+	if opts.Links, err = v1.ParseLinks(a.Links); err != nil {
+		return opts, err
+	}
+	if opts.Secrets, err = v1.ParseSecrets(a.Secrets); err != nil {
+		return opts, err
+	}
+	if opts.Volumes, err = v1.ParseVolumes(a.Volumes, true); err != nil {
+		return opts, err
+	}
+	if opts.ComputeClasses, err = v1.ParseComputeClass(a.ComputeClasses); err != nil {
+		return opts, err
+	}
+	return opts, nil
+}
+
+// Plan returns the steps that converge the apps of the project to the manifest, in the order they should be applied.
+// Apps are created and updated in order of their name, followed by the apps deleted. If prune is set, apps that were
+// applied before but are no longer in the manifest are deleted.
+func Plan(manifest *Manifest, apps []apiv1.App, prune bool) (result []Step, _ error) {
+	existing := map[string]*apiv1.App{}
+	for i := range apps {
+		existing[apps[i].Name] = &apps[i]
+	}
+
+	for _, name := range typed.SortedKeys(manifest.Apps) {
+		app := manifest.Apps[name]
+		opts, err := app.ToRunOptions(name)
+		if err != nil {
+			return nil, fmt.Errorf("app %s: %w", name, err)
+		}
+
+		step := Step{
+			Action:   ActionCreate,
+			Name:     name,
+			Image:    app.Image,
+			Options:  opts,
+			Existing: existing[name],
+		}
+		if step.Existing != nil {
+			step.Action = ActionUpdate
+			if same, err := isApplied(step.Existing, app.Image, opts); err != nil {
+				return nil, err
+			} else if same {
+				step.Action = ActionUnchanged
+			}
+		}
+		result = append(result, step)
+	}
+
+	if !prune {
+		return result, nil
+	}
+
+	for _, name := range typed.SortedKeys(existing) {
+		app := existing[name]
+		if _, ok := manifest.Apps[name]; ok || app.Labels[labels.AcornApplied] != "true" {
+			continue
+		}
+		result = append(result, Step{
+			Action:   ActionDelete,
+			Name:     name,
+			Image:    app.Spec.Image,
+			Existing: app,
+		})
+	}
+
+	return result, nil
+}
+
+// UpdateOptions returns the options that replace the fields of the existing app that the manifest declares, keeping
+// the rest, like published ports and granted permissions, as they are.
+func (s Step) UpdateOptions() client.AppUpdateOptions {
+	spec := s.Existing.Spec
+	return client.AppUpdateOptions{
+		Replace:             true,
+		Image:               s.Image,
+		DeployArgs:          s.Options.DeployArgs,
+		Profiles:            s.Options.Profiles,
+		Links:               s.Options.Links,
+		Secrets:             s.Options.Secrets,
+		Volumes:             s.Options.Volumes,
+		ComputeClasses:      s.Options.ComputeClasses,
+		Labels:              append(withoutApplied(spec.Labels), s.Options.Labels...),
+		Annotations:         spec.Annotations,
+		PublishMode:         spec.PublishMode,
+		Publish:             spec.Publish,
+		Env:                 spec.Environment,
+		Stop:                spec.Stop,
+		Permissions:         spec.GrantedPermissions,
+		AutoUpgrade:         spec.AutoUpgrade,
+		NotifyUpgrade:       spec.NotifyUpgrade,
+		AutoUpgradeInterval: spec.AutoUpgradeInterval,
+		Memory:              spec.Memory,
+		Region:              spec.Region,
+		Preview:             spec.Preview,
+		Schedule:            spec.Schedule,
+	}
+}
+
+func withoutApplied(scoped []v1.ScopedLabel) (result []v1.ScopedLabel) {
+	for _, label := range scoped {
+		if label.Key != labels.AcornApplied {
+			result = append(result, label)
+		}
+	}
+	return
+}
+
+// declared is the part of an app that a manifest declares.
+type declared struct {
+	Applied        bool                `json:"applied,omitempty"`
+	Image          string              `json:"image,omitempty"`
+	DeployArgs     map[string]any      `json:"deployArgs,omitempty"`
+	Profiles       []string            `json:"profiles,omitempty"`
+	Links          []v1.ServiceBinding `json:"links,omitempty"`
+	Secrets        []v1.SecretBinding  `json:"secrets,omitempty"`
+	Volumes        []v1.VolumeBinding  `json:"volumes,omitempty"`
+	ComputeClasses v1.ComputeClassMap  `json:"computeClasses,omitempty"`
+}
+
+// isApplied returns true if the app already is what the manifest declares.
+func isApplied(app *apiv1.App, image string, opts client.AppRunOptions) (bool, error) {
+	current, err := json.Marshal(declared{
+		Applied:        app.Labels[labels.AcornApplied] == "true",
+		Image:          app.Spec.Image,
+		DeployArgs:     app.Spec.DeployArgs.GetData(),
+		Profiles:       app.Spec.Profiles,
+		Links:          app.Spec.Links,
+		Secrets:        app.Spec.Secrets,
+		Volumes:        app.Spec.Volumes,
+		ComputeClasses: app.Spec.ComputeClasses,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	desired, err := json.Marshal(declared{
+		Applied:        true,
+		Image:          image,
+		DeployArgs:     opts.DeployArgs,
+		Profiles:       opts.Profiles,
+		Links:          opts.Links,
+		Secrets:        opts.Secrets,
+		Volumes:        opts.Volumes,
+		ComputeClasses: opts.ComputeClasses,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(current, desired), nil
+}
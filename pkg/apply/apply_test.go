@@ -0,0 +1,136 @@
+package apply
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testManifest = `
+apps: {
+	web: {
+		image: "ghcr.io/acme/web:v2"
+		deployArgs: replicas: 2
+		links: ["db"]
+		computeClasses: ["large"]
+	}
+	db: {
+		image: "ghcr.io/acme/db:v1"
+		secrets: ["db-creds:creds"]
+	}
+	cache: image: "ghcr.io/acme/cache:v1"
+}
+`
+
+func readManifest(t *testing.T, content string) (*Manifest, error) {
+	t.Helper()
+	file := filepath.Join(t.TempDir(), "project.acorn")
+	if err := os.WriteFile(file, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return ReadFile(file)
+}
+
+func appliedApp(name, image string) apiv1.App {
+	return apiv1.App{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{labels.AcornApplied: "true"},
+		},
+		Spec: v1.AppInstanceSpec{
+			Image: image,
+		},
+	}
+}
+
+func TestReadFile(t *testing.T) {
+	manifest, err := readManifest(t, testManifest)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, App{
+		Image:          "ghcr.io/acme/web:v2",
+		DeployArgs:     map[string]any{"replicas": float64(2)},
+		Links:          []string{"db"},
+		ComputeClasses: []string{"large"},
+	}, manifest.Apps["web"])
+	assert.Len(t, manifest.Apps, 3)
+
+	_, err = readManifest(t, `apps: Web: image: "foo"`)
+	assert.ErrorContains(t, err, "invalid app name Web")
+
+	_, err = readManifest(t, `apps: web: deployArgs: replicas: 2`)
+	assert.ErrorContains(t, err, "app web has no image")
+}
+
+func TestPlan(t *testing.T) {
+	manifest, err := readManifest(t, testManifest)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	db := appliedApp("db", "ghcr.io/acme/db:v1")
+	db.Spec.Secrets = []v1.SecretBinding{{Secret: "db-creds", Target: "creds"}}
+
+	apps := []apiv1.App{
+		appliedApp("web", "ghcr.io/acme/web:v1"),
+		db,
+		appliedApp("old", "ghcr.io/acme/old:v1"),
+		// Not created by apply, so never pruned
+		{ObjectMeta: metav1.ObjectMeta{Name: "manual"}},
+	}
+
+	steps, err := Plan(manifest, apps, false)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var actions []string
+	for _, step := range steps {
+		actions = append(actions, string(step.Action)+" "+step.Name)
+	}
+	assert.Equal(t, []string{"create cache", "unchanged db", "update web"}, actions)
+
+	steps, err = Plan(manifest, apps, true)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Len(t, steps, 4)
+	assert.Equal(t, ActionDelete, steps[3].Action)
+	assert.Equal(t, "old", steps[3].Name)
+
+	// Updating replaces what the manifest declares and keeps the rest
+	web := steps[2]
+	web.Existing.Spec.Publish = []v1.PortBinding{{Hostname: "web.example.com"}}
+	opts := web.UpdateOptions()
+	assert.True(t, opts.Replace)
+	assert.Equal(t, "ghcr.io/acme/web:v2", opts.Image)
+	assert.Equal(t, map[string]any{"replicas": float64(2)}, opts.DeployArgs)
+	assert.Equal(t, v1.ComputeClassMap{"": "large"}, opts.ComputeClasses)
+	assert.Equal(t, []v1.PortBinding{{Hostname: "web.example.com"}}, opts.Publish)
+	assert.Equal(t, web.Options.Labels, opts.Labels)
+}
+
+func TestPlanAdoptsExistingApp(t *testing.T) {
+	manifest := &Manifest{Apps: map[string]App{
+		"cache": {Image: "ghcr.io/acme/cache:v1"},
+	}}
+
+	// An app created by 'acorn run' is updated to label it as applied, even if it already matches the manifest
+	steps, err := Plan(manifest, []apiv1.App{{
+		ObjectMeta: metav1.ObjectMeta{Name: "cache"},
+		Spec:       v1.AppInstanceSpec{Image: "ghcr.io/acme/cache:v1"},
+	}}, true)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Len(t, steps, 1)
+	assert.Equal(t, ActionUpdate, steps[0].Action)
+}
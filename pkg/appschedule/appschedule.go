@@ -0,0 +1,78 @@
+package appschedule
+
+import (
+	"fmt"
+	"time"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	cronv3 "github.com/robfig/cron/v3"
+)
+
+// maxCatchUp bounds how many missed transitions are skipped to find the latest one that is due
+const maxCatchUp = 10000
+
+// Schedule is a parsed AppSchedule.
+type Schedule struct {
+	stop, start cronv3.Schedule
+	location    *time.Location
+}
+
+// Parse parses the cron schedules of the app schedule in its time zone.
+func Parse(schedule v1.AppSchedule) (*Schedule, error) {
+	if schedule.Stop == "" && schedule.Start == "" {
+		return nil, fmt.Errorf("schedule must have a stop or start schedule")
+	}
+
+	var (
+		result = &Schedule{location: time.UTC}
+		err    error
+	)
+	if schedule.TimeZone != "" {
+		if result.location, err = time.LoadLocation(schedule.TimeZone); err != nil {
+			return nil, fmt.Errorf("invalid time zone [%s]: %w", schedule.TimeZone, err)
+		}
+	}
+	if schedule.Stop != "" {
+		if result.stop, err = cronv3.ParseStandard(schedule.Stop); err != nil {
+			return nil, fmt.Errorf("invalid stop schedule [%s]: %w", schedule.Stop, err)
+		}
+	}
+	if schedule.Start != "" {
+		if result.start, err = cronv3.ParseStandard(schedule.Start); err != nil {
+			return nil, fmt.Errorf("invalid start schedule [%s]: %w", schedule.Start, err)
+		}
+	}
+	return result, nil
+}
+
+// Next returns the first transition after t and the action applied on it.
+func (s *Schedule) Next(t time.Time) (v1.AppScheduleAction, time.Time) {
+	t = t.In(s.location)
+
+	var (
+		action v1.AppScheduleAction
+		next   time.Time
+	)
+	if s.stop != nil {
+		action, next = v1.AppScheduleActionStop, s.stop.Next(t)
+	}
+	if s.start != nil {
+		if start := s.start.Next(t); next.IsZero() || start.Before(next) {
+			action, next = v1.AppScheduleActionStart, start
+		}
+	}
+	return action, next
+}
+
+// Latest returns the last transition up to now, given a transition at t that is due. Transitions missed in between, for
+// example while the controller was down, are skipped.
+func (s *Schedule) Latest(action v1.AppScheduleAction, t, now time.Time) (v1.AppScheduleAction, time.Time) {
+	for i := 0; i < maxCatchUp; i++ {
+		nextAction, next := s.Next(t)
+		if next.IsZero() || next.After(now) {
+			break
+		}
+		action, t = nextAction, next
+	}
+	return action, t
+}
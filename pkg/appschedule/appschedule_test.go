@@ -0,0 +1,62 @@
+package appschedule
+
+import (
+	"testing"
+	"time"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	_, err := Parse(v1.AppSchedule{Stop: "0 20 * * 1-5", Start: "0 7 * * 1-5", TimeZone: "America/Chicago"})
+	assert.NoError(t, err)
+
+	_, err = Parse(v1.AppSchedule{})
+	assert.Error(t, err)
+	_, err = Parse(v1.AppSchedule{Stop: "at night"})
+	assert.Error(t, err)
+	_, err = Parse(v1.AppSchedule{Start: "0 7 * * *", TimeZone: "Mars/Olympus_Mons"})
+	assert.Error(t, err)
+}
+
+func TestNext(t *testing.T) {
+	schedule, err := Parse(v1.AppSchedule{Stop: "0 20 * * 1-5", Start: "0 7 * * 1-5", TimeZone: "America/Chicago"})
+	require.NoError(t, err)
+	chicago, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+
+	// Friday afternoon
+	now := time.Date(2023, 6, 2, 15, 0, 0, 0, chicago)
+	action, next := schedule.Next(now.UTC())
+	assert.Equal(t, v1.AppScheduleActionStop, action)
+	assert.True(t, time.Date(2023, 6, 2, 20, 0, 0, 0, chicago).Equal(next))
+
+	// Friday night, the app starts again on Monday
+	action, next = schedule.Next(next)
+	assert.Equal(t, v1.AppScheduleActionStart, action)
+	assert.True(t, time.Date(2023, 6, 5, 7, 0, 0, 0, chicago).Equal(next))
+
+	// Only stopping
+	schedule, err = Parse(v1.AppSchedule{Stop: "@daily"})
+	require.NoError(t, err)
+	action, next = schedule.Next(now)
+	assert.Equal(t, v1.AppScheduleActionStop, action)
+	assert.True(t, time.Date(2023, 6, 3, 0, 0, 0, 0, time.UTC).Equal(next))
+}
+
+func TestLatest(t *testing.T) {
+	schedule, err := Parse(v1.AppSchedule{Stop: "0 20 * * *", Start: "0 7 * * *"})
+	require.NoError(t, err)
+
+	due := time.Date(2023, 6, 1, 20, 0, 0, 0, time.UTC)
+	action, latest := schedule.Latest(v1.AppScheduleActionStop, due, due.Add(time.Hour))
+	assert.Equal(t, v1.AppScheduleActionStop, action)
+	assert.Equal(t, due, latest)
+
+	// The start in the morning was missed too
+	action, latest = schedule.Latest(v1.AppScheduleActionStop, due, due.Add(14*time.Hour))
+	assert.Equal(t, v1.AppScheduleActionStart, action)
+	assert.Equal(t, time.Date(2023, 6, 2, 7, 0, 0, 0, time.UTC), latest)
+}
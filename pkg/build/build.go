@@ -21,6 +21,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/buildclient"
 	images2 "github.com/acorn-io/runtime/pkg/images"
 	"github.com/acorn-io/runtime/pkg/k8sclient"
+	"github.com/acorn-io/runtime/pkg/tracing"
 	"github.com/containerd/containerd/platforms"
 	"github.com/google/go-containerregistry/pkg/authn"
 	imagename "github.com/google/go-containerregistry/pkg/name"
@@ -29,6 +30,7 @@ import (
 	"github.com/google/uuid"
 	client2 "github.com/moby/buildkit/client"
 	"github.com/opencontainers/go-digest"
+	"go.opentelemetry.io/otel/attribute"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -53,7 +55,10 @@ type buildContext struct {
 	messages       buildclient.Messages
 }
 
-func Build(ctx context.Context, messages buildclient.Messages, pushRepo, buildNamespace string, opts v1.AcornImageBuildInstanceSpec, keychain authn.Keychain, remoteOpts ...remote.Option) (*v1.AppImage, error) {
+func Build(ctx context.Context, messages buildclient.Messages, pushRepo, buildNamespace string, opts v1.AcornImageBuildInstanceSpec, keychain authn.Keychain, remoteOpts ...remote.Option) (_ *v1.AppImage, err error) {
+	ctx, span := tracing.Start(ctx, "build acorn image", attribute.String("acorn.namespace", buildNamespace))
+	defer func() { tracing.End(span, err) }()
+
 	remoteKc := NewRemoteKeyChain(ctx, messages, keychain)
 	buildContext := &buildContext{
 		ctx:            buildkit.WithContextCacheKey(ctx, opts.ContextCacheKey),
@@ -69,6 +74,13 @@ func Build(ctx context.Context, messages buildclient.Messages, pushRepo, buildNa
 	return build(buildContext)
 }
 
+// withContext returns a copy of the build context that uses ctx, like for the span of a nested build.
+func (b *buildContext) withContext(ctx context.Context) *buildContext {
+	result := *b
+	result.ctx = ctx
+	return &result
+}
+
 func build(ctx *buildContext) (*v1.AppImage, error) {
 	var (
 		acornfileData []byte
@@ -627,6 +639,13 @@ func fromBuild(ctx *buildContext, buildCache *buildCache, build v1.Build) (id st
 		build.Context = "."
 	}
 
+	spanCtx, span := tracing.Start(ctx.ctx, "build image",
+		attribute.String("acorn.build.context", build.Context),
+		attribute.String("acorn.build.dockerfile", build.Dockerfile),
+	)
+	defer func() { tracing.End(span, err) }()
+	ctx = ctx.withContext(spanCtx)
+
 	if build.BaseImage != "" || len(build.ContextDirs) > 0 {
 		return buildWithContext(ctx, build)
 	}
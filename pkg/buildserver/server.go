@@ -15,6 +15,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/imagesystem"
 	"github.com/acorn-io/runtime/pkg/k8schannel"
 	"github.com/acorn-io/runtime/pkg/pullsecret"
+	"github.com/acorn-io/runtime/pkg/tracing"
 	"github.com/sirupsen/logrus"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -117,6 +118,7 @@ func retryOnConflict(f func() error) error {
 }
 
 func (s *Server) build(ctx context.Context, messages buildclient.Messages, token *Token) (*v1.AppImage, error) {
+	ctx = tracing.Extract(ctx, &token.Build)
 	if err := retryOnConflict(func() error {
 		return s.recordBuildStart(ctx, &token.Build)
 	}); err != nil {
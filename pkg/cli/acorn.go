@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
@@ -8,6 +9,7 @@ import (
 
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/client/term"
+	"github.com/acorn-io/runtime/pkg/tracing"
 	"github.com/google/go-containerregistry/pkg/logs"
 	"github.com/pterm/pterm"
 	"github.com/sirupsen/logrus"
@@ -33,8 +35,10 @@ func New() *cobra.Command {
 		StdIn:  nil,
 	}
 	root.AddCommand(
+		NewActivator(),
 		NewAll(cmdContext),
 		NewAPIServer(cmdContext),
+		NewApply(cmdContext),
 		NewBuild(cmdContext),
 		NewBuildServer(),
 		NewCheck(cmdContext),
@@ -43,14 +47,19 @@ func New() *cobra.Command {
 		NewController(cmdContext),
 		NewCredential(cmdContext),
 		NewDev(cmdContext),
+		NewDiff(cmdContext),
 		NewEdit(cmdContext),
 		NewRender(cmdContext),
 		NewExec(cmdContext),
 		NewPortForward(cmdContext),
+		NewMetrics(cmdContext),
+		NewEstimate(cmdContext),
 		NewEvent(cmdContext),
+		NewExport(cmdContext),
 		NewFmt(cmdContext),
 		NewImage(cmdContext),
 		NewImageCopy(cmdContext),
+		NewImport(cmdContext),
 		NewInstall(cmdContext),
 		NewOfferings(cmdContext),
 		NewUninstall(cmdContext),
@@ -63,6 +72,8 @@ func New() *cobra.Command {
 		NewPull(cmdContext),
 		NewPush(cmdContext),
 		NewRm(cmdContext),
+		NewRollback(cmdContext),
+		NewRollout(cmdContext),
 		NewRun(cmdContext),
 		NewUpdate(cmdContext),
 		NewSecret(cmdContext),
@@ -100,7 +111,7 @@ func setEnv(key, value string) error {
 	return nil
 }
 
-func (a *Acorn) PersistentPre(_ *cobra.Command, _ []string) error {
+func (a *Acorn) PersistentPre(cmd *cobra.Command, _ []string) error {
 	// If --kubeconfig is used set it to KUBECONFIG env (if env is unset) so that all
 	// kubeconfig file looks will find it
 	if err := setEnv("KUBECONFIG", a.Kubeconfig); err != nil {
@@ -133,6 +144,39 @@ func (a *Acorn) PersistentPre(_ *cobra.Command, _ []string) error {
 		}
 	}
 
+	return startTracing(cmd)
+}
+
+// serverCommands are the commands that run the services of acorn, which export their traces as their own service.
+var serverCommands = map[string]bool{
+	"api-server":   true,
+	"controller":   true,
+	"build-server": true,
+}
+
+// startTracing exports traces to the endpoint in the ACORN_OTLP_ENDPOINT environment variable, if it is set. Every other
+// command is traced as one span that the requests it makes to the API server are children of.
+func startTracing(cmd *cobra.Command) error {
+	endpoint := os.Getenv(tracing.EndpointEnv)
+	serviceName := "acorn"
+	if serverCommands[cmd.Name()] {
+		serviceName += "-" + cmd.Name()
+	}
+
+	shutdown, err := tracing.Init(cmd.Context(), serviceName, endpoint)
+	if err != nil {
+		return err
+	}
+	if endpoint == "" || serverCommands[cmd.Name()] {
+		return nil
+	}
+
+	ctx, span := tracing.Start(cmd.Context(), cmd.CommandPath())
+	cmd.SetContext(ctx)
+	cobra.OnFinalize(func() {
+		span.End()
+		_ = shutdown(context.Background())
+	})
 	return nil
 }
 
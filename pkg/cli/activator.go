@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/acorn-io/runtime/pkg/activator"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/k8sclient"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	klabels "k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewActivator() *cobra.Command {
+	return cli.Command(&Activator{}, cobra.Command{
+		Use:          "activator",
+		Hidden:       true,
+		SilenceUsage: true,
+		Short:        "Run the activator that scales the functions of an app from zero on request",
+		Args:         cobra.NoArgs,
+	})
+}
+
+type Activator struct {
+	Namespace   string   `usage:"Namespace of the functions" env:"ACORN_ACTIVATOR_NAMESPACE"`
+	IdleTimeout string   `usage:"Amount of time a function receives no requests before it is scaled to zero" default:"5m"`
+	Function    []string `usage:"Function to activate and the port to listen on for it (format name=port)"`
+}
+
+func (s *Activator) Run(cmd *cobra.Command, _ []string) error {
+	idleTimeout, err := time.ParseDuration(s.IdleTimeout)
+	if err != nil {
+		return fmt.Errorf("invalid idle timeout [%s]: %w", s.IdleTimeout, err)
+	}
+
+	functionPorts := map[string]int32{}
+	for _, function := range s.Function {
+		name, port, ok := strings.Cut(function, "=")
+		if !ok {
+			return fmt.Errorf("invalid function [%s], must be of the form name=port", function)
+		}
+		p, err := strconv.ParseInt(port, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid port of function [%s]: %w", function, err)
+		}
+		functionPorts[name] = int32(p)
+	}
+
+	cfg, err := k8sclient.DefaultConfig()
+	if err != nil {
+		return err
+	}
+
+	c, err := k8sclient.New(cfg)
+	if err != nil {
+		return err
+	}
+
+	// The pods of the functions are listed on every request, so they are read from an informer instead of the API server
+	pods, err := cache.New(cfg, cache.Options{
+		Scheme: scheme.Scheme,
+		DefaultNamespaces: map[string]cache.Config{
+			s.Namespace: {},
+		},
+		ByObject: map[kclient.Object]cache.ByObject{
+			&corev1.Pod{}: {
+				Label: klabels.SelectorFromSet(map[string]string{
+					labels.AcornManaged: "true",
+				}),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	go func() {
+		if err := pods.Start(ctx); err != nil {
+			logrus.Fatalf("failed to start pod informer: %v", err)
+		}
+	}()
+	// Start the pod informer before serving so that the first requests don't wait on it
+	if _, err := pods.GetInformer(ctx, &corev1.Pod{}); err != nil {
+		return err
+	}
+	if !pods.WaitForCacheSync(ctx) {
+		return fmt.Errorf("failed to sync pod informer")
+	}
+
+	return activator.NewServer(c, pods, s.Namespace, idleTimeout, functionPorts).Start(ctx)
+}
@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/acorn-io/runtime/pkg/apply"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/rulerequest"
+	"github.com/spf13/cobra"
+)
+
+func NewApply(c CommandContext) *cobra.Command {
+	return cli.Command(&Apply{out: c.StdOut, client: c.ClientFactory}, cobra.Command{
+		Use:          "apply [flags]",
+		SilenceUsage: true,
+		Short:        "Create, update and remove the apps of a project to match a manifest",
+		Long: `Create, update and remove the apps of a project to match a manifest. The manifest is an AML file listing
+apps by name, each with an image and optionally deploy args, profiles, links, secret and volume bindings and
+compute classes. Links and bindings use the same syntax as the flags of 'acorn run':
+
+  apps: {
+    web: {
+      image: "ghcr.io/acme/web:v1"
+      deployArgs: replicas: 2
+      links: ["db"]
+      computeClasses: ["web=large"]
+    }
+    db: {
+      image: "ghcr.io/acme/db:v1"
+      secrets: ["db-creds:creds"]
+      volumes: ["data,size=10G"]
+    }
+  }
+
+Updating an app replaces what the manifest declares and keeps everything else, like published ports and granted
+permissions. Apps created by 'acorn apply' are labeled, and with --prune those no longer in the manifest are removed.`,
+		Example: `
+  # Apply the manifest in project.acorn
+    acorn apply -f project.acorn
+
+  # Show what applying the manifest would change, validating it on the server without changing anything
+    acorn apply -f project.acorn --dry-run
+
+  # Apply the manifest and remove the apps that were removed from it
+    acorn apply -f project.acorn --prune`,
+		Args: cobra.NoArgs,
+	})
+}
+
+type Apply struct {
+	File      string `usage:"Manifest of the apps of the project" short:"f" default:"project.acorn"`
+	Prune     bool   `usage:"Remove apps created by a previous apply that are no longer in the manifest"`
+	DryRun    bool   `usage:"Print what would change and validate the apps on the server without changing anything"`
+	Dangerous bool   `usage:"Automatically approve all privileges requested by the applications"`
+
+	out    io.Writer
+	client ClientFactory
+}
+
+func (s *Apply) Run(cmd *cobra.Command, _ []string) error {
+	manifest, err := apply.ReadFile(s.File)
+	if err != nil {
+		return err
+	}
+
+	c, err := s.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	apps, err := c.AppList(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	steps, err := apply.Plan(manifest, apps, s.Prune)
+	if err != nil {
+		return err
+	}
+
+	out := s.out
+	if out == nil {
+		out = os.Stdout
+	}
+
+	for _, step := range steps {
+		if err := s.apply(cmd, c, step); err != nil {
+			return fmt.Errorf("failed to %s app %s: %w", step.Action, step.Name, err)
+		}
+
+		suffix := ""
+		if s.DryRun && step.Action != apply.ActionUnchanged {
+			suffix = " (dry run)"
+		}
+		if _, err := fmt.Fprintf(out, "%s %s%s\n", step.Action, step.Name, suffix); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// apply does the step. With --dry-run the apps created and updated are only validated by the server, and the apps
+// deleted are left alone.
+func (s *Apply) apply(cmd *cobra.Command, c client.Client, step apply.Step) (err error) {
+	switch step.Action {
+	case apply.ActionCreate:
+		opts := step.Options
+		if s.DryRun {
+			opts.DryRun = true
+			_, err = c.AppRun(cmd.Context(), step.Image, &opts)
+		} else {
+			_, err = rulerequest.PromptRun(cmd.Context(), c, s.Dangerous, step.Image, opts)
+		}
+	case apply.ActionUpdate:
+		opts := step.UpdateOptions()
+		if s.DryRun {
+			opts.DryRun = true
+			_, err = c.AppUpdate(cmd.Context(), step.Name, &opts)
+		} else {
+			_, err = rulerequest.PromptUpdate(cmd.Context(), c, s.Dangerous, step.Name, opts)
+		}
+	case apply.ActionDelete:
+		if !s.DryRun {
+			_, err = c.AppDelete(cmd.Context(), step.Name)
+		}
+	}
+	return err
+}
@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestApply(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "project.acorn")
+	err := os.WriteFile(file, []byte(`
+apps: {
+	web: image: "ghcr.io/acme/web:v2"
+	db: image: "ghcr.io/acme/db:v1"
+}
+`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	existing := []apiv1.App{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Labels: map[string]string{labels.AcornApplied: "true"}},
+			Spec:       v1.AppInstanceSpec{Image: "ghcr.io/acme/web:v1"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "old", Labels: map[string]string{labels.AcornApplied: "true"}},
+			Spec:       v1.AppInstanceSpec{Image: "ghcr.io/acme/old:v1"},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantOut string
+		prepare func(f *mocks.MockClient)
+	}{
+		{
+			name:    "acorn apply --prune",
+			args:    []string{"-f", file, "--prune"},
+			wantOut: "create db\nupdate web\ndelete old\n",
+			prepare: func(f *mocks.MockClient) {
+				f.EXPECT().AppRun(gomock.Any(), "ghcr.io/acme/db:v1", gomock.Any()).DoAndReturn(
+					func(_ context.Context, image string, opts *client.AppRunOptions) (*apiv1.App, error) {
+						assert.Equal(t, "db", opts.Name)
+						assert.False(t, opts.DryRun)
+						return &apiv1.App{}, nil
+					})
+				f.EXPECT().AppUpdate(gomock.Any(), "web", gomock.Any()).DoAndReturn(
+					func(_ context.Context, name string, opts *client.AppUpdateOptions) (*apiv1.App, error) {
+						assert.Equal(t, "ghcr.io/acme/web:v2", opts.Image)
+						assert.True(t, opts.Replace)
+						return &apiv1.App{}, nil
+					})
+				f.EXPECT().AppDelete(gomock.Any(), "old").Return(&apiv1.App{}, nil)
+			},
+		},
+		{
+			name:    "acorn apply --prune --dry-run",
+			args:    []string{"-f", file, "--prune", "--dry-run"},
+			wantOut: "create db (dry run)\nupdate web (dry run)\ndelete old (dry run)\n",
+			prepare: func(f *mocks.MockClient) {
+				f.EXPECT().AppRun(gomock.Any(), "ghcr.io/acme/db:v1", gomock.Any()).DoAndReturn(
+					func(_ context.Context, image string, opts *client.AppRunOptions) (*apiv1.App, error) {
+						assert.True(t, opts.DryRun)
+						return &apiv1.App{}, nil
+					})
+				f.EXPECT().AppUpdate(gomock.Any(), "web", gomock.Any()).DoAndReturn(
+					func(_ context.Context, name string, opts *client.AppUpdateOptions) (*apiv1.App, error) {
+						assert.True(t, opts.DryRun)
+						return &apiv1.App{}, nil
+					})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mClient := mocks.NewMockClient(ctrl)
+			mClient.EXPECT().AppList(gomock.Any()).Return(existing, nil)
+			tt.prepare(mClient)
+
+			out := &bytes.Buffer{}
+			cmd := NewApply(CommandContext{
+				ClientFactory: &testdata.MockClientFactoryManual{
+					Client: mClient,
+				},
+				StdOut: out,
+				StdErr: out,
+				StdIn:  strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			if !assert.NoError(t, cmd.Execute()) {
+				return
+			}
+			assert.Equal(t, tt.wantOut, out.String())
+		})
+	}
+}
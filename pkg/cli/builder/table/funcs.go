@@ -8,6 +8,7 @@ import (
 	"time"
 
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	adminv1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/publicname"
@@ -41,12 +42,15 @@ var (
 		"trunc":         Trunc,
 		"alias":         Noop,
 		"appGeneration": AppGeneration,
+		"appSchedule":   AppSchedule,
 		"displayRange":  DisplayRange,
 		"memoryToRange": MemoryToRange,
 		"defaultMemory": DefaultMemory,
 		"ownerName":     OwnerReferenceName,
 		"imageName":     ImageName,
 		"imageCommit":   ImageCommit,
+		"usageCPU":      UsageCPU,
+		"usageMemory":   UsageMemory,
 	}
 )
 
@@ -336,6 +340,19 @@ func AppGeneration(app apiv1.App, msg string) string {
 	return msg
 }
 
+// AppSchedule appends the next scheduled stop or start of the app to msg.
+func AppSchedule(app apiv1.App, msg string) string {
+	schedule := app.Status.Schedule
+	if schedule == nil || schedule.NextTransition.IsZero() {
+		return msg
+	}
+	next := fmt.Sprintf("scheduled %s %s", schedule.NextAction, FormatUntil(schedule.NextTransition))
+	if msg == "" {
+		return next
+	}
+	return msg + "; " + next
+}
+
 func OwnerReferenceName(obj metav1.Object) string {
 	owners := obj.GetOwnerReferences()
 	if len(owners) == 0 {
@@ -370,3 +387,36 @@ func ImageCommit(obj metav1.Object) string {
 
 	return app.Status.AppImage.VCS.Revision
 }
+
+// UsageCPU formats the CPU of the usage in millicores.
+func UsageCPU(usage *v1.Usage) string {
+	if usage == nil {
+		return ""
+	}
+	return fmt.Sprintf("%dm", usage.CPU)
+}
+
+// UsageMemory formats the memory of the usage and its limit in mebibytes, and flags usage near the limit and containers
+// that have restarted repeatedly and were last OOMKilled.
+func UsageMemory(usage *v1.Usage) string {
+	if usage == nil {
+		return ""
+	}
+
+	result := mebibytes(usage.Memory)
+	if usage.MemoryLimit > 0 {
+		result += "/" + mebibytes(usage.MemoryLimit)
+	}
+	if usage.NearMemoryLimit {
+		result += " (near limit)"
+	}
+	if usage.RepeatedlyOOMKilled() {
+		result += fmt.Sprintf(" (last restart OOMKilled, %d restarts)", usage.OOMKilledRestarts)
+	}
+	return result
+}
+
+func mebibytes(bytes int64) string {
+	const mi = 1 << 20
+	return fmt.Sprintf("%dMi", (bytes+mi-1)/mi)
+}
@@ -27,7 +27,7 @@ acorn containers`,
 
 type Container struct {
 	Quiet  bool   `usage:"Output only names" short:"q"`
-	Output string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	Output string `usage:"Output format (json, yaml, wide, {{gotemplate}})" short:"o"`
 	All    bool   `usage:"Include stopped containers" short:"a"`
 	client ClientFactory
 }
@@ -38,7 +38,12 @@ func (a *Container) Run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	out := table.NewWriter(tables.Container, a.Quiet, a.Output)
+	values, output := tables.Container, a.Output
+	if output == "wide" {
+		// wide adds the CPU and memory used to the table
+		values, output = tables.ContainerWide, ""
+	}
+	out := table.NewWriter(values, a.Quiet, output)
 
 	switch len(args) {
 	case 0:
@@ -40,6 +40,7 @@ acorn dev --name wandering-sound --clone [acorn args]
 	}
 	cmd.PersistentFlags().Lookup("dangerous").Hidden = true
 	cmd.Flags().SetInterspersed(false)
+	cmd.PersistentFlags().Lookup("preview").NoOptDefVal = previewHead
 	return cmd
 }
 
@@ -88,6 +89,11 @@ func (s *Dev) Run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	opts, err = s.previewOpts(cmd.Context(), c, imageSource, opts)
+	if err != nil {
+		return err
+	}
+
 	// If auto-upgrade is not set, set it to true if auto-upgrade is implied
 	if !z.Dereference(opts.AutoUpgrade) && autoupgrade.Implied(imageSource.Image, s.Interval, z.Dereference(opts.NotifyUpgrade)) {
 		opts.AutoUpgrade = z.Pointer(true)
@@ -0,0 +1,245 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/acorn-io/runtime/pkg/appdiff"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/imagesource"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+)
+
+func NewDiff(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&Diff{out: c.StdOut, client: c.ClientFactory}, cobra.Command{
+		Use:          "diff [flags] ACORN_NAME [IMAGE|DIRECTORY] [acorn args]",
+		SilenceUsage: true,
+		Short:        "Show what updating a deployed Acorn would change",
+		Long: `Show what updating a deployed Acorn would change without updating it. The app spec, deploy args, permissions
+and published endpoints of the running app are compared against those of the new image, or of the running
+image with the new args. Permissions the new image requests that are not granted to the app are listed
+separately, as updating the app would ask for consent to them.`,
+		Example: `
+  # Compare an Acorn called "my-app" against the contents of the current directory
+    acorn diff my-app .
+
+  # Compare an Acorn called "my-app" against a new image
+    acorn diff my-app ghcr.io/acorn-io/library/hello-world:v2
+
+  # Compare an Acorn called "my-app" against new args to its running image
+    acorn diff my-app -- --replicas 2`,
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+	cmd.Flags().SetInterspersed(false)
+	return cmd
+}
+
+type Diff struct {
+	File         string   `short:"f" usage:"Name of the build file (default \"DIRECTORY/Acornfile\")"`
+	ArgsFile     string   `usage:"Default args to apply to the diff command" default:".args.acorn"`
+	Volume       []string `usage:"Bind an existing volume (format existing:vol-name,field=value) (ex: pvc-name:app-data)" short:"v" split:"false"`
+	Secret       []string `usage:"Bind an existing secret (format existing:sec-name) (ex: sec-name:app-secret)" short:"s"`
+	Link         []string `usage:"Link external app as a service in the current app (format app-name:container-name)"`
+	PublishAll   *bool    `usage:"Publish all (true) or none (false) of the defined ports of application" short:"P"`
+	Publish      []string `usage:"Publish port of application (format [public:]private[,option=value]) (ex 81:80, app.example.com:web,basic-auth=creds)" short:"p"`
+	Env          []string `usage:"Environment variables to set on running containers" short:"e"`
+	Memory       []string `usage:"Set memory for a workload in the format of workload=memory. Only specify an amount to set all workloads. (ex foo=512Mi or 512Mi)" short:"m"`
+	ComputeClass []string `usage:"Set computeclass for a workload in the format of workload=computeclass. Specify a single computeclass to set all workloads. (ex foo=example-class or example-class)"`
+	Output       string   `usage:"Output format (json, yaml)" short:"o"`
+
+	out    io.Writer
+	client ClientFactory
+}
+
+func (s *Diff) Run(cmd *cobra.Command, args []string) error {
+	// we can't enforce the one argument requirement at the Cobra level since the acorn args are not parsed as flags
+	if len(args) == 0 {
+		return fmt.Errorf("requires at least 1 arg(s), only received 0")
+	}
+
+	c, err := s.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	app, err := c.AppGet(cmd.Context(), args[0])
+	if err != nil {
+		return err
+	}
+
+	opts, err := RunArgs{
+		Name: app.Name,
+		UpdateArgs: UpdateArgs{
+			Volume:       s.Volume,
+			Secret:       s.Secret,
+			Link:         s.Link,
+			PublishAll:   s.PublishAll,
+			Publish:      s.Publish,
+			Env:          s.Env,
+			Memory:       s.Memory,
+			ComputeClass: s.ComputeClass,
+		},
+	}.ToOpts()
+	if err != nil {
+		return err
+	}
+	updateOpts := opts.ToUpdate()
+
+	// Resolve the image and args the same way 'acorn run --update' does
+	imageSource := imagesource.NewImageSource(s.client.AcornConfigFile(), s.File, s.ArgsFile, args[1:], nil, false)
+	if imageSource.IsImageSet() {
+		if updateOpts.Image, updateOpts.DeployArgs, updateOpts.Profiles, err = imageSource.GetImageAndDeployArgs(cmd.Context(), c); err != nil {
+			return err
+		}
+	} else if len(imageSource.Args) > 0 {
+		imageSource.Image = app.Status.AppImage.Name
+		if _, updateOpts.DeployArgs, updateOpts.Profiles, err = imageSource.GetImageAndDeployArgs(cmd.Context(), c); err != nil {
+			return err
+		}
+	}
+
+	target, err := client.ToAppUpdate(cmd.Context(), c, app.Name, &updateOpts)
+	if err != nil {
+		return err
+	}
+
+	// An app keeps running the image it resolved until its image changes, even if its tag has since moved
+	image := target.Spec.Image
+	if image == app.Spec.Image && app.Status.AppImage.ID != "" {
+		image = app.Status.AppImage.ID
+	}
+
+	details, err := c.ImageDetails(cmd.Context(), image, &client.ImageDetailsOptions{
+		DeployArgs:    target.Spec.DeployArgs.GetData(),
+		Profiles:      target.Spec.GetProfiles(app.Status.GetDevMode()),
+		IncludeNested: true,
+	})
+	if err != nil {
+		return err
+	}
+	if details.ParseError != "" {
+		return errors.New(details.ParseError)
+	}
+
+	diff, err := appdiff.Compare(app, target, details)
+	if err != nil {
+		return err
+	}
+
+	out := s.out
+	if out == nil {
+		out = os.Stdout
+	}
+
+	switch s.Output {
+	case "":
+		return printDiff(out, diff)
+	case "json":
+		data, err := json.MarshalIndent(diff, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(data))
+		return err
+	case "yaml":
+		data, err := yaml.Marshal(diff)
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(data)
+		return err
+	default:
+		return fmt.Errorf("invalid output format %s, must be json or yaml", s.Output)
+	}
+}
+
+// printDiff prints each section of the diff that has changes, marking what is added with +, removed with - and
+// replaced with ~.
+func printDiff(out io.Writer, diff *appdiff.Diff) error {
+	if diff.Empty() {
+		_, err := fmt.Fprintln(out, "No changes")
+		return err
+	}
+
+	var image []appdiff.Change
+	if diff.Image != nil {
+		image = append(image, *diff.Image)
+	}
+
+	for _, section := range []struct {
+		title   string
+		changes []appdiff.Change
+	}{
+		{"Image", image},
+		{"App spec", diff.AppSpec},
+		{"Deploy args", diff.DeployArgs},
+		{"Permissions", diff.Permissions},
+		{"Endpoints", diff.Endpoints},
+	} {
+		if len(section.changes) == 0 {
+			continue
+		}
+		if _, err := fmt.Fprintf(out, "%s:\n", section.title); err != nil {
+			return err
+		}
+		for _, change := range section.changes {
+			if _, err := fmt.Fprintf(out, "  %s\n", formatChange(change)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(diff.PermissionsNeedingConsent) == 0 {
+		return nil
+	}
+	if _, err := fmt.Fprintln(out, "Permissions needing consent:"); err != nil {
+		return err
+	}
+	for _, perm := range diff.PermissionsNeedingConsent {
+		if _, err := fmt.Fprintf(out, "  %s\n", perm); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func formatChange(change appdiff.Change) string {
+	prefix := "~ "
+	switch change.Op {
+	case appdiff.OpAdd:
+		prefix = "+ "
+	case appdiff.OpRemove:
+		prefix = "- "
+	}
+	if change.Path != "" {
+		prefix += change.Path + ": "
+	}
+
+	switch change.Op {
+	case appdiff.OpAdd:
+		return prefix + formatValue(change.New)
+	case appdiff.OpRemove:
+		return prefix + formatValue(change.Old)
+	default:
+		return prefix + formatValue(change.Old) + " => " + formatValue(change.New)
+	}
+}
+
+func formatValue(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case fmt.Stringer:
+		return v.String()
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/appdiff"
+	"github.com/acorn-io/runtime/pkg/rulerequest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintDiff(t *testing.T) {
+	tests := []struct {
+		name    string
+		diff    *appdiff.Diff
+		wantOut string
+	}{
+		{
+			name:    "no changes",
+			diff:    &appdiff.Diff{Name: "found"},
+			wantOut: "No changes\n",
+		},
+		{
+			name: "changes",
+			diff: &appdiff.Diff{
+				Name:  "found",
+				Image: &appdiff.Change{Op: appdiff.OpReplace, Old: "foo:v1", New: "foo:v2"},
+				AppSpec: []appdiff.Change{
+					{Op: appdiff.OpAdd, Path: "/containers/web/env", New: []any{map[string]any{"name": "FOO", "value": "bar"}}},
+					{Op: appdiff.OpRemove, Path: "/jobs/migrate", Old: map[string]any{"image": "migrate"}},
+				},
+				DeployArgs: []appdiff.Change{
+					{Op: appdiff.OpReplace, Path: "/replicas", Old: float64(1), New: float64(2)},
+				},
+				Endpoints: []appdiff.Change{
+					{Op: appdiff.OpAdd, New: "tcp :5432 -> db:5432"},
+				},
+				PermissionsNeedingConsent: []rulerequest.RuleRequest{
+					{Service: "web", Scope: "project", Verbs: "get,list", Resource: "secrets"},
+				},
+			},
+			wantOut: `Image:
+  ~ foo:v1 => foo:v2
+App spec:
+  + /containers/web/env: [{"name":"FOO","value":"bar"}]
+  - /jobs/migrate: {"image":"migrate"}
+Deploy args:
+  ~ /replicas: 1 => 2
+Endpoints:
+  + tcp :5432 -> db:5432
+Permissions needing consent:
+  service web: get,list secrets in project
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			if !assert.NoError(t, printDiff(out, tt.diff)) {
+				return
+			}
+			assert.Equal(t, tt.wantOut, out.String())
+		})
+	}
+}
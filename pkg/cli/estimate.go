@@ -0,0 +1,204 @@
+package cli
+
+import (
+	"fmt"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	adminv1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/strings/slices"
+)
+
+func NewEstimate(c CommandContext) *cobra.Command {
+	return cli.Command(&Estimate{client: c.ClientFactory}, cobra.Command{
+		Use:          "estimate [flags] [ACORN_NAME...]",
+		SilenceUsage: true,
+		Short:        "Estimate the resources, cost and quota of the apps in the project",
+		Long: `Estimate the CPU, memory and volume storage of the apps in the project, counting every replica of each
+container. If the compute and volume classes declare prices the monthly cost is estimated too, and if the
+project has quota enforced the resources requested by the apps are compared against the quota allocated.
+
+To estimate an app before it is deployed, use 'acorn run --dry-run --estimate'.`,
+		Example: `# Estimate all apps in the project
+  acorn estimate
+
+  # Estimate one app
+  acorn estimate my-app`,
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).complete,
+	})
+}
+
+type Estimate struct {
+	Output string `usage:"Output format (json, yaml)" short:"o"`
+	client ClientFactory
+}
+
+// EstimateRow is one row of an estimate, either a workload, a volume, an app or the total of the others.
+type EstimateRow struct {
+	Name        string `json:"name,omitempty"`
+	Kind        string `json:"kind,omitempty"`
+	Class       string `json:"class,omitempty"`
+	Replicas    string `json:"replicas,omitempty"`
+	CPU         string `json:"cpu,omitempty"`
+	Memory      string `json:"memory,omitempty"`
+	Storage     string `json:"storage,omitempty"`
+	MonthlyCost string `json:"monthlyCost,omitempty"`
+}
+
+// EstimateQuota is one row of the comparison of an estimate against quota.
+type EstimateQuota struct {
+	Quota     string `json:"quota,omitempty"`
+	Resources string `json:"resources,omitempty"`
+}
+
+func (e *Estimate) Run(cmd *cobra.Command, args []string) error {
+	c, err := e.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	apps, err := c.AppList(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	var estimates []*apiv1.AppEstimate
+	for _, app := range apps {
+		if len(args) > 0 && !slices.Contains(args, app.Name) {
+			continue
+		}
+		estimate, err := c.AppEstimate(cmd.Context(), app.Name, nil)
+		if err != nil {
+			return err
+		}
+		estimate.Name = app.Name
+		estimates = append(estimates, estimate)
+	}
+
+	if e.Output != "" {
+		return writeEstimateObjects(e.Output, estimates...)
+	}
+
+	var (
+		rows      []EstimateRow
+		total     apiv1.ResourceEstimate
+		requested adminv1.QuotaRequestResources
+		quota     *apiv1.QuotaEstimate
+	)
+	for _, estimate := range estimates {
+		rows = append(rows, resourceRow(estimate.Name, "app", "", "", estimate.Total))
+		total.Add(estimate.Total)
+		if estimate.Quota.Enforced {
+			requested.Add(estimate.Quota.Requested)
+			quota = &estimate.Quota
+		}
+	}
+	rows = append(rows, resourceRow("TOTAL", "", "", "", total))
+
+	if err := writeEstimateRows(rows); err != nil {
+		return err
+	}
+
+	if quota == nil {
+		return nil
+	}
+	return writeEstimateQuota([]EstimateQuota{
+		{Quota: "Requested by apps", Resources: requested.ToString()},
+		{Quota: "Allocated to project", Resources: quota.ProjectAllocated.ToString()},
+	})
+}
+
+// printAppEstimate prints the estimate of an app about to be deployed as a table of its workloads and volumes followed
+// by how it fits in quota, or as the estimate object in the given format.
+func printAppEstimate(format string, estimate *apiv1.AppEstimate) error {
+	if format != "" {
+		return writeEstimateObjects(format, estimate)
+	}
+
+	var rows []EstimateRow
+	for _, workload := range estimate.Workloads {
+		rows = append(rows, resourceRow(workload.Name, "container", workload.ComputeClass, fmt.Sprint(workload.Replicas), apiv1.ResourceEstimate{
+			CPU:         workload.CPU * workload.Replicas,
+			Memory:      workload.Memory * workload.Replicas,
+			MonthlyCost: workload.MonthlyCost,
+		}))
+	}
+	for _, vol := range estimate.Volumes {
+		rows = append(rows, resourceRow(vol.Name, "volume", vol.VolumeClass, "", apiv1.ResourceEstimate{
+			Storage:     vol.Size,
+			MonthlyCost: vol.MonthlyCost,
+		}))
+	}
+	rows = append(rows, resourceRow("TOTAL", "", "", "", estimate.Total))
+
+	if err := writeEstimateRows(rows); err != nil {
+		return err
+	}
+
+	if !estimate.Quota.Enforced {
+		return nil
+	}
+
+	// The quota of the project once the app is deployed, replacing what is allocated to it now
+	after := *estimate.Quota.ProjectAllocated.DeepCopy()
+	after.Add(estimate.Quota.Requested)
+	after.Remove(estimate.Quota.Allocated, true)
+
+	return writeEstimateQuota([]EstimateQuota{
+		{Quota: "Requested by app", Resources: estimate.Quota.Requested.ToString()},
+		{Quota: "Allocated to app", Resources: estimate.Quota.Allocated.ToString()},
+		{Quota: "Allocated to project", Resources: estimate.Quota.ProjectAllocated.ToString()},
+		{Quota: "Project after deploy", Resources: after.ToString()},
+	})
+}
+
+func writeEstimateObjects(format string, estimates ...*apiv1.AppEstimate) error {
+	out := table.NewWriter(tables.Estimate, false, format)
+	for _, estimate := range estimates {
+		out.Write(estimate)
+	}
+	return out.Err()
+}
+
+func writeEstimateRows(rows []EstimateRow) error {
+	out := table.NewWriter(tables.Estimate, false, "")
+	for i := range rows {
+		out.WriteFormatted(&rows[i], nil)
+	}
+	return out.Err()
+}
+
+func writeEstimateQuota(rows []EstimateQuota) error {
+	fmt.Println()
+	out := table.NewWriter(tables.EstimateQuota, false, "")
+	for i := range rows {
+		out.WriteFormatted(&rows[i], nil)
+	}
+	return out.Err()
+}
+
+func resourceRow(name, kind, class, replicas string, estimate apiv1.ResourceEstimate) EstimateRow {
+	row := EstimateRow{
+		Name:     name,
+		Kind:     kind,
+		Class:    class,
+		Replicas: replicas,
+	}
+	if estimate.CPU > 0 {
+		row.CPU = fmt.Sprintf("%dm", estimate.CPU)
+	}
+	if estimate.Memory > 0 {
+		row.Memory = resource.NewQuantity(estimate.Memory, resource.BinarySI).String()
+	}
+	if estimate.Storage > 0 {
+		row.Storage = resource.NewQuantity(estimate.Storage, resource.BinarySI).String()
+	}
+	if estimate.MonthlyCost != nil {
+		row.MonthlyCost = fmt.Sprintf("%.2f", *estimate.MonthlyCost)
+	}
+	return row
+}
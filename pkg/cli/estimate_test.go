@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimate(t *testing.T) {
+	type args struct {
+		cmd  *cobra.Command
+		args []string
+	}
+	var _, w, _ = os.Pipe()
+	commandContext := CommandContext{
+		ClientFactory: &testdata.MockClientFactory{},
+		StdOut:        w,
+		StdErr:        w,
+		StdIn:         strings.NewReader(""),
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantOut string
+	}{
+		{
+			name: "acorn estimate",
+			args: args{
+				args: []string{},
+			},
+			wantOut: "NAME      KIND      CLASS     REPLICAS   CPU       MEMORY    STORAGE   MONTHLY-COST\n" +
+				"found     app                            500m      512Mi     10Gi      8.50\n" +
+				"TOTAL                                    500m      512Mi     10Gi      8.50\n",
+		},
+		{
+			name: "acorn estimate other",
+			args: args{
+				args: []string{"other"},
+			},
+			wantOut: "NAME      KIND      CLASS     REPLICAS   CPU       MEMORY    STORAGE   MONTHLY-COST\n" +
+				"TOTAL                                                                  \n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			tt.args.cmd = NewEstimate(commandContext)
+			tt.args.cmd.SetArgs(tt.args.args)
+			err := tt.args.cmd.Execute()
+			if !assert.NoError(t, err) {
+				return
+			}
+			w.Close()
+			out, _ := io.ReadAll(r)
+			assert.Equal(t, tt.wantOut, string(out))
+		})
+	}
+}
+
+func TestPrintAppEstimate(t *testing.T) {
+	c := &testdata.MockClient{}
+	estimate, err := c.AppEstimate(context.Background(), "found", nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+	err = printAppEstimate("", estimate)
+	w.Close()
+	if !assert.NoError(t, err) {
+		return
+	}
+	out, _ := io.ReadAll(r)
+	assert.Equal(t, "NAME      KIND        CLASS     REPLICAS   CPU       MEMORY    STORAGE   MONTHLY-COST\n"+
+		"web       container   default   2          500m      512Mi               7.50\n"+
+		"data      volume      fast                                     10Gi      1.00\n"+
+		"TOTAL                                      500m      512Mi     10Gi      8.50\n", string(out))
+}
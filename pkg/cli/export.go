@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/export"
+	"github.com/spf13/cobra"
+)
+
+func NewExport(c CommandContext) *cobra.Command {
+	return cli.Command(&Export{out: c.StdOut, err: c.StdErr, client: c.ClientFactory}, cobra.Command{
+		Use:          "export [flags] ACORN_NAME",
+		SilenceUsage: true,
+		Short:        "Export a deployed Acorn as Kubernetes manifests or a Helm chart",
+		Long: `Export a deployed Acorn as Kubernetes manifests or a Helm chart. The objects are rendered locally from the app
+spec and deploy args of the running app, the same way the Acorn controller renders them, so they can be applied
+to a cluster without Acorn. Features that only work when Acorn runs the app, like auto-upgrade, hostnames
+generated by Acorn DNS and generated secrets, are not exported and are listed as notes instead. The templates of
+a Helm chart take no values, the deploy args they were rendered with are recorded in the chart, so export the app
+again to change them.`,
+		Example: `
+  # Print the Kubernetes manifests of an Acorn called "my-app"
+    acorn export my-app
+
+  # Write the manifests of "my-app" for the namespace "prod" to a file
+    acorn export my-app --namespace prod > my-app.yaml
+
+  # Write a Helm chart of "my-app" to the directory ./charts/my-app
+    acorn export my-app -o helm -d ./charts/my-app`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type Export struct {
+	Output    string `usage:"Output format (yaml, helm)" short:"o" default:"yaml"`
+	Namespace string `usage:"Namespace of the exported objects (default the namespace the app runs in)"`
+	Directory string `usage:"Directory to write the Helm chart to (default ACORN_NAME)" short:"d"`
+
+	out    io.Writer
+	err    io.Writer
+	client ClientFactory
+}
+
+func (s *Export) Run(cmd *cobra.Command, args []string) error {
+	if s.Output != "yaml" && s.Output != "helm" {
+		return fmt.Errorf("unsupported output format %s", s.Output)
+	}
+
+	c, err := s.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	app, err := c.AppGet(cmd.Context(), args[0])
+	if err != nil {
+		return err
+	}
+
+	result, err := export.App(cmd.Context(), app, s.Namespace)
+	if err != nil {
+		return err
+	}
+
+	out, errOut := s.out, s.err
+	if out == nil {
+		out = os.Stdout
+	}
+	if errOut == nil {
+		errOut = os.Stderr
+	}
+
+	if s.Output == "helm" {
+		dir := s.Directory
+		if dir == "" {
+			dir = app.Name
+		}
+		if err := result.WriteChart(dir, export.Chart{
+			Name:       app.Name,
+			AppVersion: app.Spec.Image,
+			DeployArgs: app.Spec.DeployArgs.GetData(),
+		}); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(out, "Wrote Helm chart to %s\n", dir); err != nil {
+			return err
+		}
+	} else {
+		data, err := result.YAML()
+		if err != nil {
+			return err
+		}
+		if _, err := out.Write(data); err != nil {
+			return err
+		}
+	}
+
+	for _, note := range result.Notes {
+		if _, err := fmt.Fprintf(errOut, "Note: %s\n", note); err != nil {
+			return err
+		}
+	}
+	return nil
+}
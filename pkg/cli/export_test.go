@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/acorn-io/runtime/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestExport(t *testing.T) {
+	app := &apiv1.App{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "acorn", UID: "1234567890abcdef"},
+		Spec:       v1.AppInstanceSpec{Image: "ghcr.io/acme/web:v1"},
+		Status: apiv1.AppStatus{
+			Namespace: "web-app",
+			AppImage:  v1.AppImage{ID: "ghcr.io/acme/web:v1"},
+			AppSpec: v1.AppSpec{
+				Containers: map[string]v1.Container{
+					"web": {Image: "nginx"},
+				},
+			},
+		},
+	}
+	dir := filepath.Join(t.TempDir(), "chart")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantOut []string
+		wantErr string
+	}{
+		{
+			name:    "acorn export web",
+			args:    []string{"web"},
+			wantOut: []string{"kind: Deployment", "namespace: web-app"},
+		},
+		{
+			name:    "acorn export web --namespace prod",
+			args:    []string{"web", "--namespace", "prod"},
+			wantOut: []string{"kind: Deployment", "namespace: prod"},
+		},
+		{
+			name:    "acorn export web -o helm",
+			args:    []string{"web", "-o", "helm", "-d", dir},
+			wantOut: []string{"Wrote Helm chart to " + dir},
+		},
+		{
+			name:    "acorn export web -o json",
+			args:    []string{"web", "-o", "json"},
+			wantErr: "unsupported output format json",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mClient := mocks.NewMockClient(ctrl)
+			if tt.wantErr == "" {
+				mClient.EXPECT().AppGet(gomock.Any(), "web").Return(app, nil)
+			}
+
+			out, errOut := &bytes.Buffer{}, &bytes.Buffer{}
+			cmd := NewExport(CommandContext{
+				ClientFactory: &testdata.MockClientFactoryManual{
+					Client: mClient,
+				},
+				StdOut: out,
+				StdErr: errOut,
+				StdIn:  strings.NewReader(""),
+			})
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			for _, want := range tt.wantOut {
+				assert.Contains(t, out.String(), want)
+			}
+			assert.Contains(t, errOut.String(), "Note: registry credentials are not exported")
+		})
+	}
+
+	_, err := os.Stat(filepath.Join(dir, "templates", "deployment-web.yaml"))
+	assert.NoError(t, err)
+}
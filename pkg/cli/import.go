@@ -0,0 +1,23 @@
+package cli
+
+import (
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/spf13/cobra"
+)
+
+func NewImport(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&Import{}, cobra.Command{
+		Use:   "import [flags] command",
+		Short: "Translate files of other tools into Acornfiles",
+		Example: `
+acorn import compose docker-compose.yml`,
+	})
+	cmd.AddCommand(NewImportCompose(c))
+	return cmd
+}
+
+type Import struct{}
+
+func (s *Import) Run(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/compose"
+	"github.com/spf13/cobra"
+)
+
+func NewImportCompose(c CommandContext) *cobra.Command {
+	return cli.Command(&ImportCompose{out: c.StdOut, err: c.StdErr}, cobra.Command{
+		Use:          "compose [flags] FILE",
+		SilenceUsage: true,
+		Short:        "Translate a Docker Compose file into an Acornfile",
+		Long: `Translate a Docker Compose file into an Acornfile. Services become containers with their images, builds, ports,
+volumes, environment, env files, dependencies, health checks and secrets. Named volumes and secrets become
+volumes and opaque secrets of the app. Features of the Compose file that can not be translated are listed as
+notes. Paths in the Acornfile are relative to the directory of the Compose file, so write it next to it.`,
+		Example: `
+  # Print the Acornfile of docker-compose.yml
+    acorn import compose docker-compose.yml
+
+  # Write the Acornfile next to the Compose file and run it
+    acorn import compose docker-compose.yml -o Acornfile
+    acorn run .`,
+		Args: cobra.ExactArgs(1),
+	})
+}
+
+type ImportCompose struct {
+	Output string `usage:"Write the Acornfile to this file instead of printing it" short:"o"`
+
+	out io.Writer
+	err io.Writer
+}
+
+func (s *ImportCompose) Run(_ *cobra.Command, args []string) error {
+	result, err := compose.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	acornfile, err := result.Acornfile()
+	if err != nil {
+		return err
+	}
+
+	out, errOut := s.out, s.err
+	if out == nil {
+		out = os.Stdout
+	}
+	if errOut == nil {
+		errOut = os.Stderr
+	}
+
+	if s.Output != "" {
+		err = os.WriteFile(s.Output, acornfile, 0644)
+	} else {
+		_, err = out.Write(acornfile)
+	}
+	if err != nil {
+		return err
+	}
+
+	return printComposeNotes(errOut, result)
+}
+
+func printComposeNotes(out io.Writer, result *compose.Result) error {
+	for _, note := range result.Notes {
+		if _, err := fmt.Fprintf(out, "Note: %s\n", note); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportCompose(t *testing.T) {
+	dir := t.TempDir()
+	composeFile := filepath.Join(dir, "docker-compose.yml")
+	require.NoError(t, os.WriteFile(composeFile, []byte(`
+services:
+  web:
+    image: nginx
+    ports: ["80"]
+    networks: [front]
+`), 0644))
+
+	wantAcornfile := `{
+	containers: {
+		web: {
+			image: "nginx"
+			ports: {
+				publish: [
+					"80",
+				]
+			}
+		}
+	}
+}
+`
+
+	out, errOut := &bytes.Buffer{}, &bytes.Buffer{}
+	cmd := NewImportCompose(CommandContext{
+		StdOut: out,
+		StdErr: errOut,
+		StdIn:  strings.NewReader(""),
+	})
+	cmd.SetArgs([]string{composeFile})
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, wantAcornfile, out.String())
+	assert.Equal(t, "Note: service web: networks is not supported\n", errOut.String())
+
+	acornfile := filepath.Join(dir, "Acornfile")
+	cmd.SetArgs([]string{composeFile, "-o", acornfile})
+	require.NoError(t, cmd.Execute())
+	written, err := os.ReadFile(acornfile)
+	require.NoError(t, err)
+	assert.Equal(t, wantAcornfile, string(written))
+}
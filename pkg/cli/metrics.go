@@ -0,0 +1,330 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/tables"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func NewMetrics(c CommandContext) *cobra.Command {
+	return cli.Command(&Metrics{client: c.ClientFactory}, cobra.Command{
+		Use:          "metrics [flags] ACORN_NAME [CONTAINER_NAME]",
+		SilenceUsage: true,
+		Short:        "Show the metrics of the containers of an app",
+		Long:         "Show the metrics of the containers and jobs of an app that define a metrics port and path",
+		Example: `# Print the current metrics of all containers of the app
+  acorn metrics my-app
+
+  # Print the current metrics of the web container matching "http_requests"
+  acorn metrics my-app web --filter http_requests
+
+  # Take 30 samples, 2 seconds apart, and print a summary with a sparkline of each metric
+  acorn metrics my-app web --samples 30 --interval 2s`,
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsThenContainersCompletion).complete,
+		Args:              cobra.RangeArgs(1, 2),
+	})
+}
+
+type Metrics struct {
+	Filter   string `usage:"Only show metrics whose name contains this string" short:"f"`
+	Samples  int    `usage:"Number of times to scrape the metrics, more than one prints a summary" short:"s" default:"1"`
+	Interval string `usage:"Time between samples" short:"i" default:"1s"`
+	Quiet    bool   `usage:"Output only names" short:"q"`
+	Output   string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	client   ClientFactory
+}
+
+// MetricValue is the current value of a metric series scraped from a container.
+type MetricValue struct {
+	Container string  `json:"container,omitempty"`
+	Metric    string  `json:"metric,omitempty"`
+	Value     float64 `json:"value"`
+}
+
+// MetricSummary is the summary of the values of a metric series scraped from a container several times.
+type MetricSummary struct {
+	Container string    `json:"container,omitempty"`
+	Metric    string    `json:"metric,omitempty"`
+	Min       float64   `json:"min"`
+	Max       float64   `json:"max"`
+	Last      float64   `json:"last"`
+	Trend     string    `json:"trend,omitempty"`
+	Values    []float64 `json:"values,omitempty"`
+}
+
+func (m *Metrics) Run(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	c, err := m.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	interval, err := time.ParseDuration(m.Interval)
+	if err != nil {
+		return fmt.Errorf("invalid interval [%s]: %w", m.Interval, err)
+	}
+	if m.Samples < 1 {
+		return fmt.Errorf("samples must be at least 1")
+	}
+
+	app, err := c.AppGet(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	var containerName string
+	if len(args) > 1 {
+		containerName = args[1]
+	}
+
+	replicas, err := metricsReplicas(ctx, c, app.Name, containerName)
+	if err != nil {
+		return err
+	}
+	if len(replicas) == 0 {
+		if containerName != "" {
+			return fmt.Errorf("no running replicas of container [%s] of app [%s] define metrics", containerName, app.Name)
+		}
+		return fmt.Errorf("no running containers of app [%s] define metrics", app.Name)
+	}
+
+	var (
+		// series names by replica, in the order the replicas were scraped
+		series = map[string][]string{}
+		values = map[string]map[string][]float64{}
+	)
+	for i := 0; i < m.Samples; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(interval):
+			}
+		}
+		for _, replica := range replicas {
+			sample, err := scrapeMetrics(ctx, c, replica)
+			if err != nil {
+				return err
+			}
+			if values[replica.Name] == nil {
+				values[replica.Name] = map[string][]float64{}
+			}
+			for _, name := range sortedSeries(sample) {
+				if m.Filter != "" && !strings.Contains(name, m.Filter) {
+					continue
+				}
+				if _, ok := values[replica.Name][name]; !ok {
+					series[replica.Name] = append(series[replica.Name], name)
+				}
+				values[replica.Name][name] = append(values[replica.Name][name], sample[name])
+			}
+		}
+	}
+
+	if m.Samples == 1 {
+		out := table.NewWriter(tables.MetricValue, m.Quiet, m.Output)
+		for _, replica := range replicas {
+			for _, name := range series[replica.Name] {
+				out.WriteFormatted(&MetricValue{
+					Container: replica.Name,
+					Metric:    name,
+					Value:     values[replica.Name][name][0],
+				}, nil)
+			}
+		}
+		return out.Err()
+	}
+
+	out := table.NewWriter(tables.MetricSummary, m.Quiet, m.Output)
+	for _, replica := range replicas {
+		for _, name := range series[replica.Name] {
+			out.WriteFormatted(summarizeMetric(replica.Name, name, values[replica.Name][name]), nil)
+		}
+	}
+	return out.Err()
+}
+
+// metricsReplicas returns the running replicas of the app that define metrics, optionally only those of the named
+// container or job.
+func metricsReplicas(ctx context.Context, c client.Client, appName, containerName string) (result []apiv1.ContainerReplica, _ error) {
+	replicas, err := c.ContainerReplicaList(ctx, &client.ContainerReplicaListOptions{App: appName})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, replica := range replicas {
+		if replica.Spec.SidecarName != "" || replica.Spec.Metrics.Port == 0 || replica.Spec.Metrics.Path == "" {
+			continue
+		}
+		if replica.Status.Phase != "" && replica.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		if containerName != "" && replica.Spec.ContainerName != containerName && replica.Spec.JobName != containerName &&
+			replica.Name != containerName {
+			continue
+		}
+		result = append(result, replica)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// scrapeMetrics fetches the metrics of the replica through a port forward and returns the value of each series.
+func scrapeMetrics(ctx context.Context, c client.Client, replica apiv1.ContainerReplica) (map[string]float64, error) {
+	dialer, err := c.ContainerReplicaPortForward(ctx, replica.Name, int(replica.Spec.Metrics.Port))
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return dialer(ctx)
+			},
+			DisableKeepAlives: true,
+		},
+		Timeout: 30 * time.Second,
+	}
+	defer httpClient.CloseIdleConnections()
+
+	path := replica.Spec.Metrics.Path
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost"+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", string(expfmt.FmtText))
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("scraping metrics of [%s]: %w", replica.Name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("scraping metrics of [%s]: unexpected status %s", replica.Name, resp.Status)
+	}
+
+	result, err := parseMetrics(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("parsing metrics of [%s]: %w", replica.Name, err)
+	}
+	return result, nil
+}
+
+// parseMetrics parses metrics in the Prometheus text format into a value per series. Summaries and histograms are
+// reduced to their sum and count.
+func parseMetrics(r io.Reader) (map[string]float64, error) {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(r)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]float64{}
+	for name, family := range families {
+		for _, metric := range family.GetMetric() {
+			labels := seriesLabels(metric.GetLabel())
+			switch family.GetType() {
+			case dto.MetricType_COUNTER:
+				result[name+labels] = metric.GetCounter().GetValue()
+			case dto.MetricType_GAUGE:
+				result[name+labels] = metric.GetGauge().GetValue()
+			case dto.MetricType_UNTYPED:
+				result[name+labels] = metric.GetUntyped().GetValue()
+			case dto.MetricType_SUMMARY:
+				result[name+"_sum"+labels] = metric.GetSummary().GetSampleSum()
+				result[name+"_count"+labels] = float64(metric.GetSummary().GetSampleCount())
+			case dto.MetricType_HISTOGRAM, dto.MetricType_GAUGE_HISTOGRAM:
+				result[name+"_sum"+labels] = metric.GetHistogram().GetSampleSum()
+				result[name+"_count"+labels] = float64(metric.GetHistogram().GetSampleCount())
+			}
+		}
+	}
+	return result, nil
+}
+
+func seriesLabels(labels []*dto.LabelPair) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	pairs := make([]string, 0, len(labels))
+	for _, label := range labels {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", label.GetName(), label.GetValue()))
+	}
+	sort.Strings(pairs)
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func sortedSeries(sample map[string]float64) []string {
+	names := make([]string, 0, len(sample))
+	for name := range sample {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func summarizeMetric(container, metric string, values []float64) *MetricSummary {
+	summary := &MetricSummary{
+		Container: container,
+		Metric:    metric,
+		Min:       math.Inf(1),
+		Max:       math.Inf(-1),
+		Last:      values[len(values)-1],
+		Trend:     sparkline(values),
+		Values:    values,
+	}
+	for _, v := range values {
+		summary.Min = math.Min(summary.Min, v)
+		summary.Max = math.Max(summary.Max, v)
+	}
+	return summary
+}
+
+var sparks = []rune("▁▂▃▄▅▆▇█")
+
+// sparkline returns a line of block characters whose heights follow the values, scaled between their min and max.
+func sparkline(values []float64) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	min, max := values[0], values[0]
+	for _, v := range values {
+		min = math.Min(min, v)
+		max = math.Max(max, v)
+	}
+
+	var b strings.Builder
+	for _, v := range values {
+		i := 0
+		if max > min {
+			i = int((v - min) / (max - min) * float64(len(sparks)-1))
+		}
+		b.WriteRune(sparks[i])
+	}
+	return b.String()
+}
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMetrics(t *testing.T) {
+	values, err := parseMetrics(strings.NewReader(`# TYPE http_requests_total counter
+http_requests_total{method="GET",code="200"} 12
+http_requests_total{code="500",method="GET"} 1
+# TYPE queue_depth gauge
+queue_depth 3.5
+# TYPE request_seconds histogram
+request_seconds_bucket{le="0.5"} 4
+request_seconds_bucket{le="+Inf"} 5
+request_seconds_sum 1.75
+request_seconds_count 5
+`))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, map[string]float64{
+		`http_requests_total{code="200",method="GET"}`: 12,
+		`http_requests_total{code="500",method="GET"}`: 1,
+		"queue_depth":           3.5,
+		"request_seconds_sum":   1.75,
+		"request_seconds_count": 5,
+	}, values)
+
+	_, err = parseMetrics(strings.NewReader("not a metric line"))
+	assert.Error(t, err)
+}
+
+func TestSparkline(t *testing.T) {
+	assert.Equal(t, "", sparkline(nil))
+	assert.Equal(t, "▁▁▁", sparkline([]float64{2, 2, 2}))
+	assert.Equal(t, "▁▄█▁", sparkline([]float64{0, 5, 10, 0}))
+
+	summary := summarizeMetric("app.web-1", "queue_depth", []float64{3, 1, 2})
+	assert.Equal(t, float64(1), summary.Min)
+	assert.Equal(t, float64(3), summary.Max)
+	assert.Equal(t, float64(2), summary.Last)
+	assert.Equal(t, "█▁▄", summary.Trend)
+}
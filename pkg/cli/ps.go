@@ -25,7 +25,7 @@ type Ps struct {
 	All         bool   `usage:"Include stopped apps" short:"a"`
 	AllProjects bool   `usage:"Include all projects in same Acorn instance as the current default project" short:"A"`
 	Quiet       bool   `usage:"Output only names" short:"q"`
-	Output      string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	Output      string `usage:"Output format (json, yaml, wide, {{gotemplate}})" short:"o"`
 	client      ClientFactory
 }
 
@@ -43,7 +43,12 @@ func (a *Ps) Run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	out := table.NewWriter(tables.App, a.Quiet, a.Output)
+	values, output := tables.App, a.Output
+	if output == "wide" {
+		// wide adds the CPU and memory used to the table
+		values, output = tables.AppWide, ""
+	}
+	out := table.NewWriter(values, a.Quiet, output)
 
 	if len(args) == 1 {
 		app, err := c.AppGet(cmd.Context(), args[0])
@@ -5,10 +5,14 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/cli/testdata"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestApp(t *testing.T) {
@@ -71,6 +75,72 @@ func TestApp(t *testing.T) {
 			wantOut: "NAME      IMAGE     COMMIT    CREATED    ENDPOINTS   MESSAGE\n" +
 				"found                         292y ago               \n",
 		},
+		{
+			name: "acorn app scheduled", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{
+					AppList: []apiv1.App{{
+						ObjectMeta: metav1.ObjectMeta{Name: "scheduled"},
+						Status: apiv1.AppStatus{
+							Columns: v1.AppColumns{Message: "OK"},
+							Schedule: &v1.AppScheduleStatus{
+								NextAction:     v1.AppScheduleActionStop,
+								NextTransition: metav1.NewTime(time.Now().Add(3*time.Hour + 30*time.Second)),
+							},
+						},
+					}},
+				},
+				StdOut: w,
+				StdErr: w,
+				StdIn:  strings.NewReader(""),
+			},
+			args: args{
+				args:   []string{},
+				client: &testdata.MockClient{},
+			},
+			wantErr: false,
+			wantOut: "NAME        IMAGE     COMMIT    CREATED    ENDPOINTS   MESSAGE\n" +
+				"scheduled                       292y ago               OK; scheduled stop 3h from now\n",
+		},
+		{
+			name: "acorn app wide", fields: fields{
+				All:    false,
+				Quiet:  false,
+				Output: "",
+			},
+			commandContext: CommandContext{
+				ClientFactory: &testdata.MockClientFactory{
+					AppList: []apiv1.App{{
+						ObjectMeta: metav1.ObjectMeta{Name: "busy"},
+						Status: apiv1.AppStatus{
+							AppStatus: v1.AppStatus{
+								Usage: &v1.Usage{
+									CPU:               250,
+									Memory:            240 << 20,
+									MemoryLimit:       256 << 20,
+									NearMemoryLimit:   true,
+									OOMKilledRestarts: 3,
+								},
+							},
+						},
+					}},
+				},
+				StdOut: w,
+				StdErr: w,
+				StdIn:  strings.NewReader(""),
+			},
+			args: args{
+				args:   []string{"-o", "wide"},
+				client: &testdata.MockClient{},
+			},
+			wantErr: false,
+			wantOut: "NAME      IMAGE     COMMIT    CREATED    ENDPOINTS   CPU       MEMORY                                                          MESSAGE\n" +
+				"busy                          292y ago               250m      240Mi/256Mi (near limit) (last restart OOMKilled, 3 restarts)   \n",
+		},
 		{
 			name: "acorn app dne", fields: fields{
 				All:    false,
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"github.com/spf13/cobra"
+)
+
+func NewRollback(c CommandContext) *cobra.Command {
+	return cli.Command(&Rollback{client: c.ClientFactory}, cobra.Command{
+		Use: "rollback [flags] ACORN_NAME [REVISION]",
+		Example: `
+# Roll back to the previous revision
+acorn rollback my-app
+
+# Roll back to a specific revision
+acorn rollback my-app 3
+
+# List the recorded revisions of an app
+acorn rollback --list my-app`,
+		SilenceUsage:      true,
+		Short:             "Roll back an app to a previous revision",
+		Args:              cobra.RangeArgs(1, 2),
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type Rollback struct {
+	List   bool   `usage:"List the recorded revisions of the app instead of rolling back" short:"l"`
+	Quiet  bool   `usage:"Output only names" short:"q"`
+	Output string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	client ClientFactory
+}
+
+func (a *Rollback) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	if a.List {
+		if len(args) > 1 {
+			return fmt.Errorf("a revision cannot be specified with --list")
+		}
+
+		revisions, err := c.AppRevisionList(cmd.Context(), args[0])
+		if err != nil {
+			return err
+		}
+
+		out := table.NewWriter(tables.AppRevision, a.Quiet, a.Output)
+		for _, revision := range revisions {
+			out.Write(&revision)
+		}
+		return out.Err()
+	}
+
+	var revision int64
+	if len(args) > 1 {
+		revision, err = strconv.ParseInt(args[1], 10, 64)
+		if err != nil || revision < 1 {
+			return fmt.Errorf("invalid revision %q: must be a positive integer", args[1])
+		}
+	}
+
+	if _, err := c.AppRollback(cmd.Context(), args[0], revision); err != nil {
+		return fmt.Errorf("rolling back %s: %w", args[0], err)
+	}
+
+	fmt.Println(args[0])
+	return nil
+}
@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRollback(t *testing.T) {
+	type args struct {
+		cmd  *cobra.Command
+		args []string
+	}
+	var _, w, _ = os.Pipe()
+	commandContext := CommandContext{
+		ClientFactory: &testdata.MockClientFactory{},
+		StdOut:        w,
+		StdErr:        w,
+		StdIn:         strings.NewReader(""),
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+		wantOut string
+	}{
+		{
+			name: "acorn rollback found",
+			args: args{
+				args: []string{"found"},
+			},
+			wantOut: "found\n",
+		},
+		{
+			name: "acorn rollback found 1",
+			args: args{
+				args: []string{"found", "1"},
+			},
+			wantOut: "found\n",
+		},
+		{
+			name: "acorn rollback found 5",
+			args: args{
+				args: []string{"found", "5"},
+			},
+			wantErr: true,
+			wantOut: "rolling back found: revision 5 not found for app found",
+		},
+		{
+			name: "acorn rollback found invalid",
+			args: args{
+				args: []string{"found", "invalid"},
+			},
+			wantErr: true,
+			wantOut: "invalid revision \"invalid\": must be a positive integer",
+		},
+		{
+			name: "acorn rollback dne",
+			args: args{
+				args: []string{"dne"},
+			},
+			wantErr: true,
+			wantOut: "rolling back dne: error: app dne does not exist",
+		},
+		{
+			name: "acorn rollback -l -q found",
+			args: args{
+				args: []string{"-l", "-q", "found"},
+			},
+			wantOut: "found-2\nfound-1\n",
+		},
+		{
+			name: "acorn rollback -l found 1",
+			args: args{
+				args: []string{"-l", "found", "1"},
+			},
+			wantErr: true,
+			wantOut: "a revision cannot be specified with --list",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			tt.args.cmd = NewRollback(commandContext)
+			tt.args.cmd.SetArgs(tt.args.args)
+			err := tt.args.cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else {
+				w.Close()
+				out, _ := io.ReadAll(r)
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
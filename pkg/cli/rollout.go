@@ -0,0 +1,27 @@
+package cli
+
+import (
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/spf13/cobra"
+)
+
+func NewRollout(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&Rollout{}, cobra.Command{
+		Use: "rollout [flags] command",
+		Example: `
+acorn rollout promote my-app
+
+acorn rollout abort my-app web`,
+		SilenceUsage: true,
+		Short:        "Manage progressive rollouts of containers",
+	})
+	cmd.AddCommand(NewRolloutPromote(c), NewRolloutAbort(c))
+	return cmd
+}
+
+type Rollout struct {
+}
+
+func (a *Rollout) Run(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
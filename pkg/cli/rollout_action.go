@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"fmt"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/spf13/cobra"
+)
+
+func NewRolloutPromote(c CommandContext) *cobra.Command {
+	return cli.Command(&RolloutAction{client: c.ClientFactory, action: v1.RolloutActionPromote}, cobra.Command{
+		Use: "promote [flags] ACORN_NAME [CONTAINER_NAME...]",
+		Example: `
+# Promote all rollouts in progress for an app
+acorn rollout promote my-app
+
+# Promote the rollout of a single container
+acorn rollout promote my-app web`,
+		SilenceUsage:      true,
+		Short:             "Advance a paused rollout to its next step, or switch traffic to the new version",
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+func NewRolloutAbort(c CommandContext) *cobra.Command {
+	return cli.Command(&RolloutAction{client: c.ClientFactory, action: v1.RolloutActionAbort}, cobra.Command{
+		Use: "abort [flags] ACORN_NAME [CONTAINER_NAME...]",
+		Example: `
+# Abort all rollouts in progress for an app
+acorn rollout abort my-app
+
+# Abort the rollout of a single container
+acorn rollout abort my-app web`,
+		SilenceUsage:      true,
+		Short:             "Abort a rollout and return all traffic to the stable version",
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, appsCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type RolloutAction struct {
+	client ClientFactory
+	action v1.RolloutAction
+}
+
+func (a *RolloutAction) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	if err := c.AppRollout(cmd.Context(), args[0], a.action, args[1:]...); err != nil {
+		return fmt.Errorf("%s rollout of %s: %w", a.action, args[0], err)
+	}
+
+	fmt.Println(args[0])
+	return nil
+}
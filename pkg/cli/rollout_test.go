@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRollout(t *testing.T) {
+	type args struct {
+		cmd  *cobra.Command
+		args []string
+	}
+	var _, w, _ = os.Pipe()
+	commandContext := CommandContext{
+		ClientFactory: &testdata.MockClientFactory{},
+		StdOut:        w,
+		StdErr:        w,
+		StdIn:         strings.NewReader(""),
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+		wantOut string
+	}{
+		{
+			name: "acorn rollout promote found",
+			args: args{
+				args: []string{"promote", "found"},
+			},
+			wantOut: "found\n",
+		},
+		{
+			name: "acorn rollout abort found found",
+			args: args{
+				args: []string{"abort", "found", "found"},
+			},
+			wantOut: "found\n",
+		},
+		{
+			name: "acorn rollout promote found other",
+			args: args{
+				args: []string{"promote", "found", "other"},
+			},
+			wantErr: true,
+			wantOut: "promote rollout of found: container other of app found does not have a rollout in progress",
+		},
+		{
+			name: "acorn rollout abort dne",
+			args: args{
+				args: []string{"abort", "dne"},
+			},
+			wantErr: true,
+			wantOut: "abort rollout of dne: error: app dne does not exist",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			tt.args.cmd = NewRollout(commandContext)
+			tt.args.cmd.SetArgs(tt.args.args)
+			err := tt.args.cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else {
+				w.Close()
+				out, _ := io.ReadAll(r)
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
@@ -7,28 +7,44 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/autoupgrade"
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/acorn-io/runtime/pkg/compose"
 	"github.com/acorn-io/runtime/pkg/dev"
 	"github.com/acorn-io/runtime/pkg/imagerules"
 	"github.com/acorn-io/runtime/pkg/imagesource"
+	"github.com/acorn-io/runtime/pkg/preview"
 	"github.com/acorn-io/runtime/pkg/rulerequest"
+	"github.com/acorn-io/runtime/pkg/vcs"
 	"github.com/acorn-io/runtime/pkg/wait"
 	"github.com/acorn-io/z"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/yaml"
 )
 
+const (
+	// previewHead is the value of --preview without a branch, which previews the branch checked out
+	previewHead = "HEAD"
+	// dryRunClient is the value of --dry-run without a strategy, which prints the app instead of creating it
+	dryRunClient = "client"
+	// dryRunServer is the value of --dry-run that validates the app on the server, printing the app the server would
+	// create or update it to
+	dryRunServer = "server"
+)
+
 func NewRun(c CommandContext) *cobra.Command {
-	cmd := cli.Command(&Run{out: c.StdOut, client: c.ClientFactory}, cobra.Command{
+	cmd := cli.Command(&Run{out: c.StdOut, err: c.StdErr, client: c.ClientFactory}, cobra.Command{
 		Use:               "run [flags] IMAGE|DIRECTORY [acorn args]",
 		SilenceUsage:      true,
 		Short:             "Run an app from an image or Acornfile",
@@ -64,6 +80,29 @@ Publish Port Syntax
  - Publish container "myapp" using the hostname app.example.com
 	acorn run --publish app.example.com:myapp .
 
+ - Publish container "myapp" only to clients in 10.0.0.0/8 that log in with the username and password of secret "creds"
+	acorn run --publish app.example.com:myapp,basic-auth=creds,allow=10.0.0.0/8 .
+
+ - Publish port 80 behind an OIDC proxy and limit clients to 10 requests per second with bursts of 20
+	acorn run -p 80,forward-auth=http://oauth2-proxy.auth.svc/oauth2/auth,rate-limit=10,burst=20 .
+
+Dry Run
+ - Print the app that would be created without creating it
+	acorn run --dry-run .
+
+ - Check whether the server would accept the app, validating its image, region, compute classes, volume classes and permissions, without creating it
+	acorn run --dry-run=server .
+
+ - Check whether the server would accept updating an app without updating it
+	acorn run --dry-run=server --update --name my-app .
+
+ - Estimate the CPU, memory, storage, monthly cost and quota of the app without creating it
+	acorn run --dry-run --estimate .
+
+Docker Compose
+ - Run a Docker Compose file, translated to an Acornfile like 'acorn import compose' does
+	acorn run --compose docker-compose.yml
+
 Link Syntax
  - Link the running acorn application named "mydatabase" into the current app, replacing the container named "db"
 	acorn run --link mydatabase:db .
@@ -92,6 +131,8 @@ Volume Syntax
 		cmd.Printf("Error registering completion function for --region flag: %v\n", err)
 	}
 	cmd.Flags().SetInterspersed(false)
+	cmd.PersistentFlags().Lookup("preview").NoOptDefVal = previewHead
+	cmd.PersistentFlags().Lookup("dry-run").NoOptDefVal = dryRunClient
 
 	cmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
 		fmt.Println(cmd.Short + "\n")
@@ -102,21 +143,27 @@ Volume Syntax
 
 type Run struct {
 	RunArgs
-	Dev               bool  `usage:"Enable interactive dev mode: build image, stream logs/status in the foreground and stop on exit" short:"i"`
-	BidirectionalSync bool  `usage:"In interactive mode download changes in addition to uploading" short:"b"`
-	Wait              *bool `usage:"Wait for app to become ready before command exiting (default: true)"`
-	Quiet             bool  `usage:"Do not print status" short:"q"`
-	Update            bool  `usage:"Update the app if it already exists" short:"u"`
-	Replace           bool  `usage:"Replace the app with only defined values, resetting undefined fields to default values" json:"replace,omitempty"` // Replace sets patchMode to false, resulting in a full update, resetting all undefined fields to their defaults
+	Dev               bool   `usage:"Enable interactive dev mode: build image, stream logs/status in the foreground and stop on exit" short:"i"`
+	BidirectionalSync bool   `usage:"In interactive mode download changes in addition to uploading" short:"b"`
+	Wait              *bool  `usage:"Wait for app to become ready before command exiting (default: true)"`
+	Quiet             bool   `usage:"Do not print status" short:"q"`
+	Update            bool   `usage:"Update the app if it already exists" short:"u"`
+	Replace           bool   `usage:"Replace the app with only defined values, resetting undefined fields to default values" json:"replace,omitempty"` // Replace sets patchMode to false, resulting in a full update, resetting all undefined fields to their defaults
+	DryRun            string `usage:"Do not create or update the app, print it in the format of --output (yaml by default) instead. With server the app is validated by the server first (client, server)"`
+	Estimate          bool   `usage:"Print the CPU, memory, storage, monthly cost and quota the app would use instead of creating it, in the format of --output (table by default)"`
+	Compose           string `usage:"Run a Docker Compose file instead of an Acornfile, translating it like 'acorn import compose'"`
 
 	out    io.Writer
+	err    io.Writer
 	client ClientFactory
 }
 
 type RunArgs struct {
 	UpdateArgs
-	EnvFile string `usage:"Default env vars to apply" default:".acorn.env"`
-	Name    string `usage:"Name of app to create" short:"n"`
+	EnvFile    string  `usage:"Default env vars to apply" default:".acorn.env"`
+	Name       string  `usage:"Name of app to create" short:"n"`
+	Preview    *string `usage:"Run the preview environment of a git branch, the branch checked out by default. The app is named after the branch and --name or the repository, and reuses the secrets and clones the volumes of the app with that name"`
+	PreviewTTL string  `name:"preview-ttl" usage:"Remove the preview after not being deployed for this long, 0 to keep it" default:"72h"`
 }
 
 func (s RunArgs) ToOpts() (client.AppRunOptions, error) {
@@ -182,6 +229,14 @@ func (s RunArgs) ToOpts() (client.AppRunOptions, error) {
 		return opts, err
 	}
 
+	if s.StopSchedule != "" || s.StartSchedule != "" {
+		opts.Schedule = &v1.AppSchedule{
+			Stop:     s.StopSchedule,
+			Start:    s.StartSchedule,
+			TimeZone: s.TimeZone,
+		}
+	}
+
 	if s.PublishAll != nil && *s.PublishAll {
 		opts.PublishMode = v1.PublishModeAll
 	} else if s.PublishAll != nil && !*s.PublishAll {
@@ -191,6 +246,138 @@ func (s RunArgs) ToOpts() (client.AppRunOptions, error) {
 	return opts, nil
 }
 
+// previewOpts changes opts to run the preview environment of the branch given with --preview. Secrets bound to the base
+// app are bound to the preview too, and its volumes are cloned from snapshots when the preview is first created.
+func (s RunArgs) previewOpts(ctx context.Context, c client.Client, imageSource imagesource.ImageSource, opts client.AppRunOptions) (client.AppRunOptions, error) {
+	if s.Preview == nil {
+		return opts, nil
+	}
+
+	ttl := preview.DefaultTTL
+	if s.PreviewTTL != "" {
+		var err error
+		if ttl, err = time.ParseDuration(s.PreviewTTL); err != nil {
+			return opts, fmt.Errorf("invalid --preview-ttl [%s]: %w", s.PreviewTTL, err)
+		}
+	}
+
+	dir := "."
+	if _, file, err := imageSource.ResolveImageAndFile(); err == nil && file != "" {
+		dir = file
+	}
+	repository, branch := vcs.Worktree(dir)
+	if *s.Preview != "" && *s.Preview != previewHead {
+		branch = *s.Preview
+	}
+	if preview.Slug(branch) == "" {
+		return opts, fmt.Errorf("--preview requires a branch name when not run from a branch of a git repository")
+	}
+
+	base := s.Name
+	if base == "" {
+		base = repository
+	}
+	if preview.Slug(base) == "" {
+		return opts, fmt.Errorf("--name is required for --preview when not run from a git repository")
+	}
+
+	opts.Name = preview.AppName(base, branch)
+	opts.Preview = &v1.AppPreview{
+		Branch:         branch,
+		BaseApp:        base,
+		TimeoutSeconds: int32(ttl.Seconds()),
+		RenewTime:      metav1.Now(),
+	}
+	for i := range opts.Publish {
+		opts.Publish[i].Hostname = preview.Hostname(opts.Publish[i].Hostname, branch)
+	}
+
+	// Only clone the base app when the preview is created, an existing preview is just updated
+	if _, err := c.AppGet(ctx, opts.Name); err == nil || !apierrors.IsNotFound(err) {
+		return opts, err
+	}
+
+	baseApp, err := c.AppGet(ctx, base)
+	if apierrors.IsNotFound(err) {
+		return opts, nil
+	} else if err != nil {
+		return opts, err
+	}
+
+	boundSecrets := map[string]bool{}
+	for _, binding := range opts.Secrets {
+		boundSecrets[binding.Target] = true
+	}
+	for _, binding := range baseApp.Spec.Secrets {
+		if !boundSecrets[binding.Target] {
+			opts.Secrets = append(opts.Secrets, binding)
+		}
+	}
+
+	if len(opts.Publish) == 0 {
+		for _, binding := range baseApp.Spec.Publish {
+			binding.Hostname = preview.Hostname(binding.Hostname, branch)
+			opts.Publish = append(opts.Publish, binding)
+		}
+	}
+
+	boundVolumes := map[string]bool{}
+	for _, binding := range opts.Volumes {
+		boundVolumes[binding.Target] = true
+	}
+	volumes, err := c.VolumeList(ctx)
+	if err != nil {
+		return opts, err
+	}
+	for _, volume := range volumes {
+		if volume.Status.AppPublicName != baseApp.Name || boundVolumes[volume.Status.VolumeName] {
+			continue
+		}
+		snapshotName := preview.SnapshotName(opts.Name, volume.Status.VolumeName)
+		if _, err := c.VolumeSnapshotCreate(ctx, volume.Name, snapshotName); err != nil && !apierrors.IsAlreadyExists(err) {
+			return opts, err
+		}
+		opts.Volumes = append(opts.Volumes, v1.VolumeBinding{
+			Target:   volume.Status.VolumeName,
+			Snapshot: snapshotName,
+		})
+	}
+
+	return opts, nil
+}
+
+// composeAcornfile translates the Compose file of --compose and writes the Acornfile to a temporary file.
+func (s *Run) composeAcornfile() (string, error) {
+	result, err := compose.ReadFile(s.Compose)
+	if err != nil {
+		return "", err
+	}
+
+	errOut := s.err
+	if errOut == nil {
+		errOut = os.Stderr
+	}
+	if err := printComposeNotes(errOut, result); err != nil {
+		return "", err
+	}
+
+	acornfile, err := result.Acornfile()
+	if err != nil {
+		return "", err
+	}
+
+	f, err := os.CreateTemp("", "Acornfile-")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err := f.Write(acornfile); err != nil {
+		return "", err
+	}
+	return f.Name(), nil
+}
+
 func (s *Run) Run(cmd *cobra.Command, args []string) (err error) {
 	defer func() {
 		if errors.Is(err, pflag.ErrHelp) {
@@ -198,6 +385,23 @@ func (s *Run) Run(cmd *cobra.Command, args []string) (err error) {
 		}
 	}()
 
+	if s.DryRun != "" && s.DryRun != dryRunClient && s.DryRun != dryRunServer {
+		return fmt.Errorf("invalid --dry-run [%s], must be %s or %s", s.DryRun, dryRunClient, dryRunServer)
+	}
+
+	if s.Compose != "" {
+		if s.File != "" || len(args) > 0 {
+			return fmt.Errorf("--compose can not be combined with --file or IMAGE|DIRECTORY, the directory of the Compose file is used")
+		}
+		file, err := s.composeAcornfile()
+		if err != nil {
+			return err
+		}
+		defer os.Remove(file)
+		s.File = file
+		args = []string{filepath.Dir(s.Compose)}
+	}
+
 	c, err := s.client.CreateDefault()
 	if err != nil {
 		return err
@@ -225,6 +429,20 @@ func (s *Run) Run(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
+	opts, err = s.previewOpts(cmd.Context(), c, imageSource, opts)
+	if err != nil {
+		return err
+	}
+	if opts.Preview != nil {
+		s.Name = opts.Name
+		// Deploying the preview again updates it
+		if _, err := c.AppGet(cmd.Context(), opts.Name); err == nil {
+			s.Update = true
+		} else if !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
 	if s.Dev {
 		return dev.Dev(cmd.Context(), c, &dev.Options{
 			ImageSource:       imageSource,
@@ -246,13 +464,22 @@ func (s *Run) Run(cmd *cobra.Command, args []string) (err error) {
 	}()
 
 	if s.Replace || s.Update {
-		if s.Output != "" {
-			return fmt.Errorf("--output can not be combined with --update or --replace")
+		if s.Output != "" && s.DryRun != dryRunServer {
+			return fmt.Errorf("--output can not be combined with --update or --replace unless --dry-run=server is set")
+		}
+		if s.DryRun == dryRunClient || s.Estimate {
+			return fmt.Errorf("--dry-run=client and --estimate can not be combined with --update or --replace")
 		}
 		app, updated, err = s.update(cmd.Context(), c, imageSource, opts)
 		if err != nil {
 			return err
 		}
+		if updated && s.DryRun == dryRunServer {
+			// The app was not updated, so there is nothing to wait for
+			result := app
+			app = nil
+			return outputApp(s.out, s.Output, result)
+		}
 		if updated {
 			fmt.Println(app.Name)
 			return nil
@@ -286,7 +513,25 @@ func (s *Run) Run(cmd *cobra.Command, args []string) (err error) {
 	opts.DeployArgs = deployArgs
 	opts.Profiles = profiles
 
-	if s.Output != "" {
+	if s.Estimate {
+		app := client.ToApp(c.GetNamespace(), image, &opts)
+		estimate, err := c.AppEstimate(cmd.Context(), app.Name, &app.Spec)
+		if err != nil {
+			return err
+		}
+		return printAppEstimate(s.Output, estimate)
+	}
+
+	if s.DryRun == dryRunServer {
+		opts.DryRun = true
+		app, err := c.AppRun(cmd.Context(), image, &opts)
+		if err != nil {
+			return err
+		}
+		return outputApp(s.out, s.Output, app)
+	}
+
+	if s.Output != "" || s.DryRun != "" {
 		app := client.ToApp(c.GetNamespace(), image, &opts)
 		return outputApp(s.out, s.Output, app)
 	}
@@ -332,7 +577,13 @@ func (s *Run) update(ctx context.Context, c client.Client, imageSource imagesour
 		}
 	}
 
-	app, err = rulerequest.PromptUpdate(ctx, c, s.Dangerous, app.Name, updateOpts)
+	if s.DryRun == dryRunServer {
+		// Don't prompt to grant permissions or allow images, a dry run should only report what the server rejects
+		updateOpts.DryRun = true
+		app, err = c.AppUpdate(ctx, app.Name, &updateOpts)
+	} else {
+		app, err = rulerequest.PromptUpdate(ctx, c, s.Dangerous, app.Name, updateOpts)
+	}
 	if err != nil {
 		return nil, false, err
 	}
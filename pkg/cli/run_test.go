@@ -15,9 +15,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/acorn-io/runtime/pkg/imagesource"
 	"github.com/acorn-io/runtime/pkg/mocks"
+	"github.com/acorn-io/z"
 	"github.com/golang/mock/gomock"
 	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -40,6 +44,49 @@ func TestRunArgs_Env(t *testing.T) {
 	assert.Equal(t, "1", opts.Env[1].Value)
 }
 
+func TestRunArgs_Preview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mClient := mocks.NewMockClient(ctrl)
+	mClient.EXPECT().AppGet(gomock.Any(), "found-feature-login").Return(nil, apierrors.NewNotFound(schema.GroupResource{Resource: "apps"}, "found-feature-login"))
+	mClient.EXPECT().AppGet(gomock.Any(), "found").Return(&apiv1.App{
+		ObjectMeta: metav1.ObjectMeta{Name: "found"},
+		Spec: v1.AppInstanceSpec{
+			Secrets: []v1.SecretBinding{{Secret: "found.secret", Target: "found"}, {Secret: "found.creds", Target: "creds"}},
+			Publish: []v1.PortBinding{{Hostname: "app.example.com", TargetServiceName: "web"}},
+		},
+	}, nil)
+	mClient.EXPECT().VolumeList(gomock.Any()).Return([]apiv1.Volume{
+		{ObjectMeta: metav1.ObjectMeta{Name: "pvc-1"}, Status: apiv1.VolumeStatus{AppPublicName: "found", VolumeName: "data"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "pvc-2"}, Status: apiv1.VolumeStatus{AppPublicName: "other", VolumeName: "data"}},
+	}, nil)
+	mClient.EXPECT().VolumeSnapshotCreate(gomock.Any(), "pvc-1", "found-feature-login-preview-data").Return(&apiv1.VolumeSnapshot{}, nil)
+
+	runArgs := RunArgs{
+		UpdateArgs: UpdateArgs{
+			Secret: []string{"mine:creds"},
+		},
+		Name:    "found",
+		Preview: z.Pointer("feature/login"),
+	}
+	opts, err := runArgs.ToOpts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts, err = runArgs.previewOpts(context.Background(), mClient, imagesource.ImageSource{}, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "found-feature-login", opts.Name)
+	assert.Equal(t, "feature/login", opts.Preview.Branch)
+	assert.Equal(t, "found", opts.Preview.BaseApp)
+	assert.Equal(t, int32(72*60*60), opts.Preview.TimeoutSeconds)
+	assert.Equal(t, []v1.SecretBinding{{Secret: "mine", Target: "creds"}, {Secret: "found.secret", Target: "found"}}, opts.Secrets)
+	assert.Equal(t, []v1.PortBinding{{Hostname: "feature-login-app.example.com", TargetServiceName: "web"}}, opts.Publish)
+	assert.Equal(t, []v1.VolumeBinding{{Target: "data", Snapshot: "found-feature-login-preview-data"}}, opts.Volumes)
+}
+
 func TestRun(t *testing.T) {
 	baseMock := func(f *mocks.MockClient) {
 		f.EXPECT().AppGet(gomock.Any(), gomock.Any()).DoAndReturn(
@@ -222,6 +269,41 @@ func TestRun(t *testing.T) {
 				f.EXPECT().Info(gomock.Any()).Return([]apiv1.Info{{}}, nil)
 			},
 		},
+		{
+			name: "acorn run --dry-run=local found",
+			args: args{
+				args: []string{"--dry-run=local", "found"},
+			},
+			wantErr: true,
+			wantOut: "invalid --dry-run [local], must be client or server",
+		},
+		{
+			name: "acorn run --compose docker-compose.yml found",
+			args: args{
+				args: []string{"--compose", "docker-compose.yml", "found"},
+			},
+			wantErr: true,
+			wantOut: "--compose can not be combined with --file or IMAGE|DIRECTORY, the directory of the Compose file is used",
+		},
+		{
+			name: "acorn run --dry-run=server --update --name found",
+			args: args{
+				args: []string{"--dry-run=server", "--update", "--name", "found"},
+			},
+			wantOut: "metadata:\n  name: found\nspec:\n  secrets:\n  - secret: found.secret\n    target: found\n",
+			prepare: func(t *testing.T, f *mocks.MockClient) {
+				t.Helper()
+				f.EXPECT().Info(gomock.Any()).Return([]apiv1.Info{{}}, nil)
+				f.EXPECT().AppUpdate(gomock.Any(), "found", gomock.Any()).DoAndReturn(
+					func(ctx context.Context, name string, opts *client.AppUpdateOptions) (*apiv1.App, error) {
+						assert.True(t, opts.DryRun)
+						return &apiv1.App{
+							ObjectMeta: metav1.ObjectMeta{Name: name},
+							Spec:       v1.AppInstanceSpec{Secrets: []v1.SecretBinding{{Secret: "found.secret", Target: "found"}}},
+						}, nil
+					})
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
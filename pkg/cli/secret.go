@@ -24,6 +24,7 @@ acorn secret`,
 	cmd.AddCommand(NewSecretReveal(c))
 	cmd.AddCommand(NewSecretEncrypt(c))
 	cmd.AddCommand(NewSecretEdit(c))
+	cmd.AddCommand(NewSecretKey(c))
 	return cmd
 }
 
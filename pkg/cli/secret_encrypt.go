@@ -7,9 +7,11 @@ import (
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	cli "github.com/acorn-io/runtime/pkg/cli/builder"
 	"github.com/acorn-io/runtime/pkg/cli/builder/table"
 	"github.com/acorn-io/runtime/pkg/encryption/nacl"
+	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -84,9 +86,7 @@ func (e *Encrypt) Run(cmd *cobra.Command, args []string) error {
 		}
 		for _, info := range fullInfo {
 			for _, region := range info.Regions {
-				for _, key := range region.PublicKeys {
-					e.PublicKey = append(e.PublicKey, key.KeyID)
-				}
+				e.PublicKey = append(e.PublicKey, encryptionKeys(region.PublicKeys)...)
 			}
 		}
 	}
@@ -105,3 +105,20 @@ func (e *Encrypt) Run(cmd *cobra.Command, args []string) error {
 
 	return out.Err()
 }
+
+// encryptionKeys returns the keys new data should be encrypted with. If the project has rotated its key, only the
+// primary key is used so that the previous keys can be retired.
+func encryptionKeys(keys []apiv1.EncryptionKey) (result []string) {
+	for _, key := range keys {
+		if key.Annotations[labels.AcornEncryptionKeyPrimary] == "true" {
+			result = append(result, key.KeyID)
+		}
+	}
+	if len(result) > 0 {
+		return result
+	}
+	for _, key := range keys {
+		result = append(result, key.KeyID)
+	}
+	return result
+}
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"github.com/spf13/cobra"
+)
+
+func NewSecretKey(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&SecretKey{}, cobra.Command{
+		Use: "key [flags] command",
+		Example: `
+acorn secret key list
+
+acorn secret key rotate`,
+		SilenceUsage: true,
+		Short:        "Manage the keys secrets are encrypted with",
+	})
+	cmd.AddCommand(NewSecretKeyList(c), NewSecretKeyRotate(c), NewSecretKeyRetire(c))
+	return cmd
+}
+
+type SecretKey struct {
+}
+
+func (a *SecretKey) Run(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
+
+func NewSecretKeyList(c CommandContext) *cobra.Command {
+	return cli.Command(&SecretKeyList{client: c.ClientFactory}, cobra.Command{
+		Use:     "list [flags]",
+		Aliases: []string{"ls"},
+		Example: `
+acorn secret key list`,
+		SilenceUsage: true,
+		Short:        "List the encryption keys of the project and the apps that depend on them",
+		Args:         cobra.NoArgs,
+	})
+}
+
+type SecretKeyList struct {
+	Quiet  bool   `usage:"Output only names" short:"q"`
+	Output string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	client ClientFactory
+}
+
+func (a *SecretKeyList) Run(cmd *cobra.Command, _ []string) error {
+	client, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	keys, err := client.SecretKeyList(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	out := table.NewWriter(tables.SecretKey, a.Quiet, a.Output)
+	for _, key := range keys {
+		out.Write(&key)
+	}
+
+	return out.Err()
+}
@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"fmt"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/spf13/cobra"
+)
+
+func NewSecretKeyRetire(c CommandContext) *cobra.Command {
+	return cli.Command(&SecretKeyRetire{client: c.ClientFactory}, cobra.Command{
+		Use: "retire [flags] KEY_NAME...",
+		Example: `
+acorn secret key retire gC5e2dnx8-j4IOPRqXZ3vBzX6XB8u2I4k0VrCNcnTiY`,
+		SilenceUsage: true,
+		Short:        "Remove encryption keys, data encrypted only with a retired key can no longer be decrypted",
+		Args:         cobra.MinimumNArgs(1),
+	})
+}
+
+type SecretKeyRetire struct {
+	client ClientFactory
+}
+
+func (a *SecretKeyRetire) Run(cmd *cobra.Command, args []string) error {
+	client, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	for _, name := range args {
+		if _, err := client.SecretKeyRetire(cmd.Context(), name); err != nil {
+			return fmt.Errorf("retiring %s: %w", name, err)
+		}
+		fmt.Println(name)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"fmt"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/spf13/cobra"
+)
+
+func NewSecretKeyRotate(c CommandContext) *cobra.Command {
+	return cli.Command(&SecretKeyRotate{client: c.ClientFactory}, cobra.Command{
+		Use: "rotate [flags]",
+		Example: `
+acorn secret key rotate`,
+		SilenceUsage: true,
+		Short:        "Generate a new primary encryption key, existing keys are kept for decryption until retired",
+		Args:         cobra.NoArgs,
+	})
+}
+
+type SecretKeyRotate struct {
+	client ClientFactory
+}
+
+func (a *SecretKeyRotate) Run(cmd *cobra.Command, _ []string) error {
+	client, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	key, err := client.SecretKeyRotate(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("rotating key: %w", err)
+	}
+
+	fmt.Println(key.Name)
+	return nil
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretKey(t *testing.T) {
+	type args struct {
+		cmd  *cobra.Command
+		args []string
+	}
+	var _, w, _ = os.Pipe()
+	commandContext := CommandContext{
+		ClientFactory: &testdata.MockClientFactory{},
+		StdOut:        w,
+		StdErr:        w,
+		StdIn:         strings.NewReader(""),
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+		wantOut string
+	}{
+		{
+			name: "acorn secret key list -q",
+			args: args{
+				args: []string{"list", "-q"},
+			},
+			wantOut: "new-key\nold-key\n",
+		},
+		{
+			name: "acorn secret key rotate",
+			args: args{
+				args: []string{"rotate"},
+			},
+			wantOut: "new-key\n",
+		},
+		{
+			name: "acorn secret key retire old-key",
+			args: args{
+				args: []string{"retire", "old-key"},
+			},
+			wantOut: "old-key\n",
+		},
+		{
+			name: "acorn secret key retire dne",
+			args: args{
+				args: []string{"retire", "dne"},
+			},
+			wantErr: true,
+			wantOut: "retiring dne: error: secretkeys.api.acorn.io \"dne\" not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			tt.args.cmd = NewSecretKey(commandContext)
+			tt.args.cmd.SetArgs(tt.args.args)
+			err := tt.args.cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else {
+				w.Close()
+				out, _ := io.ReadAll(r)
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
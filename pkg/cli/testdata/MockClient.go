@@ -207,6 +207,84 @@ func (m *MockClient) AppIgnoreDeleteCleanup(context.Context, string) error {
 	return nil
 }
 
+func (m *MockClient) AppRevisionList(_ context.Context, name string) ([]apiv1.AppRevision, error) {
+	switch name {
+	case "dne":
+		return nil, fmt.Errorf("error: app %s does not exist", name)
+	case "found":
+		return []apiv1.AppRevision{{
+			ObjectMeta: metav1.ObjectMeta{Name: "found-2"},
+			Spec: v1.AppRevisionInstanceSpec{
+				AppName:  "found",
+				Revision: 2,
+			},
+		}, {
+			ObjectMeta: metav1.ObjectMeta{Name: "found-1"},
+			Spec: v1.AppRevisionInstanceSpec{
+				AppName:  "found",
+				Revision: 1,
+			},
+		}}, nil
+	}
+	return nil, nil
+}
+
+func (m *MockClient) AppRollback(_ context.Context, name string, revision int64) (*apiv1.App, error) {
+	switch name {
+	case "dne":
+		return nil, fmt.Errorf("error: app %s does not exist", name)
+	case "found":
+		if revision > 2 {
+			return nil, fmt.Errorf("revision %d not found for app %s", revision, name)
+		}
+		return &apiv1.App{ObjectMeta: metav1.ObjectMeta{Name: "found"}}, nil
+	}
+	return nil, fmt.Errorf("error: app %s does not exist", name)
+}
+
+func (m *MockClient) AppRollout(_ context.Context, name string, _ v1.RolloutAction, containers ...string) error {
+	switch name {
+	case "found":
+		for _, container := range containers {
+			if container != "found" {
+				return fmt.Errorf("container %s of app %s does not have a rollout in progress", container, name)
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("error: app %s does not exist", name)
+}
+
+func (m *MockClient) AppEstimate(_ context.Context, name string, _ *v1.AppInstanceSpec) (*apiv1.AppEstimate, error) {
+	if name != "found" {
+		return nil, fmt.Errorf("error: app %s does not exist", name)
+	}
+	cost, storageCost, totalCost := 7.5, 1.0, 8.5
+	return &apiv1.AppEstimate{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Workloads: []apiv1.WorkloadEstimate{{
+			Name:         "web",
+			ComputeClass: "default",
+			Replicas:     2,
+			CPU:          250,
+			Memory:       256 << 20,
+			MonthlyCost:  &cost,
+		}},
+		Volumes: []apiv1.VolumeEstimate{{
+			Name:        "data",
+			VolumeClass: "fast",
+			Size:        10 << 30,
+			MonthlyCost: &storageCost,
+		}},
+		Total: apiv1.ResourceEstimate{
+			CPU:         500,
+			Memory:      512 << 20,
+			Storage:     10 << 30,
+			MonthlyCost: &totalCost,
+		},
+	}, nil
+}
+
 func (m *MockClient) AppGet(_ context.Context, name string) (*apiv1.App, error) {
 	if m.AppItem != nil {
 		return m.AppItem, nil
@@ -461,6 +539,34 @@ func (m *MockClient) SecretUpdate(context.Context, string, map[string][]byte) (*
 	return nil, nil
 }
 
+func (m *MockClient) SecretKeyList(_ context.Context) ([]apiv1.SecretKey, error) {
+	return []apiv1.SecretKey{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "new-key"},
+			Primary:    true,
+		},
+		{
+			ObjectMeta:   metav1.ObjectMeta{Name: "old-key"},
+			ReferencedBy: []string{"found"},
+		},
+	}, nil
+}
+
+func (m *MockClient) SecretKeyRotate(_ context.Context) (*apiv1.SecretKey, error) {
+	return &apiv1.SecretKey{
+		ObjectMeta: metav1.ObjectMeta{Name: "new-key"},
+		Primary:    true,
+	}, nil
+}
+
+func (m *MockClient) SecretKeyRetire(_ context.Context, name string) (*apiv1.SecretKey, error) {
+	switch name {
+	case "old-key":
+		return &apiv1.SecretKey{ObjectMeta: metav1.ObjectMeta{Name: name}}, nil
+	}
+	return nil, fmt.Errorf("error: secretkeys.api.acorn.io \"%s\" not found", name)
+}
+
 func (m *MockClient) SecretDelete(_ context.Context, name string) (*apiv1.Secret, error) {
 	if m.SecretItem != nil {
 		return m.SecretItem, nil
@@ -651,6 +757,45 @@ func (m *MockClient) VolumeDelete(_ context.Context, name string) (*apiv1.Volume
 	return nil, nil
 }
 
+func (m *MockClient) VolumeSnapshotCreate(_ context.Context, volumeName, name string) (*apiv1.VolumeSnapshot, error) {
+	switch volumeName {
+	case "volume", "found.vol":
+		if name == "" {
+			name = "found-vol-abcde"
+		}
+		return &apiv1.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       v1.VolumeSnapshotInstanceSpec{Volume: "volume", AppName: "found", VolumeName: "vol"},
+		}, nil
+	}
+	return nil, fmt.Errorf("error: volumes \"%s\" not found", volumeName)
+}
+
+func (m *MockClient) VolumeSnapshotList(_ context.Context) ([]apiv1.VolumeSnapshot, error) {
+	return []apiv1.VolumeSnapshot{{
+		ObjectMeta: metav1.ObjectMeta{Name: "snap"},
+		Spec:       v1.VolumeSnapshotInstanceSpec{Volume: "volume", AppName: "found", VolumeName: "vol"},
+		Status:     v1.VolumeSnapshotInstanceStatus{Method: v1.VolumeSnapshotMethodCSI, Ready: true, Size: "10G"},
+	}}, nil
+}
+
+func (m *MockClient) VolumeSnapshotGet(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	snapshots, _ := m.VolumeSnapshotList(ctx)
+	for _, snapshot := range snapshots {
+		if snapshot.Name == name {
+			return &snapshot, nil
+		}
+	}
+	return nil, fmt.Errorf("error: volumesnapshots.api.acorn.io \"%s\" not found", name)
+}
+
+func (m *MockClient) VolumeSnapshotDelete(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	if snapshot, err := m.VolumeSnapshotGet(ctx, name); err == nil {
+		return snapshot, nil
+	}
+	return nil, nil
+}
+
 func (m *MockClient) ImageList(_ context.Context) ([]apiv1.Image, error) {
 	if m.Images != nil {
 		return m.Images, nil
@@ -37,7 +37,7 @@ type UpdateArgs struct {
 	Secret        []string `usage:"Bind an existing secret (format existing:sec-name) (ex: sec-name:app-secret)" short:"s"`
 	Link          []string `usage:"Link external app as a service in the current app (format app-name:container-name)"`
 	PublishAll    *bool    `usage:"Publish all (true) or none (false) of the defined ports of application" short:"P"`
-	Publish       []string `usage:"Publish port of application (format [public:]private) (ex 81:80)" short:"p"`
+	Publish       []string `usage:"Publish port of application (format [public:]private[,option=value]) (ex 81:80, app.example.com:web,basic-auth=creds)" short:"p"`
 	Env           []string `usage:"Environment variables to set on running containers" short:"e"`
 	Label         []string `usage:"Add labels to the app and the resources it creates (format [type:][name:]key=value) (ex k=v, containers:k=v)" short:"l"`
 	Annotation    []string `usage:"Add annotations to the app and the resources it creates (format [type:][name:]key=value) (ex k=v, containers:k=v)"`
@@ -48,6 +48,9 @@ type UpdateArgs struct {
 	Interval      string   `usage:"If configured for auto-upgrade, this is the time interval at which to check for new releases (ex: 1h, 5m)"`
 	Memory        []string `usage:"Set memory for a workload in the format of workload=memory. Only specify an amount to set all workloads. (ex foo=512Mi or 512Mi)" short:"m"`
 	ComputeClass  []string `usage:"Set computeclass for a workload in the format of workload=computeclass. Specify a single computeclass to set all workloads. (ex foo=example-class or example-class)"`
+	StopSchedule  string   `usage:"Cron schedule to stop the app on (ex \"0 20 * * 1-5\")"`
+	StartSchedule string   `usage:"Cron schedule to start the app on (ex \"0 7 * * 1-5\")"`
+	TimeZone      string   `name:"schedule-time-zone" usage:"Time zone of the stop and start schedules (ex America/Chicago), defaults to UTC"`
 }
 
 type Update struct {
@@ -0,0 +1,36 @@
+package cli
+
+import (
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/volume"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/spf13/cobra"
+)
+
+func NewVolumeArchive() *cobra.Command {
+	return cli.Command(&VolumeArchive{}, cobra.Command{
+		Use:          "archive [flags] REF DIR",
+		SilenceUsage: true,
+		Hidden:       true,
+		Short:        "Push the content of a volume to a registry, or restore it from there",
+		Args:         cobra.ExactArgs(2),
+	})
+}
+
+type VolumeArchive struct {
+	Restore  bool `usage:"Extract the archive into the directory if it is empty instead of pushing it"`
+	Insecure bool `usage:"Allow plain http registries"`
+}
+
+func (a *VolumeArchive) Run(cmd *cobra.Command, args []string) error {
+	var opts []name.Option
+	if a.Insecure {
+		opts = append(opts, name.Insecure)
+	}
+
+	ref, dir := args[0], args[1]
+	if a.Restore {
+		return volume.PullArchive(cmd.Context(), ref, dir, opts...)
+	}
+	return volume.PushArchive(cmd.Context(), dir, ref, opts...)
+}
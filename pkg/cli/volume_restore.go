@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"fmt"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/client"
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func NewVolumeRestore(c CommandContext) *cobra.Command {
+	return cli.Command(&VolumeRestore{client: c.ClientFactory}, cobra.Command{
+		Use: "restore [flags] SNAPSHOT_NAME APP_NAME",
+		Example: `
+# Restore a snapshot into the volume it was taken of after the volume was removed
+acorn volume restore my-app-data-x5z9d my-app
+
+# Restore a snapshot into a different volume of the app
+acorn volume restore --volume restored my-app-data-x5z9d my-app`,
+		SilenceUsage: true,
+		Short:        "Restore a volume snapshot into a new volume of an app",
+		Long: `Restore a volume snapshot into a new volume of an app. The volume must not exist yet. A snapshot can also be
+restored into a volume of a new app with "acorn run -v VOLUME_NAME,snapshot=SNAPSHOT_NAME".`,
+		Args: cobra.ExactArgs(2),
+	})
+}
+
+type VolumeRestore struct {
+	Volume string `usage:"Name of the volume in the app to restore into, defaults to the volume the snapshot was taken of"`
+	client ClientFactory
+}
+
+func (a *VolumeRestore) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	snapshotName, appName := args[0], args[1]
+	snapshot, err := c.VolumeSnapshotGet(cmd.Context(), snapshotName)
+	if err != nil {
+		return err
+	}
+
+	target := a.Volume
+	if target == "" {
+		target = snapshot.Spec.VolumeName
+	}
+
+	existing, err := c.VolumeGet(cmd.Context(), appName+"."+target)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	} else if err == nil && existing != nil {
+		return fmt.Errorf("volume %s of app %s already exists, remove it before restoring a snapshot into it", target, appName)
+	}
+
+	if _, err := c.AppUpdate(cmd.Context(), appName, &client.AppUpdateOptions{
+		Volumes: []v1.VolumeBinding{
+			{
+				Target:   target,
+				Snapshot: snapshotName,
+			},
+		},
+	}); err != nil {
+		return err
+	}
+
+	fmt.Println(appName)
+	return nil
+}
@@ -0,0 +1,140 @@
+package cli
+
+import (
+	"fmt"
+
+	cli "github.com/acorn-io/runtime/pkg/cli/builder"
+	"github.com/acorn-io/runtime/pkg/cli/builder/table"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"github.com/spf13/cobra"
+	"k8s.io/utils/strings/slices"
+)
+
+func NewVolumeSnapshot(c CommandContext) *cobra.Command {
+	cmd := cli.Command(&VolumeSnapshot{}, cobra.Command{
+		Use: "snapshot [flags] command",
+		Example: `
+acorn volume snapshot create my-app.data
+
+acorn volume snapshot list`,
+		SilenceUsage: true,
+		Short:        "Manage volume snapshots",
+	})
+	cmd.AddCommand(NewVolumeSnapshotCreate(c), NewVolumeSnapshotList(c), NewVolumeSnapshotDelete(c))
+	return cmd
+}
+
+type VolumeSnapshot struct {
+}
+
+func (a *VolumeSnapshot) Run(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
+
+func NewVolumeSnapshotCreate(c CommandContext) *cobra.Command {
+	return cli.Command(&VolumeSnapshotCreate{client: c.ClientFactory}, cobra.Command{
+		Use: "create [flags] VOLUME_NAME",
+		Example: `
+acorn volume snapshot create my-app.data
+
+acorn volume snapshot create --name before-upgrade my-app.data`,
+		SilenceUsage:      true,
+		Short:             "Take a snapshot of a volume",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: newCompletion(c.ClientFactory, volumesCompletion).withShouldCompleteOptions(onlyNumArgs(1)).complete,
+	})
+}
+
+type VolumeSnapshotCreate struct {
+	Name   string `usage:"Name of the snapshot, generated if not set" short:"n"`
+	client ClientFactory
+}
+
+func (a *VolumeSnapshotCreate) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := c.VolumeSnapshotCreate(cmd.Context(), args[0], a.Name)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(snapshot.Name)
+	return nil
+}
+
+func NewVolumeSnapshotList(c CommandContext) *cobra.Command {
+	return cli.Command(&VolumeSnapshotList{client: c.ClientFactory}, cobra.Command{
+		Use:     "list [flags] [SNAPSHOT_NAME...]",
+		Aliases: []string{"ls"},
+		Example: `
+acorn volume snapshot list`,
+		SilenceUsage: true,
+		Short:        "List volume snapshots",
+	})
+}
+
+type VolumeSnapshotList struct {
+	Quiet  bool   `usage:"Output only names" short:"q"`
+	Output string `usage:"Output format (json, yaml, {{gotemplate}})" short:"o"`
+	client ClientFactory
+}
+
+func (a *VolumeSnapshotList) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	snapshots, err := c.VolumeSnapshotList(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	out := table.NewWriter(tables.VolumeSnapshot, a.Quiet, a.Output)
+	for _, snapshot := range snapshots {
+		if len(args) == 0 || slices.Contains(args, snapshot.Name) {
+			out.Write(&snapshot)
+		}
+	}
+
+	return out.Err()
+}
+
+func NewVolumeSnapshotDelete(c CommandContext) *cobra.Command {
+	return cli.Command(&VolumeSnapshotDelete{client: c.ClientFactory}, cobra.Command{
+		Use: "rm [flags] SNAPSHOT_NAME...",
+		Example: `
+acorn volume snapshot rm my-app-data-x5z9d`,
+		SilenceUsage: true,
+		Short:        "Delete volume snapshots",
+		Args:         cobra.MinimumNArgs(1),
+	})
+}
+
+type VolumeSnapshotDelete struct {
+	client ClientFactory
+}
+
+func (a *VolumeSnapshotDelete) Run(cmd *cobra.Command, args []string) error {
+	c, err := a.client.CreateDefault()
+	if err != nil {
+		return err
+	}
+
+	for _, name := range args {
+		deleted, err := c.VolumeSnapshotDelete(cmd.Context(), name)
+		if err != nil {
+			return fmt.Errorf("deleting %s: %w", name, err)
+		}
+		if deleted != nil {
+			fmt.Println(name)
+		} else {
+			fmt.Printf("Error: No such volume snapshot: %s\n", name)
+		}
+	}
+
+	return nil
+}
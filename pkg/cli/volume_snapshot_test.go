@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/cli/testdata"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVolumeSnapshot(t *testing.T) {
+	type args struct {
+		cmd  *cobra.Command
+		args []string
+	}
+	var _, w, _ = os.Pipe()
+	commandContext := CommandContext{
+		ClientFactory: &testdata.MockClientFactory{},
+		StdOut:        w,
+		StdErr:        w,
+		StdIn:         strings.NewReader(""),
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+		wantOut string
+	}{
+		{
+			name: "acorn volume snapshot create found.vol",
+			args: args{
+				args: []string{"snapshot", "create", "found.vol"},
+			},
+			wantOut: "found-vol-abcde\n",
+		},
+		{
+			name: "acorn volume snapshot create --name snap found.vol",
+			args: args{
+				args: []string{"snapshot", "create", "--name", "snap", "found.vol"},
+			},
+			wantOut: "snap\n",
+		},
+		{
+			name: "acorn volume snapshot create dne",
+			args: args{
+				args: []string{"snapshot", "create", "dne"},
+			},
+			wantErr: true,
+			wantOut: "error: volumes \"dne\" not found",
+		},
+		{
+			name: "acorn volume snapshot list -q",
+			args: args{
+				args: []string{"snapshot", "list", "-q"},
+			},
+			wantOut: "snap\n",
+		},
+		{
+			name: "acorn volume snapshot rm snap",
+			args: args{
+				args: []string{"snapshot", "rm", "snap"},
+			},
+			wantOut: "snap\n",
+		},
+		{
+			name: "acorn volume restore snap found",
+			args: args{
+				args: []string{"restore", "snap", "found"},
+			},
+			wantErr: true,
+			wantOut: "volume vol of app found already exists, remove it before restoring a snapshot into it",
+		},
+		{
+			name: "acorn volume restore --volume restored snap found",
+			args: args{
+				args: []string{"restore", "--volume", "restored", "snap", "found"},
+			},
+			wantOut: "found\n",
+		},
+		{
+			name: "acorn volume restore dne found",
+			args: args{
+				args: []string{"restore", "dne", "found"},
+			},
+			wantErr: true,
+			wantOut: "error: volumesnapshots.api.acorn.io \"dne\" not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			tt.args.cmd = NewVolume(commandContext)
+			tt.args.cmd.SetArgs(tt.args.args)
+			err := tt.args.cmd.Execute()
+			if err != nil && !tt.wantErr {
+				assert.Failf(t, "got err when err not expected", "got err: %s", err.Error())
+			} else if err != nil && tt.wantErr {
+				assert.Equal(t, tt.wantOut, err.Error())
+			} else {
+				w.Close()
+				out, _ := io.ReadAll(r)
+				assert.Equal(t, tt.wantOut, string(out))
+			}
+		})
+	}
+}
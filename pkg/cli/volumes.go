@@ -18,7 +18,7 @@ acorn volume`,
 		Short:             "Manage volumes",
 		ValidArgsFunction: newCompletion(c.ClientFactory, volumesCompletion).complete,
 	})
-	cmd.AddCommand(NewVolumeDelete(c))
+	cmd.AddCommand(NewVolumeDelete(c), NewVolumeSnapshot(c), NewVolumeRestore(c), NewVolumeArchive())
 	return cmd
 }
 
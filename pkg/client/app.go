@@ -68,6 +68,8 @@ func ToApp(namespace, image string, opts *AppRunOptions) *apiv1.App {
 			AutoUpgradeInterval: opts.AutoUpgradeInterval,
 			Memory:              opts.Memory,
 			ComputeClasses:      opts.ComputeClasses,
+			Preview:             opts.Preview,
+			Schedule:            opts.Schedule,
 		},
 	}
 }
@@ -83,7 +85,11 @@ func (c *DefaultClient) AppRun(ctx context.Context, image string, opts *AppRunOp
 		}
 	}
 	app := ToApp(c.Namespace, image, opts)
-	return app, translateErr(c.Client.Create(ctx, app))
+	var createOpts []kclient.CreateOption
+	if opts != nil && opts.DryRun {
+		createOpts = append(createOpts, kclient.DryRunAll)
+	}
+	return app, translateErr(c.Client.Create(ctx, app, createOpts...))
 }
 
 func (c *DefaultClient) AppUpdate(ctx context.Context, name string, opts *AppUpdateOptions) (result *apiv1.App, err error) {
@@ -171,6 +177,12 @@ func ToAppUpdate(ctx context.Context, c Client, name string, opts *AppUpdateOpti
 	if opts.Region != "" {
 		app.Spec.Region = opts.Region
 	}
+	if opts.Preview != nil {
+		app.Spec.Preview = opts.Preview
+	}
+	if opts.Schedule != nil {
+		app.Spec.Schedule = opts.Schedule
+	}
 
 	return app, nil
 }
@@ -230,11 +242,16 @@ func (c *DefaultClient) appUpdate(ctx context.Context, name string, opts *AppUpd
 				SessionRenewTime:      metav1.Now(),
 				SpecOverride:          &app.Spec,
 				Region:                app.GetRegion(),
+				ExpireAction:          opts.DevSessionExpireAction,
 			},
 		}))
 	}
 
-	return app, translateErr(c.Client.Update(ctx, app))
+	var updateOpts []kclient.UpdateOption
+	if opts.DryRun {
+		updateOpts = append(updateOpts, kclient.DryRunAll)
+	}
+	return app, translateErr(c.Client.Update(ctx, app, updateOpts...))
 }
 
 func translateErr(err error) error {
@@ -593,6 +610,50 @@ func (c *DefaultClient) AppConfirmUpgrade(ctx context.Context, name string) erro
 		Body(&apiv1.ConfirmUpgrade{}).Do(ctx).Error()
 }
 
+func (c *DefaultClient) AppRollout(ctx context.Context, name string, action v1.RolloutAction, containers ...string) error {
+	app := &apiv1.App{}
+	err := c.Client.Get(ctx, kclient.ObjectKey{
+		Name:      name,
+		Namespace: c.Namespace,
+	}, app)
+	if err != nil {
+		return err
+	}
+
+	return c.RESTClient.Post().
+		Namespace(app.Namespace).
+		Resource("apps").
+		Name(app.Name).
+		SubResource("rollout").
+		Body(&apiv1.AppRollout{
+			Action:     action,
+			Containers: containers,
+		}).Do(ctx).Error()
+}
+
+func (c *DefaultClient) AppEstimate(ctx context.Context, name string, spec *v1.AppInstanceSpec) (*apiv1.AppEstimate, error) {
+	if spec == nil {
+		app := &apiv1.App{}
+		if err := c.Client.Get(ctx, kclient.ObjectKey{
+			Name:      name,
+			Namespace: c.Namespace,
+		}, app); err != nil {
+			return nil, err
+		}
+		name = app.Name
+	}
+
+	estimate := &apiv1.AppEstimate{
+		Spec: spec,
+	}
+	return estimate, c.RESTClient.Post().
+		Namespace(c.Namespace).
+		Resource("apps").
+		Name(name).
+		SubResource("estimate").
+		Body(estimate).Do(ctx).Into(estimate)
+}
+
 func (c *DefaultClient) AppInfo(ctx context.Context, name string) (string, error) {
 	app := &apiv1.App{}
 	err := c.Client.Get(ctx, kclient.ObjectKey{
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/tags"
+	imagename "github.com/google/go-containerregistry/pkg/name"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	klabels "k8s.io/apimachinery/pkg/labels"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (c *DefaultClient) AppRevisionList(ctx context.Context, name string) ([]apiv1.AppRevision, error) {
+	result := &apiv1.AppRevisionList{}
+	if err := c.Client.List(ctx, result, &kclient.ListOptions{
+		Namespace: c.Namespace,
+		LabelSelector: klabels.SelectorFromSet(map[string]string{
+			labels.AcornAppName: name,
+		}),
+	}); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(result.Items, func(i, j int) bool {
+		return result.Items[i].Spec.Revision > result.Items[j].Spec.Revision
+	})
+
+	return result.Items, nil
+}
+
+func (c *DefaultClient) AppRollback(ctx context.Context, name string, revision int64) (app *apiv1.App, err error) {
+	for i := 0; i < 5; i++ {
+		app, err = c.appRollback(ctx, name, revision)
+		if apierrors.IsConflict(err) {
+			continue
+		}
+		return
+	}
+	return
+}
+
+func (c *DefaultClient) appRollback(ctx context.Context, name string, revision int64) (*apiv1.App, error) {
+	app := &apiv1.App{}
+	if err := c.Client.Get(ctx, kclient.ObjectKey{
+		Name:      name,
+		Namespace: c.Namespace,
+	}, app); err != nil {
+		return nil, err
+	}
+
+	revisions, err := c.AppRevisionList(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := findRevision(name, revisions, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	// The whole spec is replaced in a single update, so the app never observes a partially restored revision.
+	// Stop is not part of a revision and is preserved as is.
+	stop := app.Spec.Stop
+	app.Spec = *target.Spec.AppSpec.DeepCopy()
+	app.Spec.Stop = stop
+	app.Spec.Image = revisionImage(target)
+
+	return app, c.Client.Update(ctx, app)
+}
+
+// findRevision returns the requested revision from revisions, which must be sorted newest first. If revision is
+// 0, then the revision before the latest is returned.
+func findRevision(name string, revisions []apiv1.AppRevision, revision int64) (*apiv1.AppRevision, error) {
+	if revision == 0 {
+		if len(revisions) < 2 {
+			return nil, fmt.Errorf("app %s does not have a previous revision to roll back to", name)
+		}
+		return &revisions[1], nil
+	}
+
+	for i := range revisions {
+		if revisions[i].Spec.Revision == revision {
+			return &revisions[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("revision %d not found for app %s", revision, name)
+}
+
+// revisionImage returns the image for a revision pinned to the digest that was running at that revision, so that
+// rolling back does not pick up a tag that has since moved.
+func revisionImage(revision *apiv1.AppRevision) string {
+	if revision.Spec.ImageID == "" || revision.Spec.ImageDigest == "" {
+		return revision.Spec.AppSpec.Image
+	}
+	if tags.SHAPattern.MatchString(revision.Spec.ImageID) {
+		return revision.Spec.ImageID
+	}
+	ref, err := imagename.ParseReference(revision.Spec.ImageID)
+	if err != nil {
+		return revision.Spec.AppSpec.Image
+	}
+	return ref.Context().Digest(revision.Spec.ImageDigest).String()
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/scheme"
 	"github.com/acorn-io/runtime/pkg/streams"
 	"github.com/acorn-io/runtime/pkg/system"
+	"github.com/acorn-io/runtime/pkg/tracing"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/client-go/rest"
@@ -48,7 +49,7 @@ func (f *Factory) Namespace(project, namespace string) Client {
 }
 
 func NewClientFactory(restConfig *rest.Config) (*Factory, error) {
-	k8sclient, err := k8sclient.New(restConfig)
+	k8sclient, err := k8sclient.New(tracing.Config(restConfig))
 	if err != nil {
 		return nil, err
 	}
@@ -58,7 +59,7 @@ func NewClientFactory(restConfig *rest.Config) (*Factory, error) {
 		return nil, err
 	}
 
-	cfg := rest.CopyConfig(restConfig)
+	cfg := tracing.Config(restConfig)
 	cfg.APIPath = "/apis"
 	cfg.GroupVersion = &apiv1.SchemeGroupVersion
 	restconfig.SetScheme(cfg, scheme.Scheme)
@@ -109,8 +110,12 @@ type AppUpdateOptions struct {
 	Memory                   v1.MemoryMap
 	ComputeClasses           v1.ComputeClassMap
 	Region                   string
+	Preview                  *v1.AppPreview
+	Schedule                 *v1.AppSchedule
 	DevSessionClient         *v1.DevSessionInstanceClient
 	DevSessionTimeoutSeconds int32
+	DevSessionExpireAction   *v1.DevSessionInstanceExpireAction
+	DryRun                   bool // DryRun validates the update on the server, returning the app it would result in without updating it
 }
 
 type ContainerLogsWriter interface {
@@ -143,6 +148,9 @@ type AppRunOptions struct {
 	AutoUpgradeInterval string
 	Memory              v1.MemoryMap
 	ComputeClasses      v1.ComputeClassMap
+	Preview             *v1.AppPreview
+	Schedule            *v1.AppSchedule
+	DryRun              bool // DryRun validates the app on the server, returning it without creating it
 }
 
 func (a AppRunOptions) ToUpdate() AppUpdateOptions {
@@ -165,11 +173,16 @@ func (a AppRunOptions) ToUpdate() AppUpdateOptions {
 		Memory:              a.Memory,
 		ComputeClasses:      a.ComputeClasses,
 		Region:              a.Region,
+		Preview:             a.Preview,
+		Schedule:            a.Schedule,
+		DryRun:              a.DryRun,
 	}
 }
 
 func (a AppUpdateOptions) ToRun() AppRunOptions {
 	return AppRunOptions{
+		Region:              a.Region,
+		Stop:                a.Stop,
 		Annotations:         a.Annotations,
 		Labels:              a.Labels,
 		PublishMode:         a.PublishMode,
@@ -186,6 +199,9 @@ func (a AppUpdateOptions) ToRun() AppRunOptions {
 		AutoUpgradeInterval: a.AutoUpgradeInterval,
 		Memory:              a.Memory,
 		ComputeClasses:      a.ComputeClasses,
+		Preview:             a.Preview,
+		Schedule:            a.Schedule,
+		DryRun:              a.DryRun,
 	}
 }
 
@@ -223,6 +239,15 @@ type Client interface {
 	AppConfirmUpgrade(ctx context.Context, name string) error
 	AppPullImage(ctx context.Context, name string) error
 	AppIgnoreDeleteCleanup(ctx context.Context, name string) error
+	AppRevisionList(ctx context.Context, name string) ([]apiv1.AppRevision, error)
+	// AppRollback restores the spec of the app to the given revision. A revision of 0 rolls back to the previous revision.
+	AppRollback(ctx context.Context, name string, revision int64) (*apiv1.App, error)
+	// AppRollout promotes or aborts the rollouts in progress for the given containers of the app. If no containers are
+	// given, all rollouts in progress are affected.
+	AppRollout(ctx context.Context, name string, action v1.RolloutAction, containers ...string) error
+	// AppEstimate estimates the resources, cost and quota of the app. If spec is set, the app is estimated as if it was
+	// deployed with that spec, and the app does not have to exist.
+	AppEstimate(ctx context.Context, name string, spec *v1.AppInstanceSpec) (*apiv1.AppEstimate, error)
 
 	DevSessionRenew(ctx context.Context, name string, client v1.DevSessionInstanceClient) error
 	DevSessionRelease(ctx context.Context, name string) error
@@ -240,6 +265,10 @@ type Client interface {
 	SecretUpdate(ctx context.Context, name string, data map[string][]byte) (*apiv1.Secret, error)
 	SecretDelete(ctx context.Context, name string) (*apiv1.Secret, error)
 
+	SecretKeyList(ctx context.Context) ([]apiv1.SecretKey, error)
+	SecretKeyRotate(ctx context.Context) (*apiv1.SecretKey, error)
+	SecretKeyRetire(ctx context.Context, name string) (*apiv1.SecretKey, error)
+
 	ContainerReplicaList(ctx context.Context, opts *ContainerReplicaListOptions) ([]apiv1.ContainerReplica, error)
 	ContainerReplicaGet(ctx context.Context, name string) (*apiv1.ContainerReplica, error)
 	ContainerReplicaDelete(ctx context.Context, name string) (*apiv1.ContainerReplica, error)
@@ -254,6 +283,11 @@ type Client interface {
 	VolumeGet(ctx context.Context, name string) (*apiv1.Volume, error)
 	VolumeDelete(ctx context.Context, name string) (*apiv1.Volume, error)
 
+	VolumeSnapshotCreate(ctx context.Context, volumeName, name string) (*apiv1.VolumeSnapshot, error)
+	VolumeSnapshotList(ctx context.Context) ([]apiv1.VolumeSnapshot, error)
+	VolumeSnapshotGet(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error)
+	VolumeSnapshotDelete(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error)
+
 	ImageList(ctx context.Context) ([]apiv1.Image, error)
 	ImageGet(ctx context.Context, name string) (*apiv1.Image, error)
 	ImageDelete(ctx context.Context, name string, opts *ImageDeleteOptions) (*apiv1.Image, []string, error) // returns the modified/deleted image and a list of deleted tags
@@ -115,6 +115,34 @@ func (d *DeferredClient) AppIgnoreDeleteCleanup(ctx context.Context, name string
 	return d.Client.AppIgnoreDeleteCleanup(ctx, name)
 }
 
+func (d *DeferredClient) AppRevisionList(ctx context.Context, name string) ([]apiv1.AppRevision, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.AppRevisionList(ctx, name)
+}
+
+func (d *DeferredClient) AppRollback(ctx context.Context, name string, revision int64) (*apiv1.App, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.AppRollback(ctx, name, revision)
+}
+
+func (d *DeferredClient) AppRollout(ctx context.Context, name string, action v1.RolloutAction, containers ...string) error {
+	if err := d.create(); err != nil {
+		return err
+	}
+	return d.Client.AppRollout(ctx, name, action, containers...)
+}
+
+func (d *DeferredClient) AppEstimate(ctx context.Context, name string, spec *v1.AppInstanceSpec) (*apiv1.AppEstimate, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.AppEstimate(ctx, name, spec)
+}
+
 func (d *DeferredClient) DevSessionRenew(ctx context.Context, name string, client v1.DevSessionInstanceClient) error {
 	if err := d.create(); err != nil {
 		return err
@@ -206,6 +234,27 @@ func (d *DeferredClient) SecretDelete(ctx context.Context, name string) (*apiv1.
 	return d.Client.SecretDelete(ctx, name)
 }
 
+func (d *DeferredClient) SecretKeyList(ctx context.Context) ([]apiv1.SecretKey, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.SecretKeyList(ctx)
+}
+
+func (d *DeferredClient) SecretKeyRotate(ctx context.Context) (*apiv1.SecretKey, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.SecretKeyRotate(ctx)
+}
+
+func (d *DeferredClient) SecretKeyRetire(ctx context.Context, name string) (*apiv1.SecretKey, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.SecretKeyRetire(ctx, name)
+}
+
 func (d *DeferredClient) ContainerReplicaList(ctx context.Context, opts *ContainerReplicaListOptions) ([]apiv1.ContainerReplica, error) {
 	if err := d.create(); err != nil {
 		return nil, err
@@ -283,6 +332,34 @@ func (d *DeferredClient) VolumeDelete(ctx context.Context, name string) (*apiv1.
 	return d.Client.VolumeDelete(ctx, name)
 }
 
+func (d *DeferredClient) VolumeSnapshotCreate(ctx context.Context, volumeName, name string) (*apiv1.VolumeSnapshot, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.VolumeSnapshotCreate(ctx, volumeName, name)
+}
+
+func (d *DeferredClient) VolumeSnapshotList(ctx context.Context) ([]apiv1.VolumeSnapshot, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.VolumeSnapshotList(ctx)
+}
+
+func (d *DeferredClient) VolumeSnapshotGet(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.VolumeSnapshotGet(ctx, name)
+}
+
+func (d *DeferredClient) VolumeSnapshotDelete(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	if err := d.create(); err != nil {
+		return nil, err
+	}
+	return d.Client.VolumeSnapshotDelete(ctx, name)
+}
+
 func (d *DeferredClient) ImageList(ctx context.Context) ([]apiv1.Image, error) {
 	if err := d.create(); err != nil {
 		return nil, err
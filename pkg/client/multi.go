@@ -233,6 +233,31 @@ func (m *MultiClient) AppIgnoreDeleteCleanup(ctx context.Context, name string) e
 	return err
 }
 
+func (m *MultiClient) AppRevisionList(ctx context.Context, name string) ([]apiv1.AppRevision, error) {
+	return onOneList(ctx, m.Factory, name, func(name string, c Client) ([]apiv1.AppRevision, error) {
+		return c.AppRevisionList(ctx, name)
+	})
+}
+
+func (m *MultiClient) AppRollout(ctx context.Context, name string, action v1.RolloutAction, containers ...string) error {
+	_, err := onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.App, error) {
+		return &apiv1.App{}, c.AppRollout(ctx, name, action, containers...)
+	})
+	return err
+}
+
+func (m *MultiClient) AppEstimate(ctx context.Context, name string, spec *v1.AppInstanceSpec) (*apiv1.AppEstimate, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.AppEstimate, error) {
+		return c.AppEstimate(ctx, name, spec)
+	})
+}
+
+func (m *MultiClient) AppRollback(ctx context.Context, name string, revision int64) (*apiv1.App, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.App, error) {
+		return c.AppRollback(ctx, name, revision)
+	})
+}
+
 func (m *MultiClient) DevSessionRenew(ctx context.Context, name string, client v1.DevSessionInstanceClient) error {
 	_, err := onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.App, error) {
 		return &apiv1.App{}, c.DevSessionRenew(ctx, name, client)
@@ -313,6 +338,26 @@ func (m *MultiClient) SecretDelete(ctx context.Context, name string) (*apiv1.Sec
 	})
 }
 
+func (m *MultiClient) SecretKeyList(ctx context.Context) ([]apiv1.SecretKey, error) {
+	return aggregate(ctx, m.Factory, func(c Client) ([]apiv1.SecretKey, error) {
+		return c.SecretKeyList(ctx)
+	})
+}
+
+func (m *MultiClient) SecretKeyRotate(ctx context.Context) (*apiv1.SecretKey, error) {
+	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
+	if err != nil {
+		return nil, err
+	}
+	return c.SecretKeyRotate(ctx)
+}
+
+func (m *MultiClient) SecretKeyRetire(ctx context.Context, name string) (*apiv1.SecretKey, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.SecretKey, error) {
+		return c.SecretKeyRetire(ctx, name)
+	})
+}
+
 func (m *MultiClient) ContainerReplicaList(ctx context.Context, opts *ContainerReplicaListOptions) ([]apiv1.ContainerReplica, error) {
 	if opts != nil && opts.App != "" {
 		return onOneList(ctx, m.Factory, opts.App, func(name string, c Client) ([]apiv1.ContainerReplica, error) {
@@ -396,6 +441,30 @@ func (m *MultiClient) VolumeDelete(ctx context.Context, name string) (*apiv1.Vol
 	})
 }
 
+func (m *MultiClient) VolumeSnapshotCreate(ctx context.Context, volumeName, name string) (*apiv1.VolumeSnapshot, error) {
+	return onOne(ctx, m.Factory, volumeName, func(volumeName string, c Client) (*apiv1.VolumeSnapshot, error) {
+		return c.VolumeSnapshotCreate(ctx, volumeName, name)
+	})
+}
+
+func (m *MultiClient) VolumeSnapshotList(ctx context.Context) ([]apiv1.VolumeSnapshot, error) {
+	return aggregate(ctx, m.Factory, func(c Client) ([]apiv1.VolumeSnapshot, error) {
+		return c.VolumeSnapshotList(ctx)
+	})
+}
+
+func (m *MultiClient) VolumeSnapshotGet(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.VolumeSnapshot, error) {
+		return c.VolumeSnapshotGet(ctx, name)
+	})
+}
+
+func (m *MultiClient) VolumeSnapshotDelete(ctx context.Context, name string) (*apiv1.VolumeSnapshot, error) {
+	return onOne(ctx, m.Factory, name, func(name string, c Client) (*apiv1.VolumeSnapshot, error) {
+		return c.VolumeSnapshotDelete(ctx, name)
+	})
+}
+
 func (m *MultiClient) ImageList(ctx context.Context) ([]apiv1.Image, error) {
 	c, err := m.Factory.ForProject(ctx, m.Factory.DefaultProject())
 	if err != nil {
@@ -0,0 +1,48 @@
+package client
+
+import (
+	"context"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (c *DefaultClient) SecretKeyList(ctx context.Context) ([]apiv1.SecretKey, error) {
+	result := &apiv1.SecretKeyList{}
+	err := c.Client.List(ctx, result, &kclient.ListOptions{
+		Namespace: c.Namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Items, nil
+}
+
+func (c *DefaultClient) SecretKeyRotate(ctx context.Context) (*apiv1.SecretKey, error) {
+	key := &apiv1.SecretKey{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "key-",
+			Namespace:    c.Namespace,
+		},
+	}
+	return key, c.Client.Create(ctx, key)
+}
+
+func (c *DefaultClient) SecretKeyRetire(ctx context.Context, name string) (*apiv1.SecretKey, error) {
+	key := &apiv1.SecretKey{}
+	err := c.Client.Get(ctx, kclient.ObjectKey{
+		Name:      name,
+		Namespace: c.Namespace,
+	}, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, c.Client.Delete(ctx, &apiv1.SecretKey{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+		},
+	})
+}
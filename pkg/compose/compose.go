@@ -0,0 +1,544 @@
+package compose
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/acorn-io/aml"
+	"github.com/acorn-io/baaah/pkg/typed"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	// supportedKeys are the top level keys of a Compose file that are translated, or that have no meaning in an
+	// Acornfile and are dropped without a note
+	supportedKeys = map[string]bool{
+		"name":     true,
+		"services": true,
+		"secrets":  true,
+		"version":  true,
+		"volumes":  true,
+	}
+	supportedServiceKeys = map[string]bool{
+		"build":          true,
+		"command":        true,
+		"container_name": true,
+		"depends_on":     true,
+		"deploy":         true,
+		"entrypoint":     true,
+		"env_file":       true,
+		"environment":    true,
+		"expose":         true,
+		"healthcheck":    true,
+		"image":          true,
+		"labels":         true,
+		"ports":          true,
+		"restart":        true,
+		"scale":          true,
+		"secrets":        true,
+		"user":           true,
+		"volumes":        true,
+		"working_dir":    true,
+	}
+	invalidNameChars = regexp.MustCompile("[^a-z0-9-]+")
+)
+
+// Result is an Acornfile translated from a Docker Compose file.
+type Result struct {
+	AppSpec v1.AppSpec
+	// Notes describe the features of the Compose file that could not be translated
+	Notes []string
+}
+
+// ReadFile translates the Docker Compose file. Env files are read relative to the directory of the file, like Compose
+// does.
+func ReadFile(file string) (*Result, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	result, err := Translate(data, filepath.Dir(file))
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", file, err)
+	}
+	return result, nil
+}
+
+// Translate translates the contents of a Docker Compose file to an Acornfile. Services become containers, named volumes
+// become volumes and secrets become opaque secrets mounted where Compose mounts them. Env files are read relative to
+// dir.
+func Translate(data []byte, dir string) (*Result, error) {
+	var top map[string]any
+	if err := yaml.Unmarshal(data, &top); err != nil {
+		return nil, err
+	}
+	services, _ := top["services"].(map[string]any)
+
+	var f file
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, err
+	}
+
+	t := &translator{
+		dir: dir,
+		result: &Result{
+			AppSpec: v1.AppSpec{
+				Containers: map[string]v1.Container{},
+			},
+		},
+	}
+
+	for _, key := range typed.SortedKeys(top) {
+		if !supportedKeys[key] && !strings.HasPrefix(key, "x-") {
+			t.note("%s is not supported", key)
+		}
+	}
+	if strings.Contains(string(data), "${") {
+		t.note("variable substitution is not supported, values like ${VAR} are copied as they are")
+	}
+
+	for _, name := range typed.SortedKeys(f.Volumes) {
+		t.volume(name, f.Volumes[name])
+	}
+	for _, name := range typed.SortedKeys(f.Secrets) {
+		t.secret(name, f.Secrets[name])
+	}
+	for _, name := range typed.SortedKeys(f.Services) {
+		serviceKeys, _ := services[name].(map[string]any)
+		for _, key := range typed.SortedKeys(serviceKeys) {
+			if !supportedServiceKeys[key] && !strings.HasPrefix(key, "x-") {
+				t.note("service %s: %s is not supported", name, key)
+			}
+		}
+		deployKeys, _ := serviceKeys["deploy"].(map[string]any)
+		for _, key := range typed.SortedKeys(deployKeys) {
+			if key != "replicas" {
+				t.note("service %s: deploy.%s is not supported", name, key)
+			}
+		}
+		if err := t.service(name, f.Services[name]); err != nil {
+			return nil, fmt.Errorf("service %s: %w", name, err)
+		}
+	}
+
+	return t.result, nil
+}
+
+// Acornfile returns the Acornfile, formatted the same way as 'acorn fmt'.
+func (r *Result) Acornfile() ([]byte, error) {
+	data, err := json.MarshalIndent(toAcornfile(r.AppSpec), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return aml.Format(data)
+}
+
+// toAcornfile returns the fields of the app spec that a Compose file sets, written the way an Acornfile declares them.
+func toAcornfile(spec v1.AppSpec) map[string]any {
+	containers := map[string]any{}
+	for name, container := range spec.Containers {
+		c := map[string]any{}
+		set := func(key string, value any, isSet bool) {
+			if isSet {
+				c[key] = value
+			}
+		}
+
+		set("image", container.Image, container.Image != "")
+		set("build", container.Build, container.Build != nil)
+		set("entrypoint", container.Entrypoint, len(container.Entrypoint) > 0)
+		set("command", container.Command, len(container.Command) > 0)
+		set("workDir", container.WorkingDir, container.WorkingDir != "")
+		set("labels", container.Labels, len(container.Labels) > 0)
+		set("scale", container.Scale, container.Scale != nil)
+		set("probes", container.Probes, len(container.Probes) > 0)
+
+		if container.UserContext != nil {
+			c["user"] = fmt.Sprintf("%d:%d", container.UserContext.UID, container.UserContext.GID)
+		}
+
+		env := map[string]string{}
+		for _, e := range container.Environment {
+			env[e.Name] = e.Value
+		}
+		set("env", env, len(env) > 0)
+
+		ports := map[string][]string{}
+		for _, p := range container.Ports {
+			port := strconv.Itoa(int(p.TargetPort))
+			if p.Port != p.TargetPort {
+				port = strconv.Itoa(int(p.Port)) + ":" + port
+			}
+			if p.Protocol != "" {
+				port += "/" + string(p.Protocol)
+			}
+			if p.Publish {
+				ports["publish"] = append(ports["publish"], port)
+			} else {
+				ports["expose"] = append(ports["expose"], port)
+			}
+		}
+		set("ports", ports, len(ports) > 0)
+
+		dirs := map[string]string{}
+		for dir, mount := range container.Dirs {
+			if mount.ContextDir != "" {
+				dirs[dir] = mount.ContextDir
+			} else {
+				dirs[dir] = mount.Volume
+			}
+		}
+		set("dirs", dirs, len(dirs) > 0)
+
+		files := map[string]string{}
+		for file, f := range container.Files {
+			files[file] = fmt.Sprintf("secret://%s/%s", f.Secret.Name, f.Secret.Key)
+		}
+		set("files", files, len(files) > 0)
+
+		var deps []string
+		for _, dep := range container.Dependencies {
+			deps = append(deps, dep.TargetName)
+		}
+		set("dependsOn", deps, len(deps) > 0)
+
+		containers[name] = c
+	}
+
+	result := map[string]any{
+		"containers": containers,
+	}
+	if len(spec.Volumes) > 0 {
+		result["volumes"] = spec.Volumes
+	}
+	if len(spec.Secrets) > 0 {
+		result["secrets"] = spec.Secrets
+	}
+	return result
+}
+
+type translator struct {
+	dir    string
+	result *Result
+}
+
+func (t *translator) note(format string, args ...any) {
+	t.result.Notes = append(t.result.Notes, fmt.Sprintf(format, args...))
+}
+
+func (t *translator) volume(name string, vol *volume) {
+	if vol != nil && vol.External {
+		t.note("volume %s is external, bind an existing volume to it with --volume", name)
+	}
+	if t.result.AppSpec.Volumes == nil {
+		t.result.AppSpec.Volumes = map[string]v1.VolumeRequest{}
+	}
+	t.result.AppSpec.Volumes[toName(name)] = v1.VolumeRequest{}
+}
+
+func (t *translator) secret(name string, spec secretSpec) {
+	if t.result.AppSpec.Secrets == nil {
+		t.result.AppSpec.Secrets = map[string]v1.Secret{}
+	}
+	if spec.External {
+		external := name
+		if spec.Name != "" {
+			external = spec.Name
+		}
+		t.result.AppSpec.Secrets[toName(name)] = v1.Secret{
+			External: external,
+		}
+		return
+	}
+
+	switch {
+	case spec.File != "":
+		t.note("secret %s is read from the file %s, bind an existing secret to it with --secret", name, spec.File)
+	case spec.Environment != "":
+		t.note("secret %s is read from the environment variable %s, bind an existing secret to it with --secret", name, spec.Environment)
+	}
+	t.result.AppSpec.Secrets[toName(name)] = v1.Secret{
+		Type: "opaque",
+		Data: map[string]string{
+			"content": "",
+		},
+	}
+}
+
+func (t *translator) service(name string, svc service) error {
+	containerName := toName(name)
+	if containerName != name {
+		t.note("service %s is renamed to %s", name, containerName)
+	}
+
+	container := v1.Container{
+		Image:      svc.Image,
+		Command:    v1.CommandSlice(svc.Command),
+		Entrypoint: v1.CommandSlice(svc.Entrypoint),
+		WorkingDir: svc.WorkingDir,
+		Labels:     t.values(name, "label", svc.Labels),
+		Scale:      svc.Scale,
+	}
+	if svc.Deploy != nil && svc.Deploy.Replicas != nil {
+		container.Scale = svc.Deploy.Replicas
+	}
+
+	if svc.Build != nil {
+		container.Image = ""
+		container.Build = &v1.Build{
+			Context:   svc.Build.Context,
+			BuildArgs: t.values(name, "build arg", svc.Build.Args),
+			Target:    svc.Build.Target,
+		}
+		if container.Build.Context == "" {
+			container.Build.Context = "."
+		}
+		if svc.Build.Dockerfile != "" {
+			// Compose reads the Dockerfile relative to the context, Acorn relative to the Acornfile
+			container.Build.Dockerfile = path.Join(container.Build.Context, svc.Build.Dockerfile)
+		}
+	} else if container.Image == "" {
+		return fmt.Errorf("has neither an image nor a build")
+	}
+
+	if svc.User != "" {
+		user, err := toUser(svc.User)
+		if err != nil {
+			t.note("service %s: user %s is not supported, only numeric user and group IDs are", name, svc.User)
+		} else {
+			container.UserContext = user
+		}
+	}
+
+	env, err := t.environment(name, svc)
+	if err != nil {
+		return err
+	}
+	container.Environment = env
+
+	for _, p := range svc.Ports {
+		if def, ok := t.port(name, p, true); ok {
+			container.Ports = append(container.Ports, def)
+		}
+	}
+	for _, p := range svc.Expose {
+		if def, ok := t.port(name, p, false); ok {
+			container.Ports = append(container.Ports, def)
+		}
+	}
+
+	for _, mount := range svc.Volumes {
+		t.mount(name, &container, mount)
+	}
+
+	for _, mount := range svc.Secrets {
+		target := mount.Target
+		if target == "" {
+			target = mount.Source
+		}
+		if !path.IsAbs(target) {
+			target = path.Join("/run/secrets", target)
+		}
+		if _, ok := t.result.AppSpec.Secrets[toName(mount.Source)]; !ok {
+			t.secret(mount.Source, secretSpec{})
+		}
+		if container.Files == nil {
+			container.Files = v1.Files{}
+		}
+		container.Files[target] = v1.File{
+			Secret: v1.SecretReference{
+				Name: toName(mount.Source),
+				Key:  "content",
+			},
+		}
+	}
+
+	for _, dep := range svc.DependsOn {
+		container.Dependencies = append(container.Dependencies, v1.Dependency{
+			TargetName: toName(dep),
+		})
+	}
+
+	if svc.Healthcheck != nil {
+		probe, err := t.probe(name, svc.Healthcheck)
+		if err != nil {
+			return err
+		}
+		if probe != nil {
+			container.Probes = append(container.Probes, *probe)
+		}
+	}
+
+	t.result.AppSpec.Containers[containerName] = container
+	return nil
+}
+
+// values returns the values set, noting the names without a value, which Compose reads from the environment.
+func (t *translator) values(service, kind string, values mapOrList) map[string]string {
+	if len(values) == 0 {
+		return nil
+	}
+	result := map[string]string{}
+	for _, key := range typed.SortedKeys(values) {
+		if values[key] == nil {
+			t.note("service %s: %s %s is read from the environment, set it when running the app", service, kind, key)
+			continue
+		}
+		result[key] = *values[key]
+	}
+	return result
+}
+
+func (t *translator) environment(service string, svc service) (result v1.EnvVars, _ error) {
+	env := map[string]string{}
+	for _, envFile := range svc.EnvFile {
+		data, err := os.ReadFile(filepath.Join(t.dir, envFile))
+		if err != nil {
+			return nil, err
+		}
+		var lines []string
+		for _, line := range strings.Split(string(data), "\n") {
+			if !strings.HasPrefix(strings.TrimSpace(line), "#") {
+				lines = append(lines, line)
+			}
+		}
+		for _, nv := range v1.ParseNameValues(false, lines...) {
+			env[nv.Name] = strings.Trim(nv.Value, `"'`)
+		}
+	}
+
+	// Values in the environment override those of the env files
+	for k, v := range t.values(service, "environment variable", svc.Environment) {
+		env[k] = v
+	}
+
+	for _, entry := range typed.Sorted(env) {
+		result = append(result, v1.EnvVar{
+			Name:  entry.Key,
+			Value: entry.Value,
+		})
+	}
+	return result, nil
+}
+
+func (t *translator) port(service string, p port, publish bool) (v1.PortDef, bool) {
+	target, err := strconv.ParseInt(p.Target, 10, 32)
+	if err != nil {
+		t.note("service %s: port %s is not supported, only single ports are", service, p.Target)
+		return v1.PortDef{}, false
+	}
+
+	def := v1.PortDef{
+		Port:       int32(target),
+		TargetPort: int32(target),
+		Publish:    publish,
+	}
+	if p.Published != "" {
+		published, err := strconv.ParseInt(p.Published, 10, 32)
+		if err != nil {
+			t.note("service %s: published port %s is not supported, only single ports are", service, p.Published)
+			return v1.PortDef{}, false
+		}
+		def.Port = int32(published)
+	}
+	if p.Protocol == string(v1.ProtocolUDP) {
+		def.Protocol = v1.ProtocolUDP
+	}
+	return def, true
+}
+
+func (t *translator) mount(service string, container *v1.Container, mount volumeMount) {
+	if container.Dirs == nil {
+		container.Dirs = map[string]v1.VolumeMount{}
+	}
+
+	switch {
+	case mount.Type == "tmpfs" || (mount.Type == "volume" && mount.Source == ""):
+		container.Dirs[mount.Target] = v1.VolumeMount{
+			Volume: "ephemeral://",
+		}
+	case mount.Type == "volume":
+		volumeName := toName(mount.Source)
+		if _, ok := t.result.AppSpec.Volumes[volumeName]; !ok {
+			t.volume(mount.Source, nil)
+		}
+		container.Dirs[mount.Target] = v1.VolumeMount{
+			Volume: volumeName,
+		}
+	case mount.Type == "bind" && !filepath.IsAbs(mount.Source) && !strings.HasPrefix(mount.Source, "~") && !strings.HasPrefix(filepath.Clean(mount.Source), ".."):
+		container.Dirs[mount.Target] = v1.VolumeMount{
+			ContextDir: "./" + filepath.ToSlash(filepath.Clean(mount.Source)),
+		}
+		t.note("service %s: %s is copied into the image instead of bind mounted, use 'acorn dev' to sync changes to it", service, mount.Source)
+	default:
+		t.note("service %s: mounting %s is not supported, only volumes and directories next to the Compose file are", service, mount.Source)
+	}
+}
+
+func (t *translator) probe(service string, hc *healthcheck) (*v1.Probe, error) {
+	if hc.Disable || len(hc.Test) == 0 || hc.Test[0] == "NONE" {
+		return nil, nil
+	}
+
+	probe := &v1.Probe{
+		Type:             v1.ReadinessProbeType,
+		FailureThreshold: hc.Retries,
+	}
+	switch hc.Test[0] {
+	case "CMD":
+		probe.Exec = &v1.ExecProbe{Command: hc.Test[1:]}
+	case "CMD-SHELL":
+		probe.Exec = &v1.ExecProbe{Command: []string{"/bin/sh", "-c", strings.Join(hc.Test[1:], " ")}}
+	default:
+		probe.Exec = &v1.ExecProbe{Command: []string{"/bin/sh", "-c", strings.Join(hc.Test, " ")}}
+	}
+
+	for _, d := range []struct {
+		value string
+		field *int32
+	}{
+		{hc.Interval, &probe.PeriodSeconds},
+		{hc.Timeout, &probe.TimeoutSeconds},
+		{hc.StartPeriod, &probe.InitialDelaySeconds},
+	} {
+		if d.value == "" {
+			continue
+		}
+		duration, err := time.ParseDuration(d.value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid healthcheck duration %s: %w", d.value, err)
+		}
+		*d.field = int32(duration.Seconds())
+	}
+
+	return probe, nil
+}
+
+func toUser(user string) (*v1.UserContext, error) {
+	uid, gid, _ := strings.Cut(user, ":")
+	result := &v1.UserContext{}
+	var err error
+	if result.UID, err = strconv.ParseInt(uid, 10, 64); err != nil {
+		return nil, err
+	}
+	result.GID = result.UID
+	if gid != "" {
+		if result.GID, err = strconv.ParseInt(gid, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// toName returns the name of a Compose service, volume or secret as a valid name in an Acornfile.
+func toName(name string) string {
+	return strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
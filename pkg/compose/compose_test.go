@@ -0,0 +1,141 @@
+package compose
+
+import (
+	"os"
+	"testing"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/appdefinition"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadFile(t *testing.T) {
+	result, err := ReadFile("testdata/docker-compose.yml")
+	require.NoError(t, err)
+
+	acornfile, err := result.Acornfile()
+	require.NoError(t, err)
+	expected, err := os.ReadFile("testdata/Acornfile")
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(acornfile))
+
+	assert.Equal(t, []string{
+		"networks is not supported",
+		"secret db_password is read from the file ./db_password.txt, bind an existing secret to it with --secret",
+		"service web_app: networks is not supported",
+		"service web_app: deploy.resources is not supported",
+		"service web_app is renamed to web-app",
+		"service web_app: environment variable API_KEY is read from the environment, set it when running the app",
+		"service web_app: ./static is copied into the image instead of bind mounted, use 'acorn dev' to sync changes to it",
+		"service web_app: mounting /var/run/docker.sock is not supported, only volumes and directories next to the Compose file are",
+	}, result.Notes)
+
+	// The Acornfile is valid and means what the Compose file does
+	appDef, err := appdefinition.NewAppDefinition(acornfile)
+	require.NoError(t, err)
+	appSpec, err := appDef.AppSpec()
+	require.NoError(t, err)
+
+	web := appSpec.Containers["web-app"]
+	assert.Equal(t, "web/Dockerfile.prod", web.Build.Dockerfile)
+	assert.Equal(t, v1.EnvVars{
+		{Name: "LOG_LEVEL", Value: "debug"},
+		{Name: "PORT", Value: "3000"},
+	}, web.Environment)
+	assert.Equal(t, v1.PortDef{Port: 8080, TargetPort: 3000, Protocol: v1.ProtocolTCP, Publish: true}, web.Ports[0].Complete())
+	assert.Equal(t, v1.PortDef{Port: 9000, TargetPort: 9000, Protocol: v1.ProtocolUDP, Publish: true}, web.Ports[1].Complete())
+	assert.Equal(t, v1.Dependencies{{TargetName: "db"}}, web.Dependencies)
+	assert.Equal(t, "./static", web.Dirs["/app/static"].ContextDir)
+	assert.Equal(t, int32(2), *web.Scale)
+
+	db := appSpec.Containers["db"]
+	assert.Equal(t, "db-password", db.Files["/run/secrets/password"].Secret.Name)
+	assert.Equal(t, []string{"/bin/sh", "-c", "pg_isready -U postgres"}, db.Probes[0].Exec.Command)
+	assert.Equal(t, int32(10), db.Probes[0].PeriodSeconds)
+	assert.Contains(t, appSpec.Volumes, "db-data")
+}
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		name    string
+		compose string
+		check   func(t *testing.T, result *Result)
+		wantErr string
+	}{
+		{
+			name: "short syntax",
+			compose: `
+services:
+  app:
+    image: nginx
+    entrypoint: /docker-entrypoint.sh
+    command: ["nginx", "-g", "daemon off;"]
+    ports: ["127.0.0.1:80:8080", 443]
+    healthcheck:
+      test: curl -f http://localhost
+    x-custom: true
+    restart: always
+`,
+			check: func(t *testing.T, result *Result) {
+				app := result.AppSpec.Containers["app"]
+				assert.Equal(t, v1.CommandSlice{"/docker-entrypoint.sh"}, app.Entrypoint)
+				assert.Equal(t, v1.CommandSlice{"nginx", "-g", "daemon off;"}, app.Command)
+				assert.Equal(t, v1.Ports{
+					{Port: 80, TargetPort: 8080, Publish: true},
+					{Port: 443, TargetPort: 443, Publish: true},
+				}, app.Ports)
+				assert.Equal(t, []string{"/bin/sh", "-c", "curl -f http://localhost"}, app.Probes[0].Exec.Command)
+				assert.Empty(t, result.Notes)
+			},
+		},
+		{
+			name: "port range",
+			compose: `
+services:
+  app:
+    image: nginx
+    ports: ["3000-3005"]
+`,
+			check: func(t *testing.T, result *Result) {
+				assert.Empty(t, result.AppSpec.Containers["app"].Ports)
+				assert.Equal(t, []string{"service app: port 3000-3005 is not supported, only single ports are"}, result.Notes)
+			},
+		},
+		{
+			name: "external secret",
+			compose: `
+services:
+  app:
+    image: nginx
+    secrets: [creds]
+secrets:
+  creds:
+    external: true
+`,
+			check: func(t *testing.T, result *Result) {
+				assert.Equal(t, v1.Secret{External: "creds"}, result.AppSpec.Secrets["creds"])
+			},
+		},
+		{
+			name: "no image",
+			compose: `
+services:
+  app:
+    command: ["sleep", "10"]
+`,
+			wantErr: "service app: has neither an image nor a build",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Translate([]byte(tt.compose), ".")
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			tt.check(t, result)
+		})
+	}
+}
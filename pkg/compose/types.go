@@ -0,0 +1,260 @@
+package compose
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/shlex"
+)
+
+// file is the part of a Docker Compose file that can be translated to an Acornfile.
+type file struct {
+	Services map[string]service    `json:"services,omitempty"`
+	Volumes  map[string]*volume    `json:"volumes,omitempty"`
+	Secrets  map[string]secretSpec `json:"secrets,omitempty"`
+}
+
+type service struct {
+	Image       string        `json:"image,omitempty"`
+	Build       *build        `json:"build,omitempty"`
+	Command     command       `json:"command,omitempty"`
+	Entrypoint  command       `json:"entrypoint,omitempty"`
+	Environment mapOrList     `json:"environment,omitempty"`
+	EnvFile     stringOrList  `json:"env_file,omitempty"`
+	Labels      mapOrList     `json:"labels,omitempty"`
+	Ports       []port        `json:"ports,omitempty"`
+	Expose      []port        `json:"expose,omitempty"`
+	Volumes     []volumeMount `json:"volumes,omitempty"`
+	DependsOn   dependsOn     `json:"depends_on,omitempty"`
+	Healthcheck *healthcheck  `json:"healthcheck,omitempty"`
+	Secrets     []secretMount `json:"secrets,omitempty"`
+	WorkingDir  string        `json:"working_dir,omitempty"`
+	User        string        `json:"user,omitempty"`
+	Scale       *int32        `json:"scale,omitempty"`
+	Deploy      *deploy       `json:"deploy,omitempty"`
+}
+
+type build struct {
+	Context    string    `json:"context,omitempty"`
+	Dockerfile string    `json:"dockerfile,omitempty"`
+	Args       mapOrList `json:"args,omitempty"`
+	Target     string    `json:"target,omitempty"`
+}
+
+func (b *build) UnmarshalJSON(data []byte) error {
+	if isString(data) {
+		return json.Unmarshal(data, &b.Context)
+	}
+	type buildType build
+	return json.Unmarshal(data, (*buildType)(b))
+}
+
+type healthcheck struct {
+	Test        command `json:"test,omitempty"`
+	Interval    string  `json:"interval,omitempty"`
+	Timeout     string  `json:"timeout,omitempty"`
+	Retries     int32   `json:"retries,omitempty"`
+	StartPeriod string  `json:"start_period,omitempty"`
+	Disable     bool    `json:"disable,omitempty"`
+}
+
+type deploy struct {
+	Replicas *int32 `json:"replicas,omitempty"`
+}
+
+type volume struct {
+	External bool   `json:"external,omitempty"`
+	Name     string `json:"name,omitempty"`
+}
+
+type secretSpec struct {
+	File        string `json:"file,omitempty"`
+	Environment string `json:"environment,omitempty"`
+	External    bool   `json:"external,omitempty"`
+	Name        string `json:"name,omitempty"`
+}
+
+// command is a command in the shell form, like "npm start", or the exec form, like ["npm", "start"].
+type command []string
+
+func (c *command) UnmarshalJSON(data []byte) error {
+	if !isString(data) {
+		return json.Unmarshal(data, (*[]string)(c))
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parts, err := shlex.Split(s)
+	if err != nil {
+		return err
+	}
+	*c = parts
+	return nil
+}
+
+type stringOrList []string
+
+func (s *stringOrList) UnmarshalJSON(data []byte) error {
+	if isString(data) {
+		*s = []string{""}
+		return json.Unmarshal(data, &(*s)[0])
+	}
+	return json.Unmarshal(data, (*[]string)(s))
+}
+
+// mapOrList is a map of names to values, written as a map or as a list of NAME=VALUE. A value is nil if it is only
+// named, which Compose reads from the environment.
+type mapOrList map[string]*string
+
+func (m *mapOrList) UnmarshalJSON(data []byte) error {
+	if !strings.HasPrefix(strings.TrimSpace(string(data)), "[") {
+		var values map[string]any
+		if err := json.Unmarshal(data, &values); err != nil {
+			return err
+		}
+		*m = map[string]*string{}
+		for k, v := range values {
+			if v == nil {
+				(*m)[k] = nil
+			} else {
+				s := fmt.Sprint(v)
+				(*m)[k] = &s
+			}
+		}
+		return nil
+	}
+
+	var values []string
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	*m = map[string]*string{}
+	for _, value := range values {
+		k, v, ok := strings.Cut(value, "=")
+		if ok {
+			(*m)[k] = &v
+		} else {
+			(*m)[k] = nil
+		}
+	}
+	return nil
+}
+
+// dependsOn is the names of the services a service depends on, written as a list or as a map of conditions.
+type dependsOn []string
+
+func (d *dependsOn) UnmarshalJSON(data []byte) error {
+	if strings.HasPrefix(strings.TrimSpace(string(data)), "[") {
+		return json.Unmarshal(data, (*[]string)(d))
+	}
+	var conditions map[string]any
+	if err := json.Unmarshal(data, &conditions); err != nil {
+		return err
+	}
+	for name := range conditions {
+		*d = append(*d, name)
+	}
+	sort.Strings(*d)
+	return nil
+}
+
+// port is a port in the short form, like "8080:80/udp", or the long form.
+type port struct {
+	Target    string `json:"target,omitempty"`
+	Published string `json:"published,omitempty"`
+	Protocol  string `json:"protocol,omitempty"`
+}
+
+func (p *port) UnmarshalJSON(data []byte) error {
+	if strings.HasPrefix(strings.TrimSpace(string(data)), "{") {
+		var long struct {
+			Target    any    `json:"target,omitempty"`
+			Published any    `json:"published,omitempty"`
+			Protocol  string `json:"protocol,omitempty"`
+		}
+		if err := json.Unmarshal(data, &long); err != nil {
+			return err
+		}
+		p.Target, p.Published, p.Protocol = toString(long.Target), toString(long.Published), long.Protocol
+		return nil
+	}
+
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	s := toString(value)
+	s, p.Protocol, _ = strings.Cut(s, "/")
+	parts := strings.Split(s, ":")
+	p.Target = parts[len(parts)-1]
+	if len(parts) > 1 {
+		p.Published = parts[len(parts)-2]
+	}
+	return nil
+}
+
+// volumeMount is a mount in the short form, like "data:/var/lib/data:ro", or the long form.
+type volumeMount struct {
+	Type   string `json:"type,omitempty"`
+	Source string `json:"source,omitempty"`
+	Target string `json:"target,omitempty"`
+}
+
+func (v *volumeMount) UnmarshalJSON(data []byte) error {
+	if !isString(data) {
+		type volumeMountType volumeMount
+		return json.Unmarshal(data, (*volumeMountType)(v))
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parts := strings.Split(s, ":")
+	switch {
+	case len(parts) == 1:
+		v.Type, v.Target = "volume", parts[0]
+	case isPath(parts[0]):
+		v.Type, v.Source, v.Target = "bind", parts[0], parts[1]
+	default:
+		v.Type, v.Source, v.Target = "volume", parts[0], parts[1]
+	}
+	return nil
+}
+
+// secretMount is the name of a secret, or the long form that also sets where the secret is mounted.
+type secretMount struct {
+	Source string `json:"source,omitempty"`
+	Target string `json:"target,omitempty"`
+}
+
+func (s *secretMount) UnmarshalJSON(data []byte) error {
+	if isString(data) {
+		return json.Unmarshal(data, &s.Source)
+	}
+	type secretMountType secretMount
+	return json.Unmarshal(data, (*secretMountType)(s))
+}
+
+func isString(data []byte) bool {
+	return strings.HasPrefix(strings.TrimSpace(string(data)), `"`)
+}
+
+func isPath(s string) bool {
+	return strings.HasPrefix(s, ".") || strings.HasPrefix(s, "/") || strings.HasPrefix(s, "~")
+}
+
+func toString(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
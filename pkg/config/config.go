@@ -395,6 +395,15 @@ func merge(oldConfig, newConfig *apiv1.Config) *apiv1.Config {
 	if newConfig.EventTTL != nil {
 		mergedConfig.EventTTL = newConfig.EventTTL
 	}
+	if newConfig.FunctionIdleTimeout != nil {
+		mergedConfig.FunctionIdleTimeout = newConfig.FunctionIdleTimeout
+	}
+	if newConfig.PublishBackend != nil {
+		mergedConfig.PublishBackend = newConfig.PublishBackend
+	}
+	if newConfig.GatewayClassName != nil {
+		mergedConfig.GatewayClassName = newConfig.GatewayClassName
+	}
 	if newConfig.CertManagerIssuer != nil {
 		mergedConfig.CertManagerIssuer = newConfig.CertManagerIssuer
 	}
@@ -426,6 +435,12 @@ func merge(oldConfig, newConfig *apiv1.Config) *apiv1.Config {
 		mergedConfig.AllowTrafficFromNamespace = newConfig.AllowTrafficFromNamespace
 	}
 
+	if len(newConfig.SecretBackendAllowedAddresses) > 0 && newConfig.SecretBackendAllowedAddresses[0] == "" {
+		mergedConfig.SecretBackendAllowedAddresses = nil
+	} else if len(newConfig.SecretBackendAllowedAddresses) > 0 {
+		mergedConfig.SecretBackendAllowedAddresses = newConfig.SecretBackendAllowedAddresses
+	}
+
 	if len(newConfig.ServiceLBAnnotations) > 0 && newConfig.ServiceLBAnnotations[0] == "" {
 		mergedConfig.ServiceLBAnnotations = nil
 	} else if len(newConfig.ServiceLBAnnotations) > 0 {
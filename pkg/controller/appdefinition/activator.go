@@ -0,0 +1,133 @@
+package appdefinition
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/acorn-io/baaah/pkg/typed"
+	"github.com/acorn-io/runtime/pkg/activator"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/pdb"
+	"github.com/acorn-io/runtime/pkg/system"
+	"github.com/acorn-io/runtime/pkg/tolerations"
+	"github.com/acorn-io/z"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// toActivator renders the activator of the app, which the services of functions that scale from zero route to.
+func toActivator(ctx context.Context, c kclient.Client, appInstance *v1.AppInstance) ([]kclient.Object, error) {
+	functionPorts := activator.Ports(appInstance)
+	if len(functionPorts) == 0 {
+		return nil, nil
+	}
+
+	cfg, err := config.Get(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	idleTimeout, err := activator.IdleTimeout(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	args := []string{"activator", "--idle-timeout", idleTimeout.String()}
+	var containerPorts []corev1.ContainerPort
+	for _, entry := range typed.Sorted(functionPorts) {
+		args = append(args, "--function", fmt.Sprintf("%s=%d", entry.Key, entry.Value))
+		containerPorts = append(containerPorts, corev1.ContainerPort{
+			ContainerPort: entry.Value,
+			Protocol:      corev1.ProtocolTCP,
+		})
+	}
+
+	matchLabels := labels.Managed(appInstance, labels.AcornActivator, "true")
+	dep := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      activator.Name,
+			Namespace: appInstance.Status.Namespace,
+			Labels:    matchLabels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: matchLabels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: matchLabels,
+				},
+				Spec: corev1.PodSpec{
+					TerminationGracePeriodSeconds: z.Pointer[int64](10),
+					EnableServiceLinks:            new(bool),
+					Containers: []corev1.Container{
+						{
+							Name:  "activator",
+							Image: system.DefaultImage(),
+							Args:  args,
+							Env: []corev1.EnvVar{
+								{
+									Name: "ACORN_ACTIVATOR_NAMESPACE",
+									ValueFrom: &corev1.EnvVarSource{
+										FieldRef: &corev1.ObjectFieldSelector{
+											FieldPath: "metadata.namespace",
+										},
+									},
+								},
+							},
+							Ports: containerPorts,
+							ReadinessProbe: &corev1.Probe{
+								ProbeHandler: corev1.ProbeHandler{
+									TCPSocket: &corev1.TCPSocketAction{
+										Port: intstr.FromInt(int(containerPorts[0].ContainerPort)),
+									},
+								},
+							},
+						},
+					},
+					Tolerations: []corev1.Toleration{
+						{
+							Key:      tolerations.WorkloadTolerationKey,
+							Operator: corev1.TolerationOpExists,
+						},
+					},
+					ServiceAccountName: activator.Name,
+				},
+			},
+		},
+	}
+
+	if z.Dereference(appInstance.Spec.Stop) {
+		dep.Spec.Replicas = new(int32)
+	}
+
+	result := []kclient.Object{
+		dep,
+		&corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      activator.Name,
+				Namespace: appInstance.Status.Namespace,
+				Labels:    matchLabels,
+			},
+		},
+		pdb.ToPodDisruptionBudget(dep),
+	}
+	return append(result, toRoleAndRoleBinding(activator.Name, appInstance.Status.Namespace, activator.Name, appInstance.Status.Namespace,
+		[]rbacv1.PolicyRule{
+			{
+				APIGroups: []string{""},
+				Resources: []string{"pods"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+			{
+				APIGroups: []string{"apps"},
+				Resources: []string{"deployments"},
+				Verbs:     []string{"get", "patch"},
+			},
+		}, matchLabels, nil, appInstance)...), nil
+}
@@ -0,0 +1,95 @@
+package appdefinition
+
+import (
+	"fmt"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/z"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// toHorizontalPodAutoscaler returns the HorizontalPodAutoscaler that manages the replicas of the deployment of an
+// autoscaled container. If no metrics are given, Kubernetes defaults to a target CPU utilization of 80%.
+func toHorizontalPodAutoscaler(name string, container v1.Container, dep *appsv1.Deployment) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	autoscale := container.Autoscale
+	if autoscale.MaxReplicas < 1 {
+		return nil, fmt.Errorf("autoscale maxReplicas for container %s must be at least 1", name)
+	}
+	if z.Dereference(autoscale.MinReplicas) > autoscale.MaxReplicas {
+		return nil, fmt.Errorf("autoscale minReplicas %d for container %s is greater than maxReplicas %d",
+			*autoscale.MinReplicas, name, autoscale.MaxReplicas)
+	}
+
+	var metrics []autoscalingv2.MetricSpec
+	if autoscale.CPU != nil {
+		metrics = append(metrics, resourceMetric(corev1.ResourceCPU, *autoscale.CPU))
+	}
+	if autoscale.Memory != nil {
+		metrics = append(metrics, resourceMetric(corev1.ResourceMemory, *autoscale.Memory))
+	}
+	if autoscale.Metric != nil {
+		if container.Metrics.Port == 0 {
+			return nil, fmt.Errorf("autoscale metric %s for container %s requires the container to define metrics", autoscale.Metric.Name, name)
+		}
+		target, err := resource.ParseQuantity(autoscale.Metric.Target)
+		if err != nil {
+			return nil, fmt.Errorf("invalid target %q for autoscale metric %s of container %s: %w", autoscale.Metric.Target, autoscale.Metric.Name, name, err)
+		}
+		metrics = append(metrics, autoscalingv2.MetricSpec{
+			Type: autoscalingv2.PodsMetricSourceType,
+			Pods: &autoscalingv2.PodsMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{
+					Name: autoscale.Metric.Name,
+				},
+				Target: autoscalingv2.MetricTarget{
+					Type:         autoscalingv2.AverageValueMetricType,
+					AverageValue: &target,
+				},
+			},
+		})
+	}
+
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        dep.Name,
+			Namespace:   dep.Namespace,
+			Labels:      dep.Labels,
+			Annotations: dep.Annotations,
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: appsv1.SchemeGroupVersion.String(),
+				Kind:       "Deployment",
+				Name:       dep.Name,
+			},
+			MinReplicas: autoscale.MinReplicas,
+			MaxReplicas: autoscale.MaxReplicas,
+			Metrics:     metrics,
+		},
+	}, nil
+}
+
+func resourceMetric(name corev1.ResourceName, utilization int32) autoscalingv2.MetricSpec {
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name: name,
+			Target: autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: z.Pointer(utilization),
+			},
+		},
+	}
+}
+
+// maxReplicas returns the most replicas a container can run at once
+func maxReplicas(container v1.Container) int32 {
+	if container.Autoscale != nil {
+		return container.Autoscale.MaxReplicas
+	}
+	return z.Dereference(container.Scale)
+}
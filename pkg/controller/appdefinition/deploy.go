@@ -14,6 +14,7 @@ import (
 	wname "github.com/acorn-io/baaah/pkg/name"
 	"github.com/acorn-io/baaah/pkg/router"
 	"github.com/acorn-io/baaah/pkg/typed"
+	"github.com/acorn-io/runtime/pkg/activator"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/appdefinition"
 	"github.com/acorn-io/runtime/pkg/condition"
@@ -97,12 +98,21 @@ func DeploySpec(req router.Request, resp router.Response) (err error) {
 	} else if len(objs) > 0 {
 		result = append(result, objs...)
 	}
+	if delay := nextRolloutTransition(appInstance); delay > 0 {
+		resp.RetryAfter(delay)
+	}
 	objs, err := ToFunctions(req, appInstance, tag, pullSecrets, interpolator)
 	if err != nil {
 		return err
 	}
 	result = append(result, objs...)
 
+	objs, err = toActivator(req.Ctx, req.Client, appInstance)
+	if err != nil {
+		return err
+	}
+	result = append(result, objs...)
+
 	objs, err = toRouters(req.Ctx, req.Client, appInstance)
 	if err != nil {
 		return err
@@ -115,6 +125,12 @@ func DeploySpec(req router.Request, resp router.Response) (err error) {
 	}
 	result = append(result, objs...)
 
+	objs, err = toMonitors(req, appInstance)
+	if err != nil {
+		return err
+	}
+	result = append(result, objs...)
+
 	objs, err = services.ToAcornServices(req.Ctx, req.Client, interpolator, appInstance)
 	if err != nil {
 		return err
@@ -378,22 +394,20 @@ func toMounts(app *v1.AppInstance, container v1.Container, interpolation *secret
 }
 
 func toPorts(container v1.Container) []corev1.ContainerPort {
+	type portKey struct {
+		port  int32
+		proto corev1.Protocol
+	}
 	var (
 		ports []corev1.ContainerPort
-		seen  = map[struct {
-			port  int32
-			proto corev1.Protocol
-		}]bool{}
+		seen  = map[portKey]bool{}
 	)
 	for _, port := range container.Ports {
 		protocol := corev1.ProtocolTCP
 		if port.Protocol == v1.ProtocolUDP {
 			protocol = corev1.ProtocolUDP
 		}
-		key := struct {
-			port  int32
-			proto corev1.Protocol
-		}{port.TargetPort, protocol}
+		key := portKey{port.TargetPort, protocol}
 		if seen[key] {
 			continue
 		}
@@ -403,6 +417,14 @@ func toPorts(container v1.Container) []corev1.ContainerPort {
 			Protocol:      protocol,
 		})
 	}
+	// A PodMonitor only scrapes ports that are declared on the pod
+	if container.Metrics.Port != 0 && !seen[portKey{container.Metrics.Port, corev1.ProtocolTCP}] {
+		ports = append(ports, corev1.ContainerPort{
+			Name:          metricsPortName,
+			ContainerPort: container.Metrics.Port,
+			Protocol:      corev1.ProtocolTCP,
+		})
+	}
 	return ports
 }
 
@@ -622,6 +644,8 @@ func mergeConLabels(labelMap map[string]string, appInstance *v1.AppInstance, nam
 }
 
 func containerAnnotation(container v1.Container) string {
+	// Changing only the rollout strategy should not restart the pods
+	container.Rollout = nil
 	// convert to map first to sort keys
 	data, _ := convert.EncodeToMap(container)
 	json, _ := json.Marshal(data)
@@ -692,22 +716,7 @@ func addPrometheusAnnotations(annotations map[string]string, container v1.Contai
 }
 
 func isStateful(appInstance *v1.AppInstance, container v1.Container) bool {
-	for _, dir := range container.Dirs {
-		if dir.Secret.Name != "" {
-			continue
-		}
-		for volName, vol := range appInstance.Status.AppSpec.Volumes {
-			if vol.Class == "ephemeral" {
-				continue
-			}
-			if dir.Volume == volName {
-				if len(vol.AccessModes) == 0 || (len(vol.AccessModes) == 1 && vol.AccessModes[0] == v1.AccessModeReadWriteOnce) {
-					return true
-				}
-			}
-		}
-	}
-	return false
+	return volume.IsStateful(appInstance.Status.AppSpec.Volumes, container)
 }
 
 func getRevision(req router.Request, namespace, secretName string) (string, error) {
@@ -717,6 +726,10 @@ func getRevision(req router.Request, namespace, secretName string) (string, erro
 	}
 	hash := sha256.New()
 	for _, entry := range typed.Sorted(secret.Data) {
+		if entry.Key == secrets.PreviousKey && secret.Annotations[labels.AcornSecretRotatedAt] != "" {
+			// Dropping the previous value of a rotated secret after its grace period should not cause a redeploy
+			continue
+		}
 		hash.Write([]byte(entry.Key))
 		hash.Write([]byte{'\x00'})
 		hash.Write(entry.Value)
@@ -832,6 +845,12 @@ func toFunctionDeployment(req router.Request, appInstance *v1.AppInstance, tag n
 		},
 	}
 
+	// The replicas of functions that scale from zero are managed by the activator
+	activated := activator.Enabled(appInstance, container)
+	if activated {
+		dep.Spec.Replicas = nil
+	}
+
 	if appInstance.Spec.Stop != nil && *appInstance.Spec.Stop {
 		dep.Spec.Replicas = new(int32)
 	} else {
@@ -839,7 +858,7 @@ func toFunctionDeployment(req router.Request, appInstance *v1.AppInstance, tag n
 	}
 
 	// Set karpenter do-not-evict annotation if scale is nil or 1. This prevents karpenter from evicting the pod if deployment is not running with more than 1 replica.
-	if dep.Spec.Replicas == nil || *dep.Spec.Replicas == 1 {
+	if !activated && (dep.Spec.Replicas == nil || *dep.Spec.Replicas == 1) {
 		cfg, err := config.Get(req.Ctx, req.Client)
 		if err != nil {
 			return nil, err
@@ -855,7 +874,8 @@ func toFunctionDeployment(req router.Request, appInstance *v1.AppInstance, tag n
 func toDeployment(req router.Request, appInstance *v1.AppInstance, tag name.Reference, name string, container v1.Container, pullSecrets *PullSecrets, interpolator *secrets.Interpolator) (*appsv1.Deployment, error) {
 	var (
 		stateful   = isStateful(appInstance, container)
-		addWait    = !stateful && len(acornSleepBinary) > 0 && z.Dereference(container.Scale) > 1 && !appInstance.Status.GetDevMode()
+		autoscaled = !stateful && container.Autoscale != nil
+		addWait    = !stateful && len(acornSleepBinary) > 0 && maxReplicas(container) > 1 && !appInstance.Status.GetDevMode()
 		addBusybox = false
 	)
 
@@ -929,6 +949,9 @@ func toDeployment(req router.Request, appInstance *v1.AppInstance, tag name.Refe
 		dep.Spec.Replicas = z.Pointer[int32](1)
 		dep.Spec.Template.Spec.Hostname = dep.Name
 		dep.Spec.Strategy.Type = appsv1.RecreateDeploymentStrategyType
+	} else if autoscaled {
+		// The replicas are managed by the HorizontalPodAutoscaler
+		dep.Spec.Replicas = nil
 	} else if dep.Spec.Replicas == nil || *dep.Spec.Replicas == 1 {
 		dep.Spec.Template.Spec.Hostname = dep.Name
 	}
@@ -939,8 +962,15 @@ func toDeployment(req router.Request, appInstance *v1.AppInstance, tag name.Refe
 		interpolator.AddMissingAnnotations(appInstance.GetStopped(), dep.Annotations)
 	}
 
+	if vol, err := restoringVolume(req, appInstance, container); err != nil {
+		return nil, err
+	} else if vol != "" {
+		// Hold the pods back until the volume is restored, so that they never see a partially restored volume
+		dep.Spec.Replicas = new(int32)
+	}
+
 	// Set karpenter do-not-evict annotation if scale is nil or 1. This prevents karpenter from evicting the pod if deployment is not running with more than 1 replica.
-	if dep.Spec.Replicas == nil || *dep.Spec.Replicas == 1 {
+	if !autoscaled && (dep.Spec.Replicas == nil || *dep.Spec.Replicas == 1) {
 		cfg, err := config.Get(req.Ctx, req.Client)
 		if err != nil {
 			return nil, err
@@ -1036,7 +1066,35 @@ func ToDeployments(req router.Request, appInstance *v1.AppInstance, tag name.Ref
 			}
 			result = append(result, perms...)
 		}
-		result = append(result, sa, dep, pdb.ToPodDisruptionBudget(dep))
+
+		var deps []kclient.Object
+		if containerDef.Rollout != nil && !appInstance.GetStopped() && !appInstance.Status.GetDevMode() && !isStateful(appInstance, containerDef) {
+			deps, err = toRolloutDeployments(req, appInstance, containerName, containerDef, dep)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			deps, err = toDeploymentsWithoutRollout(req, appInstance, containerDef, dep)
+			if err != nil {
+				return nil, err
+			}
+			if cs, ok := appInstance.Status.AppStatus.Containers[containerName]; ok && cs.Rollout != nil {
+				cs.Rollout = nil
+				appInstance.Status.AppStatus.Containers[containerName] = cs
+			}
+		}
+
+		result = append(result, sa)
+		result = append(result, deps...)
+		result = append(result, pdb.ToPodDisruptionBudget(dep))
+
+		if containerDef.Autoscale != nil && !appInstance.GetStopped() && !isStateful(appInstance, containerDef) {
+			hpa, err := toHorizontalPodAutoscaler(containerName, containerDef, dep)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, hpa)
+		}
 	}
 
 	return result, nil
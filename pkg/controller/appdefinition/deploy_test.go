@@ -65,14 +65,38 @@ func TestDeploySpecScale(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/deployspec/scale", DeploySpec)
 }
 
+func TestDeploySpecAutoscale(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/deployspec/autoscale", DeploySpec)
+}
+
+func TestDeploySpecFunctions(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/deployspec/functions", DeploySpec)
+}
+
 func TestDeploySpecStop(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/deployspec/stop", DeploySpec)
 }
 
+func TestDeploySpecRolloutStop(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/deployspec/rollout-stop", DeploySpec)
+}
+
+func TestDeploySpecRolloutDevMode(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/deployspec/rollout-devmode", DeploySpec)
+}
+
+func TestDeploySpecRolloutRemoved(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/deployspec/rollout-removed", DeploySpec)
+}
+
 func TestDeploySpecMetrics(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/deployspec/metrics", DeploySpec)
 }
 
+func TestDeploySpecMetricsMonitors(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/deployspec/metrics-monitors", DeploySpec)
+}
+
 func TestProbe(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/probes", DeploySpec)
 }
@@ -1,8 +1,11 @@
 package appdefinition
 
 import (
+	"fmt"
+	"math"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/acorn-io/baaah/pkg/apply"
 	"github.com/acorn-io/baaah/pkg/router"
@@ -175,14 +178,18 @@ func toJob(req router.Request, appInstance *v1.AppInstance, pullSecrets *PullSec
 
 	interpolator.AddMissingAnnotations(appInstance.GetStopped(), baseAnnotations)
 
+	retryAnnotations, err := setRetryPolicy(&jobSpec, container)
+	if err != nil {
+		return nil, err
+	}
+
 	if container.Schedule == "" {
-		jobSpec.BackoffLimit = z.Pointer[int32](1000)
 		job := &batchv1.Job{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:        name,
 				Namespace:   appInstance.Status.Namespace,
 				Labels:      jobSpec.Template.Labels,
-				Annotations: labels.Merge(getDependencyAnnotations(appInstance, name, container.Dependencies), baseAnnotations),
+				Annotations: labels.Merge(getDependencyAnnotations(appInstance, name, container.Dependencies), labels.Merge(baseAnnotations, retryAnnotations)),
 			},
 			Spec: jobSpec,
 		}
@@ -207,11 +214,12 @@ func toJob(req router.Request, appInstance *v1.AppInstance, pullSecrets *PullSec
 		Spec: batchv1.CronJobSpec{
 			FailedJobsHistoryLimit:     z.Pointer[int32](3),
 			SuccessfulJobsHistoryLimit: z.Pointer[int32](1),
-			ConcurrencyPolicy:          batchv1.ReplaceConcurrent,
+			ConcurrencyPolicy:          toConcurrencyPolicy(container.ConcurrencyPolicy),
 			Schedule:                   toCronJobSchedule(container.Schedule),
 			JobTemplate: batchv1.JobTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels: jobSpec.Template.Labels,
+					Labels:      jobSpec.Template.Labels,
+					Annotations: retryAnnotations,
 				},
 				Spec: jobSpec,
 			},
@@ -221,6 +229,51 @@ func toJob(req router.Request, appInstance *v1.AppInstance, pullSecrets *PullSec
 	return cronJob, nil
 }
 
+// setRetryPolicy sets how many times and for how long the job is attempted. A job with a backoff or timeout is not
+// retried by Kubernetes, instead the returned annotations tell the job controller to recreate the job once the backoff
+// passes. This way the active deadline of the Kubernetes job is the timeout of a single attempt.
+func setRetryPolicy(jobSpec *batchv1.JobSpec, container v1.Container) (map[string]string, error) {
+	if container.Timeout != "" {
+		timeout, err := time.ParseDuration(container.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timeout [%s]: %w", container.Timeout, err)
+		}
+		jobSpec.ActiveDeadlineSeconds = z.Pointer(int64(math.Ceil(timeout.Seconds())))
+	}
+
+	retries := jobs.Retries(container)
+	if !jobs.RecreatedPerAttempt(container) {
+		// Scheduled jobs keep the Kubernetes default unless retries are set
+		if container.Retries != nil || container.Schedule == "" {
+			jobSpec.BackoffLimit = &retries
+		}
+		return nil, nil
+	}
+
+	backoff := container.Backoff
+	if backoff == "" {
+		backoff = jobs.DefaultBackoff
+	} else if _, err := time.ParseDuration(backoff); err != nil {
+		return nil, fmt.Errorf("invalid backoff [%s]: %w", backoff, err)
+	}
+	jobSpec.BackoffLimit = new(int32)
+	return map[string]string{
+		labels.AcornJobRetries: strconv.Itoa(int(retries)),
+		labels.AcornJobBackoff: backoff,
+	}, nil
+}
+
+func toConcurrencyPolicy(policy v1.JobConcurrencyPolicy) batchv1.ConcurrencyPolicy {
+	switch policy {
+	case v1.JobConcurrencyPolicyAllow:
+		return batchv1.AllowConcurrent
+	case v1.JobConcurrencyPolicyForbid:
+		return batchv1.ForbidConcurrent
+	default:
+		return batchv1.ReplaceConcurrent
+	}
+}
+
 func toCronJobSchedule(schedule string) string {
 	switch strings.TrimSpace(schedule) {
 	case "year":
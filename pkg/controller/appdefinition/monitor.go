@@ -0,0 +1,124 @@
+package appdefinition
+
+import (
+	"strconv"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/typed"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/ports"
+	apiextensionv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const metricsPortName = "metrics"
+
+var (
+	ServiceMonitorGVK = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "ServiceMonitor"}
+	PodMonitorGVK     = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "PodMonitor"}
+)
+
+// toMonitors returns the Prometheus operator objects that scrape the metrics of the containers and jobs of the app. A
+// container whose metrics port is one of its ports is scraped through its service with a ServiceMonitor, anything else
+// is scraped with a PodMonitor. Nothing is returned for the kinds whose CRDs are not installed.
+func toMonitors(req router.Request, appInstance *v1.AppInstance) (result []kclient.Object, _ error) {
+	if appInstance.GetStopped() {
+		return nil, nil
+	}
+
+	hasServiceMonitors, err := crdExists(req, "servicemonitors.monitoring.coreos.com")
+	if err != nil {
+		return nil, err
+	}
+	hasPodMonitors, err := crdExists(req, "podmonitors.monitoring.coreos.com")
+	if err != nil {
+		return nil, err
+	}
+	if !hasServiceMonitors && !hasPodMonitors {
+		return nil, nil
+	}
+
+	for _, containerName := range typed.SortedKeys(appInstance.Status.AppSpec.Containers) {
+		container := appInstance.Status.AppSpec.Containers[containerName]
+		if !hasMetrics(container) || ports.IsLinked(appInstance, containerName) {
+			continue
+		}
+		if servicePort, ok := metricsServicePort(container); ok && hasServiceMonitors {
+			result = append(result, toMonitor(ServiceMonitorGVK, appInstance, containerName, selectorMatchLabels(appInstance, containerName), "endpoints", map[string]any{
+				"port": servicePort,
+				"path": container.Metrics.Path,
+			}))
+		} else if hasPodMonitors {
+			result = append(result, toPodMonitor(appInstance, containerName, selectorMatchLabels(appInstance, containerName), container))
+		}
+	}
+
+	if hasPodMonitors {
+		for _, jobName := range typed.SortedKeys(appInstance.Status.AppSpec.Jobs) {
+			job := appInstance.Status.AppSpec.Jobs[jobName]
+			if hasMetrics(job) {
+				result = append(result, toPodMonitor(appInstance, jobName, labels.Managed(appInstance, labels.AcornJobName, jobName), job))
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func hasMetrics(container v1.Container) bool {
+	return container.Metrics.Path != "" && container.Metrics.Port != 0
+}
+
+// metricsServicePort returns the name of the service port of the container that targets its metrics port
+func metricsServicePort(container v1.Container) (string, bool) {
+	for _, port := range container.Ports {
+		port = port.Complete()
+		if port.TargetPort == container.Metrics.Port && port.Protocol != v1.ProtocolUDP {
+			return strconv.Itoa(int(port.Port)), true
+		}
+	}
+	return "", false
+}
+
+func toPodMonitor(appInstance *v1.AppInstance, name string, selector map[string]string, container v1.Container) kclient.Object {
+	return toMonitor(PodMonitorGVK, appInstance, name, selector, "podMetricsEndpoints", map[string]any{
+		"targetPort": int64(container.Metrics.Port),
+		"path":       container.Metrics.Path,
+	})
+}
+
+// toMonitor returns a monitor that scrapes the endpoint of the pods or services selected by the labels.
+func toMonitor(gvk schema.GroupVersionKind, appInstance *v1.AppInstance, name string, selector map[string]string, endpointsField string, endpoint map[string]any) kclient.Object {
+	matchLabels := map[string]any{}
+	for k, v := range selector {
+		matchLabels[k] = v
+	}
+
+	monitor := &unstructured.Unstructured{
+		Object: map[string]any{
+			"spec": map[string]any{
+				"selector": map[string]any{
+					"matchLabels": matchLabels,
+				},
+				endpointsField: []any{endpoint},
+			},
+		},
+	}
+	monitor.SetGroupVersionKind(gvk)
+	monitor.SetName(name)
+	monitor.SetNamespace(appInstance.Status.Namespace)
+	monitor.SetLabels(selector)
+	return monitor
+}
+
+func crdExists(req router.Request, name string) (bool, error) {
+	err := req.Get(&apiextensionv1.CustomResourceDefinition{}, "", name)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
@@ -0,0 +1,488 @@
+package appdefinition
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	wname "github.com/acorn-io/baaah/pkg/name"
+	"github.com/acorn-io/baaah/pkg/router"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/z"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	klabels "k8s.io/apimachinery/pkg/labels"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// rolloutPromotingRetry is how often a promoting rollout is checked for completion
+const rolloutPromotingRetry = 5 * time.Second
+
+// toRolloutDeployments renders the deployments for a container that has a rollout strategy. When the pod template
+// changes, the previous template keeps running in the stable deployment and the new template runs in a separate
+// canary deployment. The pods of both deployments are selected by the container's service, so the share of traffic
+// served by the new version follows the ratio of replicas between the two, which is what the weight of the status
+// reports. For the blue-green strategy the service
+// only selects the stable pods until the rollout is promoted. The deployments select their pods by track, so that they
+// never select each other's pods.
+func toRolloutDeployments(req router.Request, appInstance *v1.AppInstance, name string, container v1.Container, dep *appsv1.Deployment) ([]kclient.Object, error) {
+	rollout := *container.Rollout
+	if rollout.Strategy == "" {
+		rollout.Strategy = v1.RolloutStrategyCanary
+	}
+
+	dep, hash, err := toStableTrack(dep)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := existingDeployment(req, dep)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing != nil && existing.Spec.Selector.MatchLabels[labels.AcornRolloutTrack] != v1.RolloutTrackStable {
+		// The deployment was created before the container had a rollout strategy. The selector of a deployment can't
+		// be changed, so the deployment is removed and created again with the selector of the stable track on the next
+		// reconcile.
+		return nil, nil
+	}
+
+	cs := appInstance.Status.AppStatus.Containers[name]
+	defer func() {
+		if appInstance.Status.AppStatus.Containers == nil {
+			appInstance.Status.AppStatus.Containers = map[string]v1.ContainerStatus{}
+		}
+		appInstance.Status.AppStatus.Containers[name] = cs
+	}()
+
+	status := cs.Rollout.DeepCopy()
+	if status == nil || status.Strategy != rollout.Strategy {
+		status = &v1.RolloutStatus{
+			Strategy: rollout.Strategy,
+		}
+	}
+	cs.Rollout = status
+
+	var stableHash string
+	if existing != nil {
+		stableHash = existing.Annotations[labels.AcornRolloutHash]
+	}
+
+	total := replicas(dep.Spec.Replicas)
+	autoscaled := container.Autoscale != nil
+	if autoscaled && existing != nil {
+		// The autoscaler owns the replicas of the stable deployment, so the canary is sized relative to it
+		total = replicas(existing.Spec.Replicas)
+	}
+
+	if status.Phase == v1.RolloutPhasePromoting && status.CanaryHash == hash {
+		if status.RequestedAction == v1.RolloutActionAbort {
+			return abortPromotion(req, status, dep, existing)
+		}
+		return promote(status, dep, existing, hash, total), nil
+	}
+	if status.Phase == v1.RolloutPhaseAborted && status.CanaryHash == hash && status.StableHash != "" {
+		// The promotion may have moved the stable deployment to the new version before it was aborted
+		stableHash = status.StableHash
+	}
+
+	if stableHash == "" || stableHash == hash {
+		// Nothing to roll out, either this is the first deployment or the stable version is already current
+		*status = v1.RolloutStatus{
+			Strategy:   rollout.Strategy,
+			Phase:      v1.RolloutPhaseCompleted,
+			StableHash: hash,
+		}
+		return []kclient.Object{dep}, nil
+	}
+
+	now := metav1.Now()
+	if status.CanaryHash != hash {
+		*status = v1.RolloutStatus{
+			Strategy:      rollout.Strategy,
+			Phase:         v1.RolloutPhaseProgressing,
+			StableHash:    stableHash,
+			CanaryHash:    hash,
+			StepStartTime: &now,
+		}
+	}
+
+	switch status.RequestedAction {
+	case v1.RolloutActionAbort:
+		status.Phase = v1.RolloutPhaseAborted
+	case v1.RolloutActionPromote:
+		if status.InProgress() {
+			if rollout.Strategy == v1.RolloutStrategyBlueGreen {
+				status.Phase = v1.RolloutPhasePromoting
+			} else {
+				status.Step++
+				status.StepStartTime = &now
+				status.Phase = v1.RolloutPhaseProgressing
+			}
+		}
+	}
+	status.RequestedAction = ""
+
+	template, err := stableTemplate(req, existing, stableHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if status.Phase == v1.RolloutPhaseAborted {
+		status.Weight = 0
+		return []kclient.Object{toStableDeployment(dep, template, stableHash, dep.Spec.Replicas)}, nil
+	}
+
+	if rollout.Strategy == v1.RolloutStrategyBlueGreen {
+		if status.Phase == v1.RolloutPhaseProgressing {
+			// Blue-green rollouts always wait to be promoted
+			status.Phase = v1.RolloutPhasePaused
+		}
+	} else if err := advanceCanary(status, rollout.Steps, now); err != nil {
+		return nil, err
+	}
+
+	if status.Phase == v1.RolloutPhasePromoting {
+		return promote(status, dep, existing, hash, total), nil
+	}
+
+	var (
+		stableReplicas = &total
+		canaryReplicas = total
+	)
+	if rollout.Strategy == v1.RolloutStrategyBlueGreen {
+		// The new version runs at full scale, but doesn't receive traffic until it is promoted
+		status.Weight = 0
+	} else {
+		weight := rollout.Steps[status.Step].Weight
+		var stable int32
+		canaryReplicas, stable = splitReplicas(total, weight)
+		stableReplicas = &stable
+		if autoscaled {
+			canaryReplicas = autoscaledCanaryReplicas(total, weight)
+			stable = total
+		}
+		// Traffic is split by the replicas of the versions, so report the share the new version actually receives
+		status.Weight = effectiveWeight(canaryReplicas, stable)
+	}
+	if autoscaled {
+		stableReplicas = nil
+	}
+
+	return []kclient.Object{
+		toStableDeployment(dep, template, stableHash, stableReplicas),
+		toCanaryDeployment(dep, canaryReplicas),
+	}, nil
+}
+
+// toDeploymentsWithoutRollout renders the deployment of a container that is not rolled out, because it is stopped, in
+// dev mode, stateful or has no rollout strategy. A deployment that was created for a rollout selects its pods by track
+// and its selector can't be changed, so while the container still has a rollout strategy the deployment keeps the
+// stable track. A stopped container keeps the stable version, so that the rollout continues when it is started. If the
+// rollout strategy was removed, the deployment is removed and created again without the track on the next reconcile.
+func toDeploymentsWithoutRollout(req router.Request, appInstance *v1.AppInstance, container v1.Container, dep *appsv1.Deployment) ([]kclient.Object, error) {
+	existing, err := existingDeployment(req, dep)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil || existing.Spec.Selector.MatchLabels[labels.AcornRolloutTrack] != v1.RolloutTrackStable {
+		return []kclient.Object{dep}, nil
+	}
+	if container.Rollout == nil {
+		return nil, nil
+	}
+
+	stable, hash, err := toStableTrack(dep)
+	if err != nil {
+		return nil, err
+	}
+	if appInstance.GetStopped() && existing.Annotations[labels.AcornRolloutHash] != "" {
+		return []kclient.Object{toStableDeployment(stable, existing.Spec.Template.DeepCopy(), existing.Annotations[labels.AcornRolloutHash], z.Pointer[int32](0))}, nil
+	}
+	return []kclient.Object{toStableDeployment(stable, nil, hash, stable.Spec.Replicas)}, nil
+}
+
+// toStableTrack returns a copy of the deployment that selects the pods of the stable track, along with the hash of its
+// pod template.
+func toStableTrack(dep *appsv1.Deployment) (*appsv1.Deployment, string, error) {
+	dep = dep.DeepCopy()
+	dep.Spec.Selector.MatchLabels = labels.Merge(dep.Spec.Selector.MatchLabels, map[string]string{
+		labels.AcornRolloutTrack: v1.RolloutTrackStable,
+	})
+	dep.Spec.Template.Labels[labels.AcornRolloutTrack] = v1.RolloutTrackStable
+	hash, err := templateHash(dep.Spec.Template)
+	if err != nil {
+		return nil, "", err
+	}
+	dep.Annotations[labels.AcornRolloutHash] = hash
+	// The hash is also kept on the template so that the template of a version can be found in the replica sets of the
+	// deployment after the deployment moved on
+	dep.Spec.Template.Annotations = labels.Merge(dep.Spec.Template.Annotations, map[string]string{
+		labels.AcornRolloutHash: hash,
+	})
+	return dep, hash, nil
+}
+
+// existingDeployment returns the deployment as it is in the cluster, or nil if it doesn't exist yet.
+func existingDeployment(req router.Request, dep *appsv1.Deployment) (*appsv1.Deployment, error) {
+	existing := &appsv1.Deployment{}
+	if err := req.Get(existing, dep.Namespace, dep.Name); apierror.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+// advanceCanary moves a canary rollout through the steps whose pause has elapsed. A step without a pause waits to be
+// promoted. Once all steps are done the rollout is promoted.
+func advanceCanary(status *v1.RolloutStatus, steps []v1.RolloutStep, now metav1.Time) error {
+	for status.Phase != v1.RolloutPhasePromoting {
+		if int(status.Step) >= len(steps) {
+			status.Phase = v1.RolloutPhasePromoting
+			break
+		}
+
+		step := steps[status.Step]
+		if step.Pause == "" {
+			status.Phase = v1.RolloutPhasePaused
+			break
+		}
+
+		pause, err := time.ParseDuration(step.Pause)
+		if err != nil {
+			return fmt.Errorf("invalid pause for rollout step %d: %w", status.Step, err)
+		}
+
+		next := status.StepStartTime.Add(pause)
+		if now.Time.Before(next) {
+			status.Phase = v1.RolloutPhaseProgressing
+			break
+		}
+
+		status.Step++
+		status.StepStartTime = &metav1.Time{Time: next}
+	}
+
+	return nil
+}
+
+// promote moves the stable deployment to the new version. The canary deployment is kept until the stable deployment
+// has fully rolled out so that the new version always has capacity.
+func promote(status *v1.RolloutStatus, dep, existing *appsv1.Deployment, hash string, total int32) []kclient.Object {
+	status.Phase = v1.RolloutPhasePromoting
+	status.Weight = 100
+	status.RequestedAction = ""
+
+	if existing != nil && existing.Annotations[labels.AcornRolloutHash] == hash && deploymentRolledOut(existing) {
+		*status = v1.RolloutStatus{
+			Strategy:   status.Strategy,
+			Phase:      v1.RolloutPhaseCompleted,
+			StableHash: hash,
+		}
+		return []kclient.Object{dep}
+	}
+
+	return []kclient.Object{dep, toCanaryDeployment(dep, total)}
+}
+
+// abortPromotion moves the stable deployment back to the stable version when a rollout is aborted while it is being
+// promoted. If the template of the stable version can no longer be found the abort is ignored and the promotion
+// continues.
+func abortPromotion(req router.Request, status *v1.RolloutStatus, dep, existing *appsv1.Deployment) ([]kclient.Object, error) {
+	status.RequestedAction = ""
+
+	template, err := stableTemplate(req, existing, status.StableHash)
+	if err != nil {
+		return nil, err
+	}
+	if template == nil {
+		return promote(status, dep, existing, status.CanaryHash, replicas(dep.Spec.Replicas)), nil
+	}
+
+	status.Phase = v1.RolloutPhaseAborted
+	status.Weight = 0
+	return []kclient.Object{toStableDeployment(dep, template, status.StableHash, dep.Spec.Replicas)}, nil
+}
+
+// stableTemplate returns the pod template of the stable version. That is the template of the existing deployment,
+// unless a promotion already moved the deployment to the new version, then it is read from the replica set the
+// deployment kept for the stable version. Nil is returned if there is no such template.
+func stableTemplate(req router.Request, existing *appsv1.Deployment, stableHash string) (*corev1.PodTemplateSpec, error) {
+	if existing == nil {
+		return nil, nil
+	}
+	if existing.Annotations[labels.AcornRolloutHash] == stableHash {
+		return existing.Spec.Template.DeepCopy(), nil
+	}
+
+	replicaSets := &appsv1.ReplicaSetList{}
+	if err := req.List(replicaSets, &kclient.ListOptions{
+		Namespace:     existing.Namespace,
+		LabelSelector: klabels.SelectorFromSet(existing.Spec.Selector.MatchLabels),
+	}); err != nil {
+		return nil, err
+	}
+	for _, rs := range replicaSets.Items {
+		if rs.Spec.Template.Annotations[labels.AcornRolloutHash] == stableHash && metav1.IsControlledBy(&rs, existing) {
+			template := rs.Spec.Template.DeepCopy()
+			delete(template.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+			return template, nil
+		}
+	}
+	return nil, nil
+}
+
+// toStableDeployment returns the deployment for the version currently serving traffic, running the template given. A
+// nil replicas leaves the replicas to the autoscaler.
+func toStableDeployment(dep *appsv1.Deployment, template *corev1.PodTemplateSpec, stableHash string, replicas *int32) *appsv1.Deployment {
+	stable := dep.DeepCopy()
+	stable.Annotations[labels.AcornRolloutHash] = stableHash
+	stable.Spec.Replicas = replicas
+	if template != nil {
+		stable.Spec.Template = *template
+	}
+	return stable
+}
+
+func toCanaryDeployment(dep *appsv1.Deployment, replicas int32) *appsv1.Deployment {
+	canary := dep.DeepCopy()
+	canary.Name = wname.SafeConcatName(dep.Name, v1.RolloutTrackCanary)
+	canary.Spec.Replicas = z.Pointer(replicas)
+	canary.Spec.Selector.MatchLabels = labels.Merge(canary.Spec.Selector.MatchLabels, map[string]string{
+		labels.AcornRolloutTrack: v1.RolloutTrackCanary,
+	})
+	canary.Spec.Template.Labels[labels.AcornRolloutTrack] = v1.RolloutTrackCanary
+	if canary.Spec.Template.Spec.Hostname != "" {
+		canary.Spec.Template.Spec.Hostname = canary.Name
+	}
+	return canary
+}
+
+// splitReplicas divides the replicas of a container between the canary and stable deployments according to the
+// weight of the canary. Each deployment runs at least one replica unless the weight is 100.
+func splitReplicas(total, weight int32) (canary, stable int32) {
+	if total < 1 {
+		total = 1
+	}
+	canary = (total*weight + 99) / 100
+	if canary < 1 {
+		canary = 1
+	}
+	if canary > total {
+		canary = total
+	}
+	stable = total - canary
+	if stable < 1 && weight < 100 {
+		stable = 1
+	}
+	return canary, stable
+}
+
+// effectiveWeight returns the percentage of the replicas, and therefore of the traffic, that run the new version.
+func effectiveWeight(canary, stable int32) int32 {
+	if canary+stable == 0 {
+		return 0
+	}
+	return (canary*100 + (canary+stable)/2) / (canary + stable)
+}
+
+// ValidateRolloutSteps checks that the weight of every step of a canary rollout can be run with the replicas of the
+// container. Traffic is split by the ratio of replicas between the versions, so a weight that the replicas can't
+// represent would send a different share of the traffic to the new version than the step asks for. The replicas of
+// autoscaled containers change, so their steps are not checked.
+func ValidateRolloutSteps(container v1.Container) error {
+	if container.Rollout == nil || container.Rollout.Strategy == v1.RolloutStrategyBlueGreen || container.Autoscale != nil {
+		return nil
+	}
+
+	total := replicas(container.Scale)
+	for i, step := range container.Rollout.Steps {
+		canary, stable := splitReplicas(total, step.Weight)
+		if canary*100 != step.Weight*(canary+stable) {
+			return fmt.Errorf("rollout step %d has a weight of %d%%, but with %d replicas the new version would receive %d%% of the traffic",
+				i, step.Weight, total, effectiveWeight(canary, stable))
+		}
+	}
+	return nil
+}
+
+// autoscaledCanaryReplicas returns the replicas of the canary deployment when the stable deployment is autoscaled and
+// keeps all of its replicas. The canary is sized so that it receives roughly the weight of the traffic, but never runs
+// more replicas than the stable deployment.
+func autoscaledCanaryReplicas(stable, weight int32) int32 {
+	if stable < 1 {
+		stable = 1
+	}
+	if weight >= 50 {
+		return stable
+	}
+	canary := (stable*weight + (100 - weight) - 1) / (100 - weight)
+	if canary < 1 {
+		canary = 1
+	}
+	return canary
+}
+
+func deploymentRolledOut(dep *appsv1.Deployment) bool {
+	desired := replicas(dep.Spec.Replicas)
+	return dep.Status.ObservedGeneration == dep.Generation &&
+		dep.Status.UpdatedReplicas == desired &&
+		dep.Status.AvailableReplicas == desired &&
+		dep.Status.Replicas == desired
+}
+
+func replicas(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
+
+func templateHash(template corev1.PodTemplateSpec) (string, error) {
+	data, err := json.Marshal(template)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])[:12], nil
+}
+
+// nextRolloutTransition returns how long to wait before a rollout of the app needs to be reconciled again, or zero
+// if no rollout is waiting on time.
+func nextRolloutTransition(appInstance *v1.AppInstance) (result time.Duration) {
+	for name, cs := range appInstance.Status.AppStatus.Containers {
+		var delay time.Duration
+		switch {
+		case !cs.Rollout.InProgress():
+			continue
+		case cs.Rollout.Phase == v1.RolloutPhasePromoting:
+			delay = rolloutPromotingRetry
+		case cs.Rollout.Phase == v1.RolloutPhaseProgressing:
+			rollout := appInstance.Status.AppSpec.Containers[name].Rollout
+			if rollout == nil || int(cs.Rollout.Step) >= len(rollout.Steps) || cs.Rollout.StepStartTime == nil {
+				continue
+			}
+			pause, err := time.ParseDuration(rollout.Steps[cs.Rollout.Step].Pause)
+			if err != nil {
+				continue
+			}
+			delay = time.Until(cs.Rollout.StepStartTime.Add(pause))
+			if delay <= 0 {
+				delay = time.Second
+			}
+		default:
+			continue
+		}
+		if result == 0 || delay < result {
+			result = delay
+		}
+	}
+	return result
+}
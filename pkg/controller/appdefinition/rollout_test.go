@@ -0,0 +1,179 @@
+package appdefinition
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSplitReplicas(t *testing.T) {
+	tests := []struct {
+		total, weight, canary, stable int32
+	}{
+		{total: 1, weight: 10, canary: 1, stable: 1},
+		{total: 10, weight: 10, canary: 1, stable: 9},
+		{total: 10, weight: 25, canary: 3, stable: 7},
+		{total: 4, weight: 50, canary: 2, stable: 2},
+		{total: 4, weight: 99, canary: 4, stable: 1},
+		{total: 4, weight: 100, canary: 4, stable: 0},
+		{total: 0, weight: 50, canary: 1, stable: 1},
+	}
+	for _, tt := range tests {
+		canary, stable := splitReplicas(tt.total, tt.weight)
+		assert.Equal(t, tt.canary, canary, "canary replicas for total %d and weight %d", tt.total, tt.weight)
+		assert.Equal(t, tt.stable, stable, "stable replicas for total %d and weight %d", tt.total, tt.weight)
+	}
+}
+
+func TestEffectiveWeight(t *testing.T) {
+	assert.Equal(t, int32(50), effectiveWeight(1, 1))
+	assert.Equal(t, int32(10), effectiveWeight(1, 9))
+	assert.Equal(t, int32(30), effectiveWeight(3, 7))
+	assert.Equal(t, int32(100), effectiveWeight(4, 0))
+	assert.Equal(t, int32(0), effectiveWeight(0, 0))
+}
+
+func TestValidateRolloutSteps(t *testing.T) {
+	rollout := func(scale int32, weights ...int32) v1.Container {
+		container := v1.Container{Scale: &scale, Rollout: &v1.Rollout{}}
+		for _, weight := range weights {
+			container.Rollout.Steps = append(container.Rollout.Steps, v1.RolloutStep{Weight: weight})
+		}
+		return container
+	}
+
+	assert.NoError(t, ValidateRolloutSteps(rollout(10, 10, 50, 90)))
+	assert.NoError(t, ValidateRolloutSteps(rollout(1, 50)))
+	assert.NoError(t, ValidateRolloutSteps(rollout(4, 25, 50, 100)))
+	assert.ErrorContains(t, ValidateRolloutSteps(rollout(1, 10)), "rollout step 0 has a weight of 10%, but with 1 replicas the new version would receive 50% of the traffic")
+	assert.ErrorContains(t, ValidateRolloutSteps(rollout(10, 10, 25)), "rollout step 1 has a weight of 25%")
+
+	// Blue-green rollouts and autoscaled containers are not checked
+	blueGreen := rollout(1, 10)
+	blueGreen.Rollout.Strategy = v1.RolloutStrategyBlueGreen
+	assert.NoError(t, ValidateRolloutSteps(blueGreen))
+	autoscaled := rollout(1, 10)
+	autoscaled.Autoscale = &v1.Autoscale{MaxReplicas: 3}
+	assert.NoError(t, ValidateRolloutSteps(autoscaled))
+}
+
+func TestAutoscaledCanaryReplicas(t *testing.T) {
+	assert.Equal(t, int32(1), autoscaledCanaryReplicas(0, 10))
+	assert.Equal(t, int32(1), autoscaledCanaryReplicas(4, 10))
+	assert.Equal(t, int32(3), autoscaledCanaryReplicas(9, 25))
+	assert.Equal(t, int32(6), autoscaledCanaryReplicas(6, 50))
+	assert.Equal(t, int32(6), autoscaledCanaryReplicas(6, 90))
+}
+
+func TestAdvanceCanary(t *testing.T) {
+	start := metav1.NewTime(time.Now().Add(-90 * time.Second))
+	steps := []v1.RolloutStep{
+		{Weight: 10, Pause: "1m"},
+		{Weight: 50, Pause: "1m"},
+		{Weight: 90},
+	}
+
+	status := &v1.RolloutStatus{
+		Phase:         v1.RolloutPhaseProgressing,
+		StepStartTime: &start,
+	}
+	assert.NoError(t, advanceCanary(status, steps, metav1.Now()))
+	assert.Equal(t, v1.RolloutPhaseProgressing, status.Phase)
+	assert.Equal(t, int32(1), status.Step)
+	assert.Equal(t, start.Add(time.Minute), status.StepStartTime.Time)
+
+	// The last step does not have a pause, so it waits to be promoted
+	assert.NoError(t, advanceCanary(status, steps, metav1.NewTime(start.Add(3*time.Minute))))
+	assert.Equal(t, v1.RolloutPhasePaused, status.Phase)
+	assert.Equal(t, int32(2), status.Step)
+
+	// Promoting past the last step promotes the rollout
+	status.Step++
+	assert.NoError(t, advanceCanary(status, steps, metav1.Now()))
+	assert.Equal(t, v1.RolloutPhasePromoting, status.Phase)
+
+	status = &v1.RolloutStatus{StepStartTime: &start}
+	assert.Error(t, advanceCanary(status, []v1.RolloutStep{{Weight: 10, Pause: "soon"}}, metav1.Now()))
+}
+
+func TestAbortPromotion(t *testing.T) {
+	selector := map[string]string{labels.AcornContainerName: "web", labels.AcornRolloutTrack: v1.RolloutTrackStable}
+	template := func(hash, image string) corev1.PodTemplateSpec {
+		return corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels:      labels.Merge(selector, map[string]string{appsv1.DefaultDeploymentUniqueLabelKey: hash}),
+				Annotations: map[string]string{labels.AcornRolloutHash: hash},
+			},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "web", Image: image}}},
+		}
+	}
+
+	// The promotion already moved the stable deployment to the new version
+	existing := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "web",
+			Namespace:   "app",
+			UID:         "web-uid",
+			Annotations: map[string]string{labels.AcornRolloutHash: "new"},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: selector},
+			Template: template("new", "web:v2"),
+		},
+	}
+	stableReplicaSet := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "web-old",
+			Namespace:       "app",
+			Labels:          selector,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(existing, appsv1.SchemeGroupVersion.WithKind("Deployment"))},
+		},
+		Spec: appsv1.ReplicaSetSpec{Template: template("old", "web:v1")},
+	}
+	req := router.Request{
+		Ctx:    context.Background(),
+		Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(existing, stableReplicaSet).Build(),
+	}
+
+	status := &v1.RolloutStatus{
+		Phase:           v1.RolloutPhasePromoting,
+		StableHash:      "old",
+		CanaryHash:      "new",
+		Weight:          100,
+		RequestedAction: v1.RolloutActionAbort,
+	}
+	objs, err := abortPromotion(req, status, existing, existing)
+	if !assert.NoError(t, err) || !assert.Len(t, objs, 1) {
+		return
+	}
+	assert.Equal(t, v1.RolloutPhaseAborted, status.Phase)
+	assert.Equal(t, int32(0), status.Weight)
+	assert.Empty(t, status.RequestedAction)
+
+	stable := objs[0].(*appsv1.Deployment)
+	assert.Equal(t, "old", stable.Annotations[labels.AcornRolloutHash])
+	assert.Equal(t, "web:v1", stable.Spec.Template.Spec.Containers[0].Image)
+	assert.NotContains(t, stable.Spec.Template.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+
+	// Without the template of the stable version the promotion continues
+	status = &v1.RolloutStatus{
+		Phase:           v1.RolloutPhasePromoting,
+		StableHash:      "older",
+		CanaryHash:      "new",
+		RequestedAction: v1.RolloutActionAbort,
+	}
+	_, err = abortPromotion(req, status, existing, existing)
+	assert.NoError(t, err)
+	assert.Equal(t, v1.RolloutPhasePromoting, status.Phase)
+	assert.Empty(t, status.RequestedAction)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -186,58 +187,186 @@ func toRouter(appInstance *v1.AppInstance, routerName string, router v1.Router,
 
 func toNginxConf(internalClusterDomain, namespace, routerName string, router v1.Router) (string, string) {
 	buf := &strings.Builder{}
+	routes := map[string][]int{}
+	var paths []string
+	for i, route := range router.Routes {
+		if len(route.GetTargets()) == 0 || route.Path == "" {
+			continue
+		}
+		key := string(route.PathType) + route.Path
+		if _, ok := routes[key]; !ok {
+			paths = append(paths, key)
+		}
+		routes[key] = append(routes[key], i)
+	}
+
+	// Routes that split the requests across targets pick a target per request at the http level of the config
+	for _, key := range paths {
+		for _, i := range routes[key] {
+			if targets := router.Routes[i].GetTargets(); len(targets) > 1 {
+				writeSplitClients(buf, internalClusterDomain, namespace, i, targets)
+			}
+		}
+	}
+
 	buf.WriteString("server {\nlisten 8080;\n")
-	for _, route := range router.Routes {
-		if route.TargetServiceName == "" || route.Path == "" {
+	for _, key := range paths {
+		group := routes[key]
+		route := router.Routes[group[0]]
+		if len(group) == 1 && !route.IsAdvanced() {
+			target := route.GetTargets()[0]
+			writeLocations(buf, route, func() {
+				buf.WriteString("  set $backend_servers ")
+				buf.WriteString(serviceHost(target.TargetServiceName, namespace, internalClusterDomain))
+				buf.WriteString(";\n  proxy_pass http://$backend_servers:")
+				buf.WriteString(strconv.Itoa(target.TargetPort))
+				buf.WriteString(";\n  proxy_set_header X-Forwarded-Host $http_host;")
+			})
 			continue
 		}
-		port := 80
-		if route.TargetPort != 0 {
-			port = route.TargetPort
+
+		writeLocations(buf, route, func() {
+			writeDispatch(buf, router.Routes, group)
+		})
+		for _, i := range group {
+			writeRouteLocation(buf, internalClusterDomain, namespace, i, router.Routes[i])
 		}
+	}
+	buf.WriteString("}\n")
+
+	conf := buf.String()
+	hash := sha256.Sum256([]byte(conf))
+	return conf, name2.SafeConcatName(routerName, hex.EncodeToString(hash[:])[:8])
+}
+
+// writeLocations writes the locations that match the path of the route with the given body.
+func writeLocations(buf *strings.Builder, route v1.Route, body func()) {
+	buf.WriteString("location = ")
+	buf.WriteString(route.Path)
+	buf.WriteString(" {\n")
+	body()
+	buf.WriteString("\n}\n")
+	if route.PathType == v1.PathTypePrefix && !strings.HasSuffix(route.Path, "/") {
 		buf.WriteString("location ")
-		buf.WriteString("= ")
 		buf.WriteString(route.Path)
-		buf.WriteString(" {\n  set $backend_servers ")
-		buf.WriteString(route.TargetServiceName)
-		buf.WriteString(".")
-		buf.WriteString(namespace)
-		buf.WriteString(".")
-		buf.WriteString(internalClusterDomain)
-		buf.WriteString(";\n  proxy_pass http://$backend_servers:")
-		buf.WriteString(strconv.Itoa(port))
-		buf.WriteString(";\n  proxy_set_header X-Forwarded-Host $http_host;")
+		buf.WriteString("/ {\n")
+		body()
 		buf.WriteString("\n}\n")
-		if route.PathType == v1.PathTypePrefix && !strings.HasSuffix(route.Path, "/") {
-			buf.WriteString("location ")
-			buf.WriteString(route.Path)
-			buf.WriteString("/ {\n  set $backend_servers ")
-			buf.WriteString(route.TargetServiceName)
-			buf.WriteString(".")
-			buf.WriteString(namespace)
-			buf.WriteString(".")
-			buf.WriteString(internalClusterDomain)
-			buf.WriteString(";\n  proxy_pass http://$backend_servers:")
-			buf.WriteString(strconv.Itoa(port))
-			buf.WriteString(";\n  proxy_set_header X-Forwarded-Host $http_host;")
-			buf.WriteString("\n}\n")
-		}
-		if route.PathType == v1.PathTypePrefix && route.Path == "/" {
-			buf.WriteString("location / {\n  set $backend_servers ")
-			buf.WriteString(route.TargetServiceName)
-			buf.WriteString(".")
-			buf.WriteString(namespace)
-			buf.WriteString(".")
-			buf.WriteString(internalClusterDomain)
-			buf.WriteString(";\n  proxy_pass http://$backend_servers:")
-			buf.WriteString(strconv.Itoa(port))
-			buf.WriteString(";\n  proxy_set_header X-Forwarded-Host $http_host;")
-			buf.WriteString("\n}\n")
+	}
+	if route.PathType == v1.PathTypePrefix && route.Path == "/" {
+		buf.WriteString("location / {\n")
+		body()
+		buf.WriteString("\n}\n")
+	}
+}
+
+// writeDispatch picks the first route of the group that matches the request and sends the request to the internal
+// location of that route.
+func writeDispatch(buf *strings.Builder, routes []v1.Route, group []int) {
+	for _, i := range group {
+		route := routes[i]
+		flag := routeVar(i)
+		buf.WriteString("  set " + flag + " 1;\n")
+		if route.Match == nil {
+			continue
+		}
+		for _, header := range typed.SortedKeys(route.Match.Headers) {
+			variable := "$http_" + strings.ReplaceAll(strings.ToLower(header), "-", "_")
+			buf.WriteString("  if (" + variable + " != " + nginxQuote(route.Match.Headers[header]) + ") { set " + flag + " 0; }\n")
+		}
+		for _, cookie := range typed.SortedKeys(route.Match.Cookies) {
+			buf.WriteString("  if ($cookie_" + cookie + " != " + nginxQuote(route.Match.Cookies[cookie]) + ") { set " + flag + " 0; }\n")
+		}
+		if len(route.Match.Methods) > 0 {
+			methods := make([]string, 0, len(route.Match.Methods))
+			for _, method := range route.Match.Methods {
+				methods = append(methods, strings.ToUpper(method))
+			}
+			buf.WriteString("  if ($request_method !~ ^(" + strings.Join(methods, "|") + ")$) { set " + flag + " 0; }\n")
+		}
+	}
+
+	// Evaluated in reverse so that the first route that matches wins
+	buf.WriteString("  set $acorn_route \"\";\n")
+	for j := len(group) - 1; j >= 0; j-- {
+		i := strconv.Itoa(group[j])
+		buf.WriteString("  if (" + routeVar(group[j]) + " = 1) { set $acorn_route " + i + "; }\n")
+	}
+	buf.WriteString("  if ($acorn_route = \"\") { return 404; }\n")
+	buf.WriteString("  rewrite ^(.*)$ /_acorn_route_$acorn_route$1 last;")
+}
+
+// writeRouteLocation writes the internal location of the route that rewrites the request and the response and proxies
+// the request to the targets of the route.
+func writeRouteLocation(buf *strings.Builder, internalClusterDomain, namespace string, i int, route v1.Route) {
+	prefix := "/_acorn_route_" + strconv.Itoa(i)
+	buf.WriteString("location ^~ " + prefix + "/ {\n  internal;\n")
+	if route.StripPrefix && route.Path != "/" {
+		buf.WriteString("  rewrite " + nginxQuote("^"+regexp.QuoteMeta(prefix+strings.TrimSuffix(route.Path, "/"))+"/?(.*)$") + " /$1 break;\n")
+	} else {
+		buf.WriteString("  rewrite " + nginxQuote("^"+regexp.QuoteMeta(prefix)+"(/.*)$") + " $1 break;\n")
+	}
+
+	buf.WriteString("  proxy_set_header X-Forwarded-Host $http_host;\n")
+	if route.RequestHeaders != nil {
+		for _, header := range typed.SortedKeys(route.RequestHeaders.Set) {
+			buf.WriteString("  proxy_set_header " + header + " " + nginxQuote(route.RequestHeaders.Set[header]) + ";\n")
+		}
+		for _, header := range route.RequestHeaders.Remove {
+			buf.WriteString("  proxy_set_header " + header + " \"\";\n")
+		}
+	}
+	if route.ResponseHeaders != nil {
+		for _, header := range typed.SortedKeys(route.ResponseHeaders.Set) {
+			buf.WriteString("  proxy_hide_header " + header + ";\n")
+			buf.WriteString("  add_header " + header + " " + nginxQuote(route.ResponseHeaders.Set[header]) + " always;\n")
+		}
+		for _, header := range route.ResponseHeaders.Remove {
+			buf.WriteString("  proxy_hide_header " + header + ";\n")
+		}
+	}
+
+	if targets := route.GetTargets(); len(targets) > 1 {
+		buf.WriteString("  proxy_pass http://" + routeVar(i) + "_backend;")
+	} else {
+		buf.WriteString("  set $backend_servers " + serviceHost(targets[0].TargetServiceName, namespace, internalClusterDomain) + ";\n")
+		buf.WriteString("  proxy_pass http://$backend_servers:" + strconv.Itoa(targets[0].TargetPort) + ";")
+	}
+	buf.WriteString("\n}\n")
+}
+
+// writeSplitClients writes the split_clients block that picks one of the targets of the route by weight for each
+// request.
+func writeSplitClients(buf *strings.Builder, internalClusterDomain, namespace string, i int, targets []v1.RouteTarget) {
+	var total int
+	for _, target := range targets {
+		total += target.Weight
+	}
+
+	buf.WriteString("split_clients \"${request_id}\" " + routeVar(i) + "_backend {\n")
+	var last v1.RouteTarget
+	for _, target := range targets {
+		if target.Weight == 0 {
+			continue
+		}
+		if last.Weight > 0 {
+			percent := strconv.FormatFloat(float64(last.Weight)*100/float64(total), 'f', 2, 64)
+			buf.WriteString("  " + percent + "% " + serviceHost(last.TargetServiceName, namespace, internalClusterDomain) + ":" + strconv.Itoa(last.TargetPort) + ";\n")
 		}
+		last = target
 	}
+	buf.WriteString("  * " + serviceHost(last.TargetServiceName, namespace, internalClusterDomain) + ":" + strconv.Itoa(last.TargetPort) + ";\n")
 	buf.WriteString("}\n")
+}
 
-	conf := buf.String()
-	hash := sha256.Sum256([]byte(conf))
-	return conf, name2.SafeConcatName(routerName, hex.EncodeToString(hash[:])[:8])
+func routeVar(i int) string {
+	return "$acorn_route_" + strconv.Itoa(i)
+}
+
+func serviceHost(serviceName, namespace, internalClusterDomain string) string {
+	return serviceName + "." + namespace + "." + internalClusterDomain
+}
+
+func nginxQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
 }
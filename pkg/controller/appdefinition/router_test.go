@@ -10,3 +10,7 @@ import (
 func TestRouter(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/router", DeploySpec)
 }
+
+func TestRouterAdvanced(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/router-advanced", DeploySpec)
+}
@@ -12,11 +12,13 @@ import (
 	"github.com/acorn-io/baaah/pkg/typed"
 	"github.com/acorn-io/baaah/pkg/uncached"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/imagesystem"
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/publicname"
 	"github.com/acorn-io/runtime/pkg/secrets"
 	"github.com/acorn-io/runtime/pkg/volume"
 	"github.com/acorn-io/z"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -210,6 +212,14 @@ func toPVCs(req router.Request, appInstance *v1.AppInstance) (result []kclient.O
 			} else {
 				pvc.Spec.Resources.Requests[corev1.ResourceStorage] = *v1.MustParseResourceQuantity(volumeRequest.Size)
 			}
+
+			if volumeBinding.Snapshot != "" {
+				restoreObjects, err := restoreSnapshot(req, appInstance, &pvc, volumeBinding.Snapshot, pvName != "")
+				if err != nil {
+					return nil, err
+				}
+				result = append(result, restoreObjects...)
+			}
 		}
 
 		// Ensure that no other PersistentVolume exists with the same public name
@@ -232,6 +242,112 @@ func toPVCs(req router.Request, appInstance *v1.AppInstance) (result []kclient.O
 	return
 }
 
+// restoreSnapshot sets up pvc to be restored from the volume snapshot and returns the objects that restore it. CSI
+// snapshots are restored through the data source of the claim, archives by a job that extracts them into the volume.
+func restoreSnapshot(req router.Request, appInstance *v1.AppInstance, pvc *corev1.PersistentVolumeClaim, snapshotName string, exists bool) ([]kclient.Object, error) {
+	snapshot := &v1.VolumeSnapshotInstance{}
+	if err := req.Get(snapshot, appInstance.Namespace, snapshotName); apierrors.IsNotFound(err) && exists {
+		// The volume was already restored before the snapshot was deleted, keep the immutable data source as is
+		existing := &corev1.PersistentVolumeClaim{}
+		if err := req.Get(existing, pvc.Namespace, pvc.Name); err == nil {
+			pvc.Spec.DataSource = existing.Spec.DataSource
+		} else if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		return nil, nil
+	} else if apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("volume snapshot %s not found", snapshotName)
+	} else if err != nil {
+		return nil, err
+	}
+
+	if snapshot.Status.Error != "" {
+		return nil, fmt.Errorf("can not restore volume snapshot %s: %s", snapshotName, snapshot.Status.Error)
+	} else if !snapshot.Status.Ready {
+		return nil, fmt.Errorf("volume snapshot %s is not ready", snapshotName)
+	}
+
+	if snapshot.Status.Size != "" {
+		size := v1.MustParseResourceQuantity(snapshot.Status.Size)
+		if requested := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; size.Cmp(requested) > 0 {
+			pvc.Spec.Resources.Requests[corev1.ResourceStorage] = *size
+		}
+	}
+
+	objLabels := map[string]string{
+		labels.AcornAppName:            appInstance.Name,
+		labels.AcornAppNamespace:       appInstance.Namespace,
+		labels.AcornVolumeSnapshotName: snapshot.Name,
+	}
+	restoreName := name.SafeConcatName(pvc.Name, "restore")
+
+	switch snapshot.Status.Method {
+	case v1.VolumeSnapshotMethodCSI:
+		volumeSnapshot, content := volume.NewPreProvisionedVolumeSnapshot(pvc.Namespace, restoreName,
+			name.SafeConcatName("restore", pvc.Namespace, pvc.Name), snapshot.Status.Driver, snapshot.Status.SnapshotHandle, objLabels)
+		pvc.Spec.DataSource = volume.SnapshotDataSource(restoreName)
+		return []kclient.Object{volumeSnapshot, content}, nil
+	case v1.VolumeSnapshotMethodArchive:
+		_, external, err := imagesystem.GetInternalRepoForNamespace(req.Ctx, req.Client, appInstance.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		args := []string{"--restore", snapshot.Status.Artifact, volume.ArchivePath}
+		if !external {
+			args = append([]string{"--insecure"}, args...)
+		}
+		return []kclient.Object{volume.NewArchiveJob(pvc.Namespace, restoreName, pvc.Name, false, objLabels, args...)}, nil
+	default:
+		return nil, fmt.Errorf("volume snapshot %s has an unsupported method %q", snapshotName, snapshot.Status.Method)
+	}
+}
+
+// restoringVolume returns the name of a volume mounted by the container that is still being restored from an archive
+// snapshot, or an empty string if there is none. CSI snapshots are restored before the claim is bound, so the pods
+// of the container can't start on them too early.
+func restoringVolume(req router.Request, appInstance *v1.AppInstance, container v1.Container) (string, error) {
+	var volumes []string
+	for _, mount := range container.Dirs {
+		volumes = append(volumes, mount.Volume)
+	}
+	for _, sidecar := range container.Sidecars {
+		for _, mount := range sidecar.Dirs {
+			volumes = append(volumes, mount.Volume)
+		}
+	}
+	sort.Strings(volumes)
+
+	for _, vol := range volumes {
+		volumeBinding, bind := isBind(appInstance, vol)
+		if vol == "" || bind || volumeBinding.Snapshot == "" {
+			continue
+		}
+
+		snapshot := &v1.VolumeSnapshotInstance{}
+		if err := req.Get(snapshot, appInstance.Namespace, volumeBinding.Snapshot); apierrors.IsNotFound(err) {
+			// Either the volume was already restored, or the claim reports that the snapshot is missing
+			continue
+		} else if err != nil {
+			return "", err
+		}
+		if snapshot.Status.Method != v1.VolumeSnapshotMethodArchive {
+			continue
+		}
+
+		job := &batchv1.Job{}
+		if err := req.Get(job, appInstance.Status.Namespace, name.SafeConcatName(vol, "restore")); apierrors.IsNotFound(err) {
+			return vol, nil
+		} else if err != nil {
+			return "", err
+		}
+		if job.Status.Succeeded == 0 {
+			return vol, nil
+		}
+	}
+
+	return "", nil
+}
+
 func getPVForVolumeBinding(req router.Request, appInstance *v1.AppInstance, binding v1.VolumeBinding) (*corev1.PersistentVolume, error) {
 	// binding.Volume can either be the actual name of the PersistentVolume, or its public name in Acorn.
 	// Check for the actual name first.
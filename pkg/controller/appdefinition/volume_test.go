@@ -11,6 +11,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/scheme"
 	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -278,6 +279,55 @@ func TestFindPVForBinding(t *testing.T) {
 	}
 }
 
+func TestRestoringVolume(t *testing.T) {
+	appInstance := &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "myApp",
+			Namespace: "proj1",
+		},
+		Spec: v1.AppInstanceSpec{
+			Volumes: []v1.VolumeBinding{{Target: "data", Snapshot: "backup"}},
+		},
+		Status: v1.AppInstanceStatus{
+			EmbeddedAppStatus: v1.EmbeddedAppStatus{
+				Namespace: "myapp-ns",
+			},
+		},
+	}
+	container := v1.Container{
+		Dirs: map[string]v1.VolumeMount{"/data": {Volume: "data"}},
+	}
+	snapshot := &v1.VolumeSnapshotInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "proj1"},
+		Status:     v1.VolumeSnapshotInstanceStatus{Method: v1.VolumeSnapshotMethodArchive},
+	}
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "data-restore", Namespace: "myapp-ns"},
+	}
+
+	// The restore job has not been created yet
+	vol, err := restoringVolume(tester.NewRequest(t, scheme.Scheme, appInstance, snapshot), appInstance, container)
+	assert.NoError(t, err)
+	assert.Equal(t, "data", vol)
+
+	// The restore job is still running
+	vol, err = restoringVolume(tester.NewRequest(t, scheme.Scheme, appInstance, snapshot, job.DeepCopy()), appInstance, container)
+	assert.NoError(t, err)
+	assert.Equal(t, "data", vol)
+
+	// The restore job is done
+	job.Status.Succeeded = 1
+	vol, err = restoringVolume(tester.NewRequest(t, scheme.Scheme, appInstance, snapshot, job), appInstance, container)
+	assert.NoError(t, err)
+	assert.Empty(t, vol)
+
+	// CSI snapshots are restored before the claim is bound
+	snapshot.Status.Method = v1.VolumeSnapshotMethodCSI
+	vol, err = restoringVolume(tester.NewRequest(t, scheme.Scheme, appInstance, snapshot), appInstance, container)
+	assert.NoError(t, err)
+	assert.Empty(t, vol)
+}
+
 func buildPVs(t *testing.T) []kclient.Object {
 	t.Helper()
 
@@ -0,0 +1,130 @@
+package apprevision
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/acorn-io/baaah/pkg/name"
+	"github.com/acorn-io/baaah/pkg/router"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	klabels "k8s.io/apimachinery/pkg/labels"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MaxRevisions is the number of revisions kept for each app. Older revisions are pruned as new ones are recorded.
+const MaxRevisions = 10
+
+// RecordRevision creates a new AppRevisionInstance whenever the spec or the resolved image of an app changes.
+// Revisions are owned by the app, so they are garbage collected when the app is deleted.
+func RecordRevision(req router.Request, _ router.Response) error {
+	app := req.Object.(*v1.AppInstance)
+
+	if !app.DeletionTimestamp.IsZero() ||
+		// Dev sessions overlay the spec of the app and should not be recorded
+		app.Status.DevSession != nil ||
+		// Nested apps are restored by rolling back their parent
+		app.Labels[labels.AcornParentAcornName] != "" ||
+		app.Status.AppImage.Digest == "" ||
+		// Wait until the image for the current spec has been promoted
+		app.Status.AppImage.Name != app.Spec.Image {
+		return nil
+	}
+
+	revisions := &v1.AppRevisionInstanceList{}
+	if err := req.List(revisions, &kclient.ListOptions{
+		Namespace: app.Namespace,
+		LabelSelector: klabels.SelectorFromSet(map[string]string{
+			labels.AcornAppName:      app.Name,
+			labels.AcornAppNamespace: app.Namespace,
+		}),
+	}); err != nil {
+		return err
+	}
+
+	items := ownedBy(app, revisions.Items)
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Spec.Revision < items[j].Spec.Revision
+	})
+
+	var latest int64
+	if len(items) > 0 {
+		last := items[len(items)-1]
+		if !Changed(&last, app) {
+			return nil
+		}
+		latest = last.Spec.Revision
+	}
+
+	revision := NewRevision(app, latest+1)
+	if err := req.Client.Create(req.Ctx, revision); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	items = append(items, *revision)
+	for _, prune := range ToPrune(items) {
+		if err := req.Client.Delete(req.Ctx, &prune); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewRevision returns the AppRevisionInstance for the current state of the app.
+func NewRevision(app *v1.AppInstance, revision int64) *v1.AppRevisionInstance {
+	spec := *app.Spec.DeepCopy()
+	spec.Stop = nil
+
+	return &v1.AppRevisionInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name.SafeConcatName(app.Name, strconv.FormatInt(revision, 10)),
+			Namespace: app.Namespace,
+			Labels: map[string]string{
+				labels.AcornAppName:      app.Name,
+				labels.AcornAppNamespace: app.Namespace,
+				labels.AcornManaged:      "true",
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(app, v1.SchemeGroupVersion.WithKind("AppInstance")),
+			},
+		},
+		Spec: v1.AppRevisionInstanceSpec{
+			AppName:     app.Name,
+			Revision:    revision,
+			AppSpec:     spec,
+			ImageID:     app.Status.AppImage.ID,
+			ImageDigest: app.Status.AppImage.Digest,
+		},
+	}
+}
+
+// Changed returns true if the app's spec (ignoring Stop) or image digest differs from the given revision.
+func Changed(revision *v1.AppRevisionInstance, app *v1.AppInstance) bool {
+	spec := *app.Spec.DeepCopy()
+	spec.Stop = nil
+	return revision.Spec.ImageDigest != app.Status.AppImage.Digest ||
+		!equality.Semantic.DeepEqual(revision.Spec.AppSpec, spec)
+}
+
+// ToPrune returns the oldest revisions beyond MaxRevisions. The revisions must be sorted oldest first.
+func ToPrune(revisions []v1.AppRevisionInstance) []v1.AppRevisionInstance {
+	if len(revisions) <= MaxRevisions {
+		return nil
+	}
+	return revisions[:len(revisions)-MaxRevisions]
+}
+
+// ownedBy filters out revisions left over from a previously deleted app with the same name.
+func ownedBy(app *v1.AppInstance, revisions []v1.AppRevisionInstance) (result []v1.AppRevisionInstance) {
+	for _, revision := range revisions {
+		if owner := metav1.GetControllerOf(&revision); owner != nil && owner.UID != app.UID {
+			continue
+		}
+		result = append(result, revision)
+	}
+	return result
+}
@@ -0,0 +1,64 @@
+package apprevision
+
+import (
+	"strconv"
+	"testing"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/z"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestChanged(t *testing.T) {
+	app := &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "acorn", UID: "1234"},
+		Spec:       v1.AppInstanceSpec{Image: "ghcr.io/acorn-io/test:v1"},
+		Status: v1.AppInstanceStatus{
+			EmbeddedAppStatus: v1.EmbeddedAppStatus{
+				AppImage: v1.AppImage{
+					ID:     "ghcr.io/acorn-io/test:v1",
+					Name:   "ghcr.io/acorn-io/test:v1",
+					Digest: "sha256:1111111111111111111111111111111111111111111111111111111111111111",
+				},
+			},
+		},
+	}
+
+	revision := NewRevision(app, 1)
+	assert.Equal(t, "app-1", revision.Name)
+	assert.Equal(t, int64(1), revision.Spec.Revision)
+	assert.Equal(t, app.Status.AppImage.Digest, revision.Spec.ImageDigest)
+	assert.False(t, Changed(revision, app))
+
+	// Stopping the app is not a new revision
+	app.Spec.Stop = z.Pointer(true)
+	assert.False(t, Changed(revision, app))
+
+	app.Status.AppImage.Digest = "sha256:2222222222222222222222222222222222222222222222222222222222222222"
+	assert.True(t, Changed(revision, app))
+
+	app.Status.AppImage.Digest = revision.Spec.ImageDigest
+	app.Spec.DeployArgs = v1.NewGenericMap(map[string]any{"foo": "bar"})
+	assert.True(t, Changed(revision, app))
+}
+
+func TestToPrune(t *testing.T) {
+	var revisions []v1.AppRevisionInstance
+	for i := 1; i <= MaxRevisions; i++ {
+		revisions = append(revisions, v1.AppRevisionInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "app-" + strconv.Itoa(i)},
+			Spec:       v1.AppRevisionInstanceSpec{Revision: int64(i)},
+		})
+	}
+	assert.Empty(t, ToPrune(revisions))
+
+	revisions = append(revisions, v1.AppRevisionInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-" + strconv.Itoa(MaxRevisions+1)},
+		Spec:       v1.AppRevisionInstanceSpec{Revision: int64(MaxRevisions + 1)},
+	})
+	pruned := ToPrune(revisions)
+	if assert.Len(t, pruned, 1) {
+		assert.Equal(t, "app-1", pruned[0].Name)
+	}
+}
@@ -0,0 +1,114 @@
+package appschedule
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/appschedule"
+	"github.com/acorn-io/runtime/pkg/event"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/z"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	AppScheduledStopEventType  = "AppScheduledStop"
+	AppScheduledStartEventType = "AppScheduledStart"
+)
+
+// ApplySchedule stops and starts the app on the transitions of its schedule, or the default schedule of its project. A
+// new schedule only applies transitions from then on, it doesn't change whether the app is stopped right away.
+func ApplySchedule(req router.Request, resp router.Response) error {
+	app := req.Object.(*v1.AppInstance)
+	if !app.DeletionTimestamp.IsZero() || app.Status.DevSession != nil {
+		return nil
+	}
+
+	schedule, err := scheduleFor(req, app)
+	if err != nil || schedule == nil {
+		app.Status.Schedule = nil
+		return err
+	}
+
+	parsed, err := appschedule.Parse(*schedule)
+	if err != nil {
+		app.Status.Schedule = nil
+		return err
+	}
+
+	now := time.Now()
+	status := app.Status.Schedule
+	if status == nil || status.Schedule != *schedule {
+		status = &v1.AppScheduleStatus{Schedule: *schedule}
+	} else if !status.NextTransition.IsZero() && !status.NextTransition.After(now) {
+		action, at := parsed.Latest(status.NextAction, status.NextTransition.Time, now)
+		if err := transition(req, app, action); err != nil {
+			return err
+		}
+		status.LastAction, status.LastTransition = action, metav1.NewTime(at)
+	}
+
+	action, next := parsed.Next(now)
+	status.NextAction, status.NextTransition = action, metav1.NewTime(next)
+	app.Status.Schedule = status
+
+	if !next.IsZero() {
+		resp.RetryAfter(time.Until(next))
+	}
+	return nil
+}
+
+// scheduleFor returns the schedule of the app, or the default schedule of its project. Nested apps are stopped and
+// started with their parent, so the project default doesn't apply to them.
+func scheduleFor(req router.Request, app *v1.AppInstance) (*v1.AppSchedule, error) {
+	if app.Spec.Schedule != nil {
+		return app.Spec.Schedule, nil
+	}
+	if app.Labels[labels.AcornParentAcornName] != "" {
+		return nil, nil
+	}
+
+	project := &v1.ProjectInstance{}
+	if err := req.Get(project, "", app.Namespace); apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return project.Spec.DefaultAppSchedule, nil
+}
+
+func transition(req router.Request, app *v1.AppInstance, action v1.AppScheduleAction) error {
+	stop := action == v1.AppScheduleActionStop
+	if z.Dereference(app.Spec.Stop) == stop {
+		return nil
+	}
+
+	app.Spec.Stop = &stop
+	if err := req.Client.Update(req.Ctx, app); err != nil {
+		return err
+	}
+
+	eventType, description := AppScheduledStartEventType, fmt.Sprintf("App %s/%s started on schedule", app.Namespace, app.Name)
+	if stop {
+		eventType, description = AppScheduledStopEventType, fmt.Sprintf("App %s/%s stopped on schedule", app.Namespace, app.Name)
+	}
+	if err := event.NewInstanceRecorder(req.Client).Record(req.Ctx, &apiv1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: app.Namespace,
+		},
+		Type:        eventType,
+		Severity:    apiv1.EventSeverityInfo,
+		Description: description,
+		AppName:     app.Name,
+		Resource:    event.Resource(app),
+		Observed:    apiv1.NowMicro(),
+	}); err != nil {
+		logrus.Warnf("Failed to record event: %v", err)
+	}
+	return nil
+}
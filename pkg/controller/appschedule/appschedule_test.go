@@ -0,0 +1,62 @@
+package appschedule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/router/tester"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/acorn-io/z"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestApplySchedule(t *testing.T) {
+	schedule := v1.AppSchedule{Stop: "0 20 * * *", Start: "0 7 * * *"}
+	project := &v1.ProjectInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "acorn"},
+		Spec:       v1.ProjectInstanceSpec{DefaultAppSchedule: &schedule},
+	}
+	app := &v1.AppInstance{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "acorn"}}
+
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(project, app).Build()
+	req := router.Request{Ctx: context.Background(), Client: c, Object: app}
+
+	// The project default is picked up without changing the app
+	resp := &tester.Response{}
+	require.NoError(t, ApplySchedule(req, resp))
+	require.NotNil(t, app.Status.Schedule)
+	assert.Equal(t, schedule, app.Status.Schedule.Schedule)
+	assert.NotZero(t, app.Status.Schedule.NextTransition)
+	assert.Equal(t, time.Until(app.Status.Schedule.NextTransition.Time).Round(time.Minute), resp.Delay.Round(time.Minute))
+	assert.Nil(t, app.Spec.Stop)
+
+	// The transition is due
+	app.Status.Schedule.NextAction = v1.AppScheduleActionStop
+	app.Status.Schedule.NextTransition = metav1.NewTime(time.Now().Add(-time.Minute))
+	require.NoError(t, ApplySchedule(req, &tester.Response{}))
+	assert.True(t, z.Dereference(app.Spec.Stop))
+	assert.Equal(t, v1.AppScheduleActionStop, app.Status.Schedule.LastAction)
+	assert.True(t, app.Status.Schedule.NextTransition.After(time.Now()))
+
+	stored := &v1.AppInstance{}
+	require.NoError(t, c.Get(req.Ctx, router.Key("acorn", "app"), stored))
+	assert.True(t, z.Dereference(stored.Spec.Stop))
+
+	events := &v1.EventInstanceList{}
+	require.NoError(t, c.List(req.Ctx, events))
+	require.Len(t, events.Items, 1)
+	assert.Equal(t, AppScheduledStopEventType, events.Items[0].Type)
+	assert.Equal(t, "app", events.Items[0].AppName)
+
+	// The app's own schedule takes precedence
+	app.Spec.Schedule = &v1.AppSchedule{Start: "@hourly"}
+	require.NoError(t, ApplySchedule(req, &tester.Response{}))
+	assert.Equal(t, *app.Spec.Schedule, app.Status.Schedule.Schedule)
+	assert.Equal(t, v1.AppScheduleActionStart, app.Status.Schedule.NextAction)
+}
@@ -8,6 +8,7 @@ import (
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/ports"
+	"github.com/acorn-io/z"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	klabels "k8s.io/apimachinery/pkg/labels"
@@ -33,11 +34,28 @@ func (a *appStatusRenderer) readContainers() error {
 		var cs v1.ContainerStatus
 		summary := summary[containerName]
 
+		if containerDef.Autoscale != nil {
+			if err := a.addAutoscaleSummary(containerName, &summary); err != nil {
+				return err
+			}
+			cs.Autoscale = &v1.AutoscaleStatus{
+				MinReplicas:     z.Dereference(containerDef.Autoscale.MinReplicas),
+				MaxReplicas:     containerDef.Autoscale.MaxReplicas,
+				DesiredReplicas: summary.DesiredCount,
+				CurrentReplicas: summary.CurrentCount,
+			}
+			if cs.Autoscale.MinReplicas == 0 {
+				cs.Autoscale.MinReplicas = 1
+			}
+		}
+
 		cs.Defined = ports.IsLinked(a.app, containerName)
 		cs.LinkOverride = ports.LinkService(a.app, containerName)
 		cs.ErrorMessages = append(cs.ErrorMessages, summary.ErrorMessages...)
 		cs.ExpressionErrors = existingStatus[containerName].ExpressionErrors
 		cs.Dependencies = existingStatus[containerName].Dependencies
+		cs.Rollout = existingStatus[containerName].Rollout
+		cs.Usage = existingStatus[containerName].Usage
 		cs.TransitioningMessages = append(cs.TransitioningMessages, summary.TransitioningMessages...)
 		cs.MaxReplicaRestartCount = summary.MaxReplicaRestartCount
 		hash, err := configHash(containerDef)
@@ -142,6 +160,14 @@ func setContainerMessages(app *v1.AppInstance) {
 			cs.TransitioningMessages = append(cs.TransitioningMessages, msg...)
 		}
 
+		if msg := rolloutMessage(cs.Rollout); msg != "" {
+			cs.Messages = append(cs.Messages, msg)
+		}
+		if msg := autoscaleMessage(cs.Autoscale); msg != "" {
+			cs.Messages = append(cs.Messages, msg)
+		}
+		cs.Messages = append(cs.Messages, usageMessages(cs.Usage)...)
+
 		// Add informative messages if all else is healthy
 		if len(cs.TransitioningMessages) == 0 && len(cs.ErrorMessages) == 0 {
 			if cs.RunningReplicaCount > 1 {
@@ -156,6 +182,30 @@ func setContainerMessages(app *v1.AppInstance) {
 	}
 }
 
+func autoscaleMessage(autoscale *v1.AutoscaleStatus) string {
+	if autoscale == nil || autoscale.DesiredReplicas == 0 || autoscale.DesiredReplicas == autoscale.CurrentReplicas {
+		return ""
+	}
+	return fmt.Sprintf("autoscaling from %d to %d replicas", autoscale.CurrentReplicas, autoscale.DesiredReplicas)
+}
+
+func rolloutMessage(rollout *v1.RolloutStatus) string {
+	if !rollout.InProgress() {
+		return ""
+	}
+	switch rollout.Phase {
+	case v1.RolloutPhasePaused:
+		if rollout.Strategy == v1.RolloutStrategyBlueGreen {
+			return "rollout paused, new version is not receiving traffic until promoted"
+		}
+		return fmt.Sprintf("rollout paused at step %d with %d%% of replicas on new version", rollout.Step+1, rollout.Weight)
+	case v1.RolloutPhasePromoting:
+		return "rollout promoting new version"
+	default:
+		return fmt.Sprintf("rollout at step %d with %d%% of replicas on new version", rollout.Step+1, rollout.Weight)
+	}
+}
+
 func (a *appStatusRenderer) isDeploymentReady(dep *appsv1.Deployment, labelNameForSelection string) (bool, error) {
 	available := false
 	for _, cond := range dep.Status.Conditions {
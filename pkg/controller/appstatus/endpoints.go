@@ -7,14 +7,19 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/acorn-io/baaah/pkg/router"
 	"github.com/acorn-io/baaah/pkg/typed"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/config"
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/publish"
+	"github.com/acorn-io/runtime/pkg/system"
+	"golang.org/x/exp/maps"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	klabels "k8s.io/apimachinery/pkg/labels"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
 func serviceEndpoints(ctx context.Context, c kclient.Client, app *v1.AppInstance) (endpoints []v1.Endpoint, _ error) {
@@ -169,6 +174,103 @@ func ingressEndpoints(ctx context.Context, c kclient.Client, app *v1.AppInstance
 	return
 }
 
+// gatewayEndpoints returns the endpoints of the ports published with Gateway API resources and the hostnames the shared
+// Gateway terminates TLS for.
+func gatewayEndpoints(ctx context.Context, c kclient.Client, app *v1.AppInstance) (endpoints []v1.Endpoint, tlsHosts map[string]struct{}, _ error) {
+	listOpts := &kclient.ListOptions{
+		Namespace: app.Status.Namespace,
+		LabelSelector: klabels.SelectorFromSet(map[string]string{
+			labels.AcornManaged: "true",
+		}),
+	}
+
+	sharedGateway := &gatewayv1beta1.Gateway{}
+	if err := c.Get(ctx, router.Key(system.Namespace, system.GatewayName), sharedGateway); kclient.IgnoreNotFound(err) != nil {
+		return nil, nil, err
+	}
+
+	routeList := &gatewayv1beta1.HTTPRouteList{}
+	if err := c.List(ctx, routeList, listOpts); err != nil {
+		return nil, nil, err
+	}
+
+	tlsHosts = map[string]struct{}{}
+	for _, route := range routeList.Items {
+		if route.Annotations[labels.AcornGatewayCertificate] != "" {
+			for _, hostname := range route.Spec.Hostnames {
+				tlsHosts[string(hostname)] = struct{}{}
+			}
+		}
+
+		targets, err := gatewayTargets(route.Annotations)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, entry := range typed.Sorted(targets) {
+			hostname, target := entry.Key, entry.Value
+			endpoints = append(endpoints, v1.Endpoint{
+				Target:     target.Service,
+				TargetPort: target.Port,
+				Path:       target.Path,
+				Address:    hostname,
+				Protocol:   v1.ProtocolHTTP,
+				Pending:    len(sharedGateway.Status.Addresses) == 0,
+			})
+		}
+	}
+
+	gatewayList := &gatewayv1beta1.GatewayList{}
+	if err := c.List(ctx, gatewayList, listOpts); err != nil {
+		return nil, nil, err
+	}
+
+	for _, gateway := range gatewayList.Items {
+		targets, err := gatewayTargets(gateway.Annotations)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, entry := range typed.Sorted(targets) {
+			key, target := entry.Key, entry.Value
+			ep := v1.Endpoint{
+				Target:     target.Service,
+				TargetPort: target.Port,
+				Address:    key,
+				Protocol:   target.Protocol,
+				Pending:    len(gateway.Status.Addresses) == 0,
+			}
+			if strings.Contains(key, ":") {
+				// TCP ports bound to a hostname are addressed by their hostname
+				endpoints = append(endpoints, ep)
+				continue
+			}
+
+			// TCP ports are addressed by the addresses of the Gateway and the published port, which is the key
+			if len(gateway.Status.Addresses) == 0 {
+				ep.Address = fmt.Sprintf("<Pending Ingress>:%s", key)
+				endpoints = append(endpoints, ep)
+			}
+			for _, address := range gateway.Status.Addresses {
+				ep.Address = fmt.Sprintf("%s:%s", address.Value, key)
+				endpoints = append(endpoints, ep)
+			}
+		}
+	}
+
+	return endpoints, tlsHosts, nil
+}
+
+func gatewayTargets(annotations map[string]string) (map[string]publish.Target, error) {
+	targets := map[string]publish.Target{}
+	if targetStr := annotations[labels.AcornTargets]; targetStr != "" {
+		if err := json.Unmarshal([]byte(targetStr), &targets); err != nil {
+			return nil, err
+		}
+	}
+	return targets, nil
+}
+
 func (a *appStatusRenderer) readEndpoints() error {
 	// reset state
 	a.app.Status.AppStatus.Endpoints = nil
@@ -190,6 +292,20 @@ func (a *appStatusRenderer) readEndpoints() error {
 		return err
 	}
 
+	cfg, err := config.Get(a.ctx, a.c)
+	if err != nil {
+		return err
+	}
+
+	if publish.UsesGateway(cfg) {
+		gatewayEndpoints, gatewayTLSHosts, err := gatewayEndpoints(a.ctx, a.c, a.app)
+		if err != nil {
+			return err
+		}
+		eps = append(eps, gatewayEndpoints...)
+		maps.Copy(ingressTLSHosts, gatewayTLSHosts)
+	}
+
 	for i, ep := range eps {
 		if ep.Protocol == v1.ProtocolHTTP {
 			ep.PublishProtocol = v1.PublishProtocolHTTP
@@ -3,18 +3,23 @@ package appstatus
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/runtime/pkg/activator"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/ports"
 	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func (a *appStatusRenderer) readFunctions() error {
 	var (
 		isTransitioning bool
+		activatorReady  *bool
 		existingStatus  = a.app.Status.AppStatus.Functions
 	)
 
@@ -35,6 +40,7 @@ func (a *appStatusRenderer) readFunctions() error {
 		cs.ErrorMessages = append(cs.ErrorMessages, summary.ErrorMessages...)
 		cs.ExpressionErrors = existingStatus[functionName].ExpressionErrors
 		cs.Dependencies = existingStatus[functionName].Dependencies
+		cs.Usage = existingStatus[functionName].Usage
 		cs.TransitioningMessages = append(cs.TransitioningMessages, summary.TransitioningMessages...)
 		cs.MaxReplicaRestartCount = summary.MaxReplicaRestartCount
 		hash, err := configHash(functionDef)
@@ -63,6 +69,23 @@ func (a *appStatusRenderer) readFunctions() error {
 			return err
 		}
 
+		if err == nil && activator.Enabled(a.app, functionDef) {
+			cs.Activation = activationStatus(&dep)
+			if !a.app.GetStopped() {
+				if activatorReady == nil {
+					ready, err := a.isActivatorReady()
+					if err != nil {
+						return err
+					}
+					activatorReady = &ready
+				}
+				if !*activatorReady {
+					cs.Ready = false
+					cs.TransitioningMessages = append(cs.TransitioningMessages, "waiting for activator")
+				}
+			}
+		}
+
 		if cs.LinkOverride != "" {
 			var err error
 			cs.UpToDate = true
@@ -108,6 +131,8 @@ func setFunctionMessages(app *v1.AppInstance) {
 		if cs.Ready {
 			if app.GetStopped() {
 				cs.State = "stopped"
+			} else if cs.Activation != nil && cs.Activation.ScaledToZero {
+				cs.State = "idle"
 			} else {
 				cs.State = "running"
 			}
@@ -139,6 +164,8 @@ func setFunctionMessages(app *v1.AppInstance) {
 			cs.TransitioningMessages = append(cs.TransitioningMessages, msg...)
 		}
 
+		cs.Messages = append(cs.Messages, usageMessages(cs.Usage)...)
+
 		// Add informative messages if all else is healthy
 		if len(cs.TransitioningMessages) == 0 && len(cs.ErrorMessages) == 0 {
 			if cs.RunningReplicaCount > 1 {
@@ -152,3 +179,33 @@ func setFunctionMessages(app *v1.AppInstance) {
 		app.Status.AppStatus.Functions[functionName] = cs
 	}
 }
+
+func (a *appStatusRenderer) isActivatorReady() (bool, error) {
+	dep := appsv1.Deployment{}
+	if err := a.c.Get(a.ctx, router.Key(a.app.Status.Namespace, activator.Name), &dep); apierrors.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return dep.Status.ReadyReplicas > 0, nil
+}
+
+// activationStatus reads the status of a function that scales from zero from its deployment and the annotations the
+// activator sets on it.
+func activationStatus(dep *appsv1.Deployment) *v1.ActivationStatus {
+	status := &v1.ActivationStatus{
+		ScaledToZero: dep.Spec.Replicas != nil && *dep.Spec.Replicas == 0 && dep.Status.Replicas == 0,
+	}
+	if status.ScaledToZero {
+		if t, err := time.Parse(time.RFC3339, dep.Annotations[labels.AcornScaledToZeroTime]); err == nil {
+			status.ScaledToZeroTime = metav1.NewTime(t)
+		}
+	}
+	if t, err := time.Parse(time.RFC3339, dep.Annotations[labels.AcornColdStartTime]); err == nil {
+		status.LastColdStart = metav1.NewTime(t)
+	}
+	if d, err := time.ParseDuration(dep.Annotations[labels.AcornColdStartDuration]); err == nil {
+		status.ColdStartLatency = metav1.Duration{Duration: d}
+	}
+	return status
+}
@@ -3,14 +3,17 @@ package appstatus
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/acorn-io/baaah/pkg/router"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/jobs"
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/ports"
 	"github.com/acorn-io/z"
 	cronv3 "github.com/robfig/cron/v3"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	apierror "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -27,6 +30,9 @@ func (a *appStatusRenderer) readJobs() error {
 		return err
 	}
 
+	// Cycles are rejected when the app is created, so an app with one just doesn't report the steps of its jobs
+	steps, numSteps, _ := jobs.Steps(a.app.Status.AppSpec.Jobs)
+
 	for jobName, jobDef := range a.app.Status.AppSpec.Jobs {
 		hash, err := configHash(jobDef)
 		if err != nil {
@@ -38,6 +44,7 @@ func (a *appStatusRenderer) readJobs() error {
 			Skipped:              existingStatus[jobName].Skipped,
 			ExpressionErrors:     existingStatus[jobName].ExpressionErrors,
 			Dependencies:         existingStatus[jobName].Dependencies,
+			Usage:                existingStatus[jobName].Usage,
 			CommonStatus: v1.CommonStatus{
 				ConfigHash: hash,
 			},
@@ -51,6 +58,10 @@ func (a *appStatusRenderer) readJobs() error {
 		c.RunningCount = summary.RunningCount
 		c.JobName = jobName
 		c.JobNamespace = a.app.Status.Namespace
+		if numSteps > 1 {
+			c.Step = steps[jobName]
+			c.Steps = numSteps
+		}
 
 		if c.Skipped {
 			c.CreationTime = &a.app.CreationTimestamp
@@ -65,7 +76,10 @@ func (a *appStatusRenderer) readJobs() error {
 			continue
 		}
 
-		var job batchv1.Job
+		var (
+			job       batchv1.Job
+			succeeded bool
+		)
 		err = a.c.Get(a.ctx, router.Key(a.app.Status.Namespace, jobName), &job)
 		if apierror.IsNotFound(err) {
 			var cronJob batchv1.CronJob
@@ -88,6 +102,7 @@ func (a *appStatusRenderer) readJobs() error {
 				}
 
 				if cronJob.Status.LastSuccessfulTime != nil {
+					succeeded = true
 					c.CreateEventSucceeded = true
 					c.Ready = c.UpToDate
 				}
@@ -108,16 +123,28 @@ func (a *appStatusRenderer) readJobs() error {
 			c.LastRun = job.Status.StartTime
 			c.Defined = true
 			c.UpToDate = job.Annotations[labels.AcornAppGeneration] == strconv.Itoa(int(a.app.Generation)) && (c.Skipped || job.Annotations[labels.AcornConfigHashAnnotation] == hash)
+			setJobAttempts(&c, &job, jobDef)
 			if job.Status.Succeeded > 0 {
+				succeeded = true
 				c.CreateEventSucceeded = true
 				c.Ready = c.UpToDate
 			} else if job.Status.Failed > 0 {
-				c.ErrorCount = int(job.Status.Failed)
+				// Jobs retried with a backoff or timeout are recreated for each attempt, so count the failures of the attempts before
+				c.ErrorCount = c.Attempt - 1 + int(job.Status.Failed)
+				if !jobs.RecreatedPerAttempt(jobDef) {
+					c.ErrorCount = int(job.Status.Failed)
+				}
 			} else if job.Status.Active > 0 && c.RunningCount == 0 {
 				c.RunningCount = int(job.Status.Active)
 			}
 		}
 
+		if succeeded {
+			if err := a.readJobOutputs(&c, jobName, jobDef); err != nil {
+				return err
+			}
+		}
+
 		if c.LinkOverride != "" {
 			var err error
 			c.UpToDate = true
@@ -136,10 +163,64 @@ func (a *appStatusRenderer) readJobs() error {
 	return nil
 }
 
+// readJobOutputs sets the outputs recorded by the last successful run of the job. A job with an output schema is not
+// ready until its outputs are recorded and valid.
+func (a *appStatusRenderer) readJobOutputs(c *v1.JobStatus, jobName string, jobDef v1.Container) error {
+	secret := &corev1.Secret{}
+	err := a.c.Get(a.ctx, router.Key(a.app.Status.Namespace, jobs.GetJobOutputsSecretName(a.app.Status.Namespace, jobName)), secret)
+	if apierror.IsNotFound(err) {
+		if jobDef.OutputSchema != nil {
+			c.Ready = false
+			c.TransitioningMessages = append(c.TransitioningMessages, "waiting for outputs")
+		}
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	outputs, err := jobs.ParseOutputs(secret.Data[jobs.OutputsSecretKey], jobDef.OutputSchema)
+	if err != nil {
+		c.Ready = false
+		c.ErrorMessages = append(c.ErrorMessages, err.Error())
+		return nil
+	}
+	c.Outputs = outputs
+	return nil
+}
+
+// setJobAttempts sets the number of the current or last attempt of the job, how many attempts it gets and, if the job
+// is waiting for a backoff before it is retried, when the next attempt is.
+func setJobAttempts(c *v1.JobStatus, job *batchv1.Job, jobDef v1.Container) {
+	c.MaxAttempts = int(jobs.Retries(jobDef)) + 1
+	if !jobs.RecreatedPerAttempt(jobDef) {
+		c.Attempt = min(int(job.Status.Failed)+1, c.MaxAttempts)
+		return
+	}
+
+	c.Attempt = jobs.Attempt(job)
+	if _, failed := jobs.FailedTime(job); !failed || c.Attempt >= c.MaxAttempts {
+		return
+	}
+	if next, err := time.Parse(time.RFC3339, job.Annotations[labels.AcornJobNextAttempt]); err == nil {
+		c.NextAttempt = z.Pointer(metav1.NewTime(next))
+	}
+}
+
 func setJobMessages(app *v1.AppInstance) {
 	for jobName, c := range app.Status.AppStatus.Jobs {
 		if c.RunningCount > 0 {
 			c.TransitioningMessages = append(c.TransitioningMessages, "job running")
+			if c.Attempt > 1 {
+				c.TransitioningMessages = append(c.TransitioningMessages, fmt.Sprintf("attempt %d of %d", c.Attempt, c.MaxAttempts))
+			}
+			// Move error to transitioning to make it look better
+			c.TransitioningMessages = append(c.TransitioningMessages, c.ErrorMessages...)
+			c.ErrorMessages = nil
+		} else if c.MaxAttempts > 0 && c.ErrorCount >= c.MaxAttempts {
+			c.ErrorMessages = append(c.ErrorMessages, fmt.Sprintf("job failed after %d attempts", c.ErrorCount))
+		} else if c.ErrorCount > 0 && c.NextAttempt != nil {
+			c.TransitioningMessages = append(c.TransitioningMessages, fmt.Sprintf("attempt %d of %d failed, retrying at %s",
+				c.Attempt, c.MaxAttempts, c.NextAttempt.UTC().Format(time.RFC3339)))
 			// Move error to transitioning to make it look better
 			c.TransitioningMessages = append(c.TransitioningMessages, c.ErrorMessages...)
 			c.ErrorMessages = nil
@@ -189,8 +270,13 @@ func setJobMessages(app *v1.AppInstance) {
 				c.State = "waiting"
 			}
 			c.TransitioningMessages = append(c.TransitioningMessages, msg...)
+			if c.Steps > 1 {
+				c.TransitioningMessages = append(c.TransitioningMessages, fmt.Sprintf("step %d of %d", c.Step, c.Steps))
+			}
 		}
 
+		c.Messages = append(c.Messages, usageMessages(c.Usage)...)
+
 		app.Status.AppStatus.Jobs[jobName] = c
 	}
 }
@@ -5,9 +5,12 @@ import (
 	"sort"
 	"strconv"
 
+	"github.com/acorn-io/baaah/pkg/router"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/labels"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	klabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -68,6 +71,27 @@ func (a *appStatusRenderer) getReplicasSummary(nameLabel string) (map[string]v1.
 	return result, nil
 }
 
+// addAutoscaleSummary adds the replicas the HorizontalPodAutoscaler of a workload wants and the replicas it last
+// observed to the summary, along with the reason the autoscaler is unable to scale, if any.
+func (a *appStatusRenderer) addAutoscaleSummary(name string, summary *v1.ReplicasSummary) error {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	if err := a.c.Get(a.ctx, router.Key(a.app.Status.Namespace, name), hpa); apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	summary.DesiredCount = hpa.Status.DesiredReplicas
+	summary.CurrentCount = hpa.Status.CurrentReplicas
+	for _, cond := range hpa.Status.Conditions {
+		if cond.Type == autoscalingv2.ScalingActive && cond.Status == corev1.ConditionFalse {
+			summary.TransitioningMessages = append(summary.TransitioningMessages, "autoscaling inactive: "+cond.Message)
+		}
+	}
+
+	return nil
+}
+
 func containerMessages(status []corev1.ContainerStatus) (transitionMessages, errorMessages []string) {
 	for _, container := range status {
 		if container.State.Waiting != nil {
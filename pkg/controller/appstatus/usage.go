@@ -0,0 +1,130 @@
+package appstatus
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/uncached"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/usage"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	klabels "k8s.io/apimachinery/pkg/labels"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// usageRefreshInterval is how often the usage of an app is read. The metrics API can not be watched, so usage is polled.
+const usageRefreshInterval = time.Minute
+
+// UsageStatus sets the CPU and memory used by the containers, functions and jobs of the app, and by the app as a whole.
+// Usage is kept for usageRefreshInterval before it is read again so that the status of the app is not updated every
+// time it is reconciled.
+func UsageStatus(req router.Request, resp router.Response) error {
+	app := req.Object.(*v1.AppInstance)
+	status := &app.Status.AppStatus
+
+	if app.GetStopped() || app.Status.Namespace == "" {
+		status.Usage = nil
+		return nil
+	}
+
+	if status.Usage != nil {
+		if wait := usageRefreshInterval - time.Since(status.Usage.Time.Time); wait > 0 {
+			resp.RetryAfter(wait)
+			return nil
+		}
+	}
+
+	sel := klabels.SelectorFromSet(map[string]string{
+		labels.AcornManaged: "true",
+		labels.AcornAppName: app.Name,
+	})
+
+	pods := &corev1.PodList{}
+	if err := req.List(pods, &kclient.ListOptions{
+		Namespace:     app.Status.Namespace,
+		LabelSelector: sel,
+	}); err != nil {
+		return err
+	}
+
+	podMetrics := usage.NewPodMetricsList()
+	if err := req.Client.List(req.Ctx, uncached.List(podMetrics), &kclient.ListOptions{
+		Namespace:     app.Status.Namespace,
+		LabelSelector: sel,
+	}); err != nil {
+		// Usage is informational, so the memory limits and OOMKilled restarts from the pods are still reported without it
+		if !usage.IsUnavailable(err) {
+			logrus.Debugf("failed to read pod metrics of app %s/%s: %v", app.Namespace, app.Name, err)
+		}
+		podMetrics.Items = nil
+	}
+	metrics := usage.FromPodMetrics(podMetrics)
+
+	var (
+		now        = metav1.Now()
+		total      = v1.Usage{Time: now}
+		containers = map[string]*v1.Usage{}
+		functions  = map[string]*v1.Usage{}
+		jobs       = map[string]*v1.Usage{}
+	)
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase == corev1.PodSucceeded {
+			continue
+		}
+
+		podUsage := usage.ForPod(pod, metrics)
+		podUsage.Time = now
+		total.Add(podUsage)
+
+		for nameLabel, byName := range map[string]map[string]*v1.Usage{
+			labels.AcornContainerName: containers,
+			labels.AcornFunctionName:  functions,
+			labels.AcornJobName:       jobs,
+		} {
+			name := pod.Labels[nameLabel]
+			if name == "" {
+				continue
+			}
+			if byName[name] == nil {
+				byName[name] = &v1.Usage{}
+			}
+			byName[name].Add(podUsage)
+		}
+	}
+
+	for name, cs := range status.Containers {
+		cs.Usage = containers[name]
+		status.Containers[name] = cs
+	}
+	for name, cs := range status.Functions {
+		cs.Usage = functions[name]
+		status.Functions[name] = cs
+	}
+	for name, js := range status.Jobs {
+		js.Usage = jobs[name]
+		status.Jobs[name] = js
+	}
+	status.Usage = &total
+
+	resp.RetryAfter(usageRefreshInterval)
+	return nil
+}
+
+// usageMessages returns the messages that warn about a workload running out of memory.
+func usageMessages(workloadUsage *v1.Usage) (result []string) {
+	if workloadUsage == nil {
+		return nil
+	}
+	if workloadUsage.NearMemoryLimit {
+		result = append(result, "memory usage near limit")
+	}
+	if workloadUsage.RepeatedlyOOMKilled() {
+		result = append(result, fmt.Sprintf("last restart was OOMKilled (%d restarts)", workloadUsage.OOMKilledRestarts))
+	}
+	return result
+}
@@ -0,0 +1,91 @@
+package config
+
+import (
+	"github.com/acorn-io/baaah/pkg/router"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/dns"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/publish"
+	"github.com/acorn-io/runtime/pkg/system"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/strings/slices"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+func NewGatewayHandler() router.Handler {
+	return &gatewayHandler{
+		dns: dns.NewClient(),
+	}
+}
+
+type gatewayHandler struct {
+	dns dns.Client
+}
+
+// Handle creates the shared Gateway that HTTP ports are published on when the gateway publish backend is used. Once the
+// Gateway has addresses, it calls the AcornDNS service to create a wildcard record for them, like the DNS ingress
+// handler does for the acorn-dns-ingress Ingress when the ingress publish backend is used.
+func (h *gatewayHandler) Handle(req router.Request, resp router.Response) error {
+	cfg, err := config.UnmarshalAndComplete(req.Ctx, req.Object.(*corev1.ConfigMap), req.Client)
+	if err != nil {
+		return err
+	}
+
+	if !publish.UsesGateway(cfg) {
+		return nil
+	}
+
+	gateway, err := publish.SharedGateway(req, cfg)
+	if err != nil {
+		return err
+	}
+	resp.Objects(gateway)
+
+	return h.createRecords(req, cfg)
+}
+
+func (h *gatewayHandler) createRecords(req router.Request, cfg *apiv1.Config) error {
+	secret := &corev1.Secret{}
+	if err := req.Get(secret, system.Namespace, system.DNSSecretName); apierrors.IsNotFound(err) {
+		// DNS Secret doesn't exist. Nothing to do
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	domain := string(secret.Data["domain"])
+	token := string(secret.Data["token"])
+	if domain == "" || token == "" || !slices.Contains(cfg.ClusterDomains, domain) {
+		return nil
+	}
+
+	gateway := &gatewayv1beta1.Gateway{}
+	if err := req.Get(gateway, system.Namespace, system.GatewayName); apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	requests, hash := dns.GatewayToRecordRequestsAndHash(domain, gateway)
+	if len(requests) == 0 || hash == gateway.Annotations[labels.AcornDNSHash] {
+		// Either the Gateway has no addresses yet or the records for them were already made
+		return nil
+	}
+
+	if err := h.dns.CreateRecords(*cfg.AcornDNSEndpoint, domain, token, requests); err != nil {
+		if dns.IsDomainAuthError(err) {
+			if err := dns.ClearDNSToken(req.Ctx, req.Client, secret); err != nil {
+				return err
+			}
+		}
+		return err
+	}
+
+	if gateway.Annotations == nil {
+		gateway.Annotations = map[string]string{}
+	}
+	gateway.Annotations[labels.AcornDNSHash] = hash
+	return req.Client.Update(req.Ctx, gateway)
+}
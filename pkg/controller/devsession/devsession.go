@@ -8,16 +8,25 @@ import (
 	"github.com/acorn-io/baaah/pkg/router"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/publicname"
+	"github.com/acorn-io/z"
 	apierror "k8s.io/apimachinery/pkg/api/errors"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func ExpireDevSession(req router.Request, resp router.Response) error {
-	if delay := expired(req.Object.(*v1.DevSessionInstance)); delay < 0 && req.Object.GetDeletionTimestamp().IsZero() {
+	devSession := req.Object.(*v1.DevSessionInstance)
+	if delay := expired(devSession); delay < 0 && devSession.DeletionTimestamp.IsZero() {
 		// Don't delete devsession when the app is removing because this latest devsession might have the info in
 		// it to properly remove the object
 		app := &v1.AppInstance{}
 		if err := req.Get(app, req.Namespace, req.Name); err == nil && !app.DeletionTimestamp.IsZero() {
 			return nil
+		} else if err == nil && devSession.Spec.ExpireAction != nil {
+			if deleted, err := applyExpireAction(req, app, *devSession.Spec.ExpireAction); err != nil || deleted {
+				return err
+			}
+		} else if err != nil && !apierror.IsNotFound(err) {
+			return err
 		}
 		return req.Client.Delete(req.Ctx, req.Object)
 	} else if delay >= 0 {
@@ -26,6 +35,18 @@ func ExpireDevSession(req router.Request, resp router.Response) error {
 	return nil
 }
 
+// applyExpireAction applies the action of the expired devsession to the app and returns whether the app was deleted.
+func applyExpireAction(req router.Request, app *v1.AppInstance, action v1.DevSessionInstanceExpireAction) (bool, error) {
+	if action.Delete {
+		return true, kclient.IgnoreNotFound(req.Client.Delete(req.Ctx, app))
+	}
+	if action.Stop && !app.GetStopped() {
+		app.Spec.Stop = z.Pointer(true)
+		return false, req.Client.Update(req.Ctx, app)
+	}
+	return false, nil
+}
+
 func OverlayDevSession(next router.Handler) router.Handler {
 	return router.HandlerFunc(func(req router.Request, resp router.Response) error {
 		oldGeneration, err := updateAppForDevSession(req)
@@ -0,0 +1,251 @@
+package eventsubscription
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/egress"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	SignatureHeader = "X-Acorn-Signature"
+	EventTypeHeader = "X-Acorn-Event-Type"
+	DeliveryHeader  = "X-Acorn-Delivery"
+
+	defaultMaxRetries = 5
+	deliveryTimeout   = 10 * time.Second
+	minBackoff        = 5 * time.Second
+	maxBackoff        = 10 * time.Minute
+	maxDeadLetters    = 10
+
+	// deliveryBudget is how long a reconcile starts new deliveries for, and maxDeliveries how many it attempts at most,
+	// so that a slow URL or a burst of events doesn't hold the worker. The remaining events are delivered on the next
+	// reconcile.
+	deliveryBudget = 5 * time.Second
+	maxDeliveries  = 20
+)
+
+type handler struct {
+	client *http.Client
+}
+
+// DeliverEvents POSTs the events of the project that match the subscription to its URL, in the order they were
+// observed. A failed delivery is retried with exponential backoff, holding back later events, until the subscription's
+// max retries are exhausted and the event is dead lettered. Events are never delivered to loopback, link-local or
+// private addresses.
+func DeliverEvents() router.HandlerFunc {
+	return handler{
+		client: egress.NewClient(deliveryTimeout, nil),
+	}.deliverEvents
+}
+
+func (h handler) deliverEvents(req router.Request, resp router.Response) error {
+	sub := req.Object.(*v1.EventSubscriptionInstance)
+	if !sub.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	var key []byte
+	if sub.Spec.SigningSecretName != "" {
+		secret := &corev1.Secret{}
+		if err := req.Get(secret, sub.Namespace, sub.Spec.SigningSecretName); err != nil {
+			return fmt.Errorf("failed to get signing secret: %w", err)
+		}
+		if key = secret.Data[v1.EventSubscriptionSigningSecretKey]; len(key) == 0 {
+			return fmt.Errorf("signing secret %s has no %q key", sub.Spec.SigningSecretName, v1.EventSubscriptionSigningSecretKey)
+		}
+	}
+
+	events := &v1.EventInstanceList{}
+	if err := req.List(events, &kclient.ListOptions{Namespace: sub.Namespace}); err != nil {
+		return err
+	}
+	sort.Slice(events.Items, func(i, j int) bool {
+		return before(events.Items[i].Observed, events.Items[i].Name, events.Items[j].Observed, events.Items[j].Name)
+	})
+
+	status := &sub.Status
+	if status.LastObserved.IsZero() {
+		status.LastObserved = v1.MicroTime(metav1.NewMicroTime(sub.CreationTimestamp.Time))
+	}
+
+	start, attempts := time.Now(), 0
+	for _, e := range events.Items {
+		if !before(status.LastObserved, status.LastEventName, e.Observed, e.Name) {
+			continue
+		}
+		if !matches(sub.Spec, e) {
+			status.LastObserved, status.LastEventName = e.Observed, e.Name
+			continue
+		}
+
+		if attempts >= maxDeliveries || time.Since(start) >= deliveryBudget {
+			resp.RetryAfter(time.Second)
+			return nil
+		}
+		attempts++
+
+		delivery := status.Retry
+		if delivery == nil || delivery.EventName != e.Name {
+			delivery = &v1.EventDelivery{EventName: e.Name, EventType: e.Type}
+		} else if wait := time.Until(delivery.NextAttempt.Time); wait > 0 {
+			resp.RetryAfter(wait)
+			return nil
+		}
+
+		now := metav1.Now()
+		err := h.deliver(req.Ctx, sub, key, e)
+		if err == nil {
+			status.Delivered++
+			status.LastDelivery = now
+			status.Retry = nil
+			status.LastObserved, status.LastEventName = e.Observed, e.Name
+			continue
+		}
+
+		delivery.Attempts++
+		delivery.LastAttempt = now
+		delivery.Error = err.Error()
+		if delivery.Attempts > maxRetries(sub.Spec) {
+			delivery.NextAttempt = metav1.Time{}
+			status.DeadLetters = append(status.DeadLetters, *delivery)
+			if len(status.DeadLetters) > maxDeadLetters {
+				status.DeadLetters = status.DeadLetters[len(status.DeadLetters)-maxDeadLetters:]
+			}
+			status.Retry = nil
+			status.LastObserved, status.LastEventName = e.Observed, e.Name
+			continue
+		}
+
+		wait := backoff(delivery.Attempts)
+		delivery.NextAttempt = metav1.NewTime(now.Add(wait))
+		status.Retry = delivery
+		resp.RetryAfter(wait)
+		return nil
+	}
+
+	return nil
+}
+
+func (h handler) deliver(ctx context.Context, sub *v1.EventSubscriptionInstance, key []byte, e v1.EventInstance) error {
+	contentType, body, err := payload(sub, e)
+	if err != nil {
+		return err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.Spec.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", contentType)
+	httpReq.Header.Set(EventTypeHeader, e.Type)
+	httpReq.Header.Set(DeliveryHeader, e.Name)
+	if len(key) > 0 {
+		httpReq.Header.Set(SignatureHeader, Sign(key, body))
+	}
+
+	httpResp, err := h.client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(httpResp.Body, 1<<16))
+
+	if httpResp.StatusCode < 200 || httpResp.StatusCode > 299 {
+		return fmt.Errorf("unexpected response status %s", httpResp.Status)
+	}
+	return nil
+}
+
+// Sign returns the value of the signature header of a payload, the hex encoded HMAC-SHA256 of the payload.
+func Sign(key, body []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// cloudEvent is a CloudEvent in the structured JSON format, see https://github.com/cloudevents/spec
+type cloudEvent struct {
+	SpecVersion     string      `json:"specversion"`
+	ID              string      `json:"id"`
+	Source          string      `json:"source"`
+	Type            string      `json:"type"`
+	Subject         string      `json:"subject,omitempty"`
+	Time            string      `json:"time,omitempty"`
+	DataContentType string      `json:"datacontenttype"`
+	Data            apiv1.Event `json:"data"`
+}
+
+func payload(sub *v1.EventSubscriptionInstance, e v1.EventInstance) (string, []byte, error) {
+	event := apiv1.Event(e)
+	event.TypeMeta = metav1.TypeMeta{
+		APIVersion: apiv1.SchemeGroupVersion.String(),
+		Kind:       "Event",
+	}
+	event.ManagedFields = nil
+
+	if sub.Spec.Format != v1.EventSubscriptionFormatCloudEvents {
+		body, err := json.Marshal(event)
+		return "application/json", body, err
+	}
+
+	ce := cloudEvent{
+		SpecVersion:     "1.0",
+		ID:              e.Name,
+		Source:          "acorn/" + e.Namespace,
+		Type:            "io.acorn.event." + e.Type,
+		Subject:         e.AppName,
+		DataContentType: "application/json",
+		Data:            event,
+	}
+	if !e.Observed.IsZero() {
+		ce.Time = e.Observed.UTC().Format(time.RFC3339Nano)
+	}
+	body, err := json.Marshal(ce)
+	return "application/cloudevents+json", body, err
+}
+
+func matches(spec v1.EventSubscriptionInstanceSpec, e v1.EventInstance) bool {
+	return (len(spec.Types) == 0 || slices.Contains(spec.Types, e.Type)) &&
+		(len(spec.Severities) == 0 || slices.Contains(spec.Severities, e.Severity)) &&
+		(len(spec.AppNames) == 0 || slices.Contains(spec.AppNames, e.AppName))
+}
+
+// before orders events by the time they were observed, then by name for events observed at the same time.
+func before(observed v1.MicroTime, name string, otherObserved v1.MicroTime, otherName string) bool {
+	if !observed.Time.Equal(otherObserved.Time) {
+		return observed.Time.Before(otherObserved.Time)
+	}
+	return name < otherName
+}
+
+func maxRetries(spec v1.EventSubscriptionInstanceSpec) int32 {
+	if spec.MaxRetries == nil {
+		return defaultMaxRetries
+	}
+	return *spec.MaxRetries
+}
+
+func backoff(attempts int32) time.Duration {
+	wait := minBackoff
+	for i := int32(1); i < attempts && wait < maxBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, maxBackoff)
+}
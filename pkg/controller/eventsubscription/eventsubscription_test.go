@@ -0,0 +1,192 @@
+package eventsubscription
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/router/tester"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/acorn-io/z"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestDeliverEvents(t *testing.T) {
+	var (
+		fail     bool
+		received []*http.Request
+		bodies   [][]byte
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received, bodies = append(received, r), append(bodies, body)
+		if fail {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	created := time.Now().Add(-time.Hour)
+	newEvent := func(name, eventType string, observed time.Time) *v1.EventInstance {
+		return &v1.EventInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "acorn"},
+			Type:       eventType,
+			Severity:   v1.EventSeverityInfo,
+			AppName:    "app",
+			Observed:   v1.NewMicroTime(observed),
+		}
+	}
+	sub := &v1.EventSubscriptionInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "sub", Namespace: "acorn", CreationTimestamp: metav1.NewTime(created)},
+		Spec: v1.EventSubscriptionInstanceSpec{
+			URL:               server.URL,
+			Types:             []string{"AppCreate", "AppDelete"},
+			SigningSecretName: "signing",
+			MaxRetries:        z.Pointer[int32](1),
+		},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "signing", Namespace: "acorn"},
+			Data:       map[string][]byte{v1.EventSubscriptionSigningSecretKey: []byte("secret")},
+		},
+		// Observed before the subscription was created
+		newEvent("old", "AppCreate", created.Add(-time.Minute)),
+		newEvent("created", "AppCreate", created.Add(time.Minute)),
+		newEvent("updated", "AppUpdate", created.Add(2*time.Minute)),
+	).Build()
+	req := router.Request{Ctx: context.Background(), Client: c, Object: sub}
+	// The test server listens on a loopback address, which the client of DeliverEvents refuses to connect to
+	deliverEvents := handler{client: server.Client()}.deliverEvents
+
+	require.NoError(t, deliverEvents(req, &tester.Response{}))
+	require.Len(t, received, 1)
+	assert.Equal(t, "created", received[0].Header.Get(DeliveryHeader))
+	assert.Equal(t, "AppCreate", received[0].Header.Get(EventTypeHeader))
+	assert.Equal(t, Sign([]byte("secret"), bodies[0]), received[0].Header.Get(SignatureHeader))
+	assert.EqualValues(t, 1, sub.Status.Delivered)
+	assert.Equal(t, "updated", sub.Status.LastEventName)
+
+	// A failed delivery is retried after a backoff
+	require.NoError(t, c.Create(req.Ctx, newEvent("deleted", "AppDelete", created.Add(3*time.Minute))))
+	fail = true
+	resp := &tester.Response{}
+	require.NoError(t, deliverEvents(req, resp))
+	require.Len(t, received, 2)
+	require.NotNil(t, sub.Status.Retry)
+	assert.Equal(t, "deleted", sub.Status.Retry.EventName)
+	assert.EqualValues(t, 1, sub.Status.Retry.Attempts)
+	assert.Equal(t, minBackoff, resp.Delay)
+
+	// Not before the backoff is over
+	require.NoError(t, deliverEvents(req, &tester.Response{}))
+	require.Len(t, received, 2)
+
+	// The event is dead lettered once the retries are exhausted
+	sub.Status.Retry.NextAttempt = metav1.NewTime(time.Now().Add(-time.Second))
+	require.NoError(t, deliverEvents(req, &tester.Response{}))
+	require.Len(t, received, 3)
+	assert.Nil(t, sub.Status.Retry)
+	require.Len(t, sub.Status.DeadLetters, 1)
+	assert.Equal(t, "deleted", sub.Status.DeadLetters[0].EventName)
+	assert.EqualValues(t, 2, sub.Status.DeadLetters[0].Attempts)
+	assert.Equal(t, "deleted", sub.Status.LastEventName)
+}
+
+func TestDeliverEventsLimit(t *testing.T) {
+	var received int
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		received++
+	}))
+	defer server.Close()
+
+	created := time.Now().Add(-time.Hour)
+	sub := &v1.EventSubscriptionInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "sub", Namespace: "acorn", CreationTimestamp: metav1.NewTime(created)},
+		Spec:       v1.EventSubscriptionInstanceSpec{URL: server.URL},
+	}
+	builder := fake.NewClientBuilder().WithScheme(scheme.Scheme)
+	for i := 0; i < maxDeliveries+5; i++ {
+		builder.WithObjects(&v1.EventInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("event-%02d", i), Namespace: "acorn"},
+			Type:       "AppCreate",
+			Observed:   v1.NewMicroTime(created.Add(time.Duration(i+1) * time.Second)),
+		})
+	}
+	req := router.Request{Ctx: context.Background(), Client: builder.Build(), Object: sub}
+	deliverEvents := handler{client: server.Client()}.deliverEvents
+
+	// The remaining events are delivered on the next reconcile
+	resp := &tester.Response{}
+	require.NoError(t, deliverEvents(req, resp))
+	assert.Equal(t, maxDeliveries, received)
+	assert.Equal(t, time.Second, resp.Delay)
+
+	require.NoError(t, deliverEvents(req, &tester.Response{}))
+	assert.Equal(t, maxDeliveries+5, received)
+}
+
+func TestDeliverEventsBlockedAddress(t *testing.T) {
+	var received int
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		received++
+	}))
+	defer server.Close()
+
+	created := time.Now().Add(-time.Hour)
+	sub := &v1.EventSubscriptionInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "sub", Namespace: "acorn", CreationTimestamp: metav1.NewTime(created)},
+		Spec:       v1.EventSubscriptionInstanceSpec{URL: server.URL},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(&v1.EventInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "event", Namespace: "acorn"},
+		Type:       "AppCreate",
+		Observed:   v1.NewMicroTime(created.Add(time.Minute)),
+	}).Build()
+
+	require.NoError(t, DeliverEvents()(router.Request{Ctx: context.Background(), Client: c, Object: sub}, &tester.Response{}))
+	assert.Zero(t, received)
+	require.NotNil(t, sub.Status.Retry)
+	assert.Contains(t, sub.Status.Retry.Error, "is not allowed")
+}
+
+func TestPayloadCloudEvents(t *testing.T) {
+	observed := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	sub := &v1.EventSubscriptionInstance{Spec: v1.EventSubscriptionInstanceSpec{Format: v1.EventSubscriptionFormatCloudEvents}}
+	contentType, body, err := payload(sub, v1.EventInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "event", Namespace: "acorn"},
+		Type:       "AppCreate",
+		AppName:    "app",
+		Observed:   v1.NewMicroTime(observed),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "application/cloudevents+json", contentType)
+
+	ce := map[string]any{}
+	require.NoError(t, json.Unmarshal(body, &ce))
+	assert.Equal(t, "1.0", ce["specversion"])
+	assert.Equal(t, "event", ce["id"])
+	assert.Equal(t, "acorn/acorn", ce["source"])
+	assert.Equal(t, "io.acorn.event.AppCreate", ce["type"])
+	assert.Equal(t, "app", ce["subject"])
+	assert.Equal(t, "2023-06-01T12:00:00Z", ce["time"])
+	assert.Equal(t, "AppCreate", ce["data"].(map[string]any)["type"])
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, 5*time.Second, backoff(1))
+	assert.Equal(t, 20*time.Second, backoff(3))
+	assert.Equal(t, maxBackoff, backoff(20))
+}
@@ -177,3 +177,51 @@ func (h *Handler) SaveJobOutput(req router.Request, _ router.Response) error {
 	_, err = h.runCommand(req.Ctx, pod, "/usr/local/bin/acorn-job-helper-shutdown")
 	return err
 }
+
+// SaveJobOutputs saves the named outputs that the helper container of a job pod recorded as its termination message once
+// the job container succeeded.
+func SaveJobOutputs(req router.Request, _ router.Response) error {
+	pod := req.Object.(*corev1.Pod)
+	jobName := pod.Labels[labels.AcornJobName]
+	if jobName == "" {
+		return nil
+	}
+
+	var (
+		outputs   string
+		succeeded bool
+	)
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Terminated == nil {
+			continue
+		}
+		switch status.Name {
+		case jobs.Helper:
+			outputs = status.State.Terminated.Message
+		case jobName:
+			succeeded = status.State.Terminated.ExitCode == 0
+		}
+	}
+	if !succeeded || outputs == "" {
+		return nil
+	}
+
+	generation, err := getAppGeneration(req.Ctx, req.Client, pod.Namespace, jobName)
+	if apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	return apply.New(req.Client).Ensure(req.Ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jobs.GetJobOutputsSecretName(pod.Namespace, jobName),
+			Namespace: pod.Namespace,
+			Labels: labels.ManagedByApp(pod.Labels[labels.AcornAppNamespace], pod.Labels[labels.AcornAppName],
+				labels.AcornAppGeneration, generation),
+		},
+		Data: map[string][]byte{
+			jobs.OutputsSecretKey: []byte(outputs),
+		},
+	})
+}
@@ -0,0 +1,68 @@
+package jobs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/router/tester"
+	"github.com/acorn-io/runtime/pkg/jobs"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta/testrestmapper"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSaveJobOutputs(t *testing.T) {
+	pod := func(exitCode int32) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "migrate-abcde",
+				Namespace: "app",
+				Labels: map[string]string{
+					labels.AcornJobName:      "migrate",
+					labels.AcornAppName:      "app",
+					labels.AcornAppNamespace: "acorn",
+				},
+			},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name:  "migrate",
+						State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: exitCode}},
+					},
+					{
+						Name:  jobs.Helper,
+						State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Message: `{"url":"postgres://db"}`}},
+					},
+				},
+			},
+		}
+	}
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "migrate",
+			Namespace:   "app",
+			Annotations: map[string]string{labels.AcornAppGeneration: "2"},
+		},
+	}
+	secretName := jobs.GetJobOutputsSecretName("app", "migrate")
+
+	// Not saved if the job container failed
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRESTMapper(testrestmapper.TestOnlyStaticRESTMapper(scheme.Scheme)).WithObjects(job).Build()
+	require.NoError(t, SaveJobOutputs(router.Request{Ctx: context.Background(), Client: c, Object: pod(1)}, &tester.Response{}))
+	err := c.Get(context.Background(), router.Key("app", secretName), &corev1.Secret{})
+	assert.True(t, apierrors.IsNotFound(err))
+
+	require.NoError(t, SaveJobOutputs(router.Request{Ctx: context.Background(), Client: c, Object: pod(0)}, &tester.Response{}))
+	secret := &corev1.Secret{}
+	require.NoError(t, c.Get(context.Background(), router.Key("app", secretName), secret))
+	assert.Equal(t, `{"url":"postgres://db"}`, string(secret.Data[jobs.OutputsSecretKey]))
+	assert.Equal(t, "2", secret.Labels[labels.AcornAppGeneration])
+}
@@ -0,0 +1,124 @@
+package jobs
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/runtime/pkg/jobs"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/sirupsen/logrus"
+	batchv1 "k8s.io/api/batch/v1"
+	apierror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// labels the job controller of Kubernetes adds to the jobs and pods it manages, which must not be copied to a new job
+var jobControllerLabels = []string{
+	"controller-uid",
+	"job-name",
+	batchv1.ControllerUidLabel,
+	batchv1.JobNameLabel,
+}
+
+// RetryWithBackoff recreates a failed job that has a backoff once the backoff passes, until the job has been retried
+// the number of times it allows.
+func RetryWithBackoff(req router.Request, resp router.Response) error {
+	job := req.Object.(*batchv1.Job)
+	if job.Annotations[labels.AcornJobBackoff] == "" || !job.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	failedTime, failed := jobs.FailedTime(job)
+	if !failed {
+		return nil
+	}
+
+	base, err := time.ParseDuration(job.Annotations[labels.AcornJobBackoff])
+	if err != nil {
+		return fmt.Errorf("invalid backoff of job %s/%s: %w", job.Namespace, job.Name, err)
+	}
+	retries, err := strconv.Atoi(job.Annotations[labels.AcornJobRetries])
+	if err != nil {
+		return fmt.Errorf("invalid retries of job %s/%s: %w", job.Namespace, job.Name, err)
+	}
+
+	attempt := jobs.Attempt(job)
+	if attempt > retries {
+		return nil
+	}
+
+	nextAttempt := failedTime.Add(jobs.Backoff(base, attempt))
+	if wait := time.Until(nextAttempt); wait > 0 {
+		resp.RetryAfter(wait)
+		next := nextAttempt.UTC().Format(time.RFC3339)
+		if job.Annotations[labels.AcornJobNextAttempt] == next {
+			return nil
+		}
+		job.Annotations[labels.AcornJobNextAttempt] = next
+		return req.Client.Update(req.Ctx, job)
+	}
+
+	logrus.Infof("Retrying failed job %s/%s, attempt %d of %d", job.Namespace, job.Name, attempt+1, retries+1)
+	if err := req.Client.Delete(req.Ctx, job, kclient.Preconditions{UID: &job.UID},
+		kclient.PropagationPolicy(metav1.DeletePropagationBackground)); apierror.IsNotFound(err) || apierror.IsConflict(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	retry := toRetry(job, attempt+1)
+	if err := req.Client.Create(req.Ctx, retry); apierror.IsAlreadyExists(err) {
+		// The job was recreated by the app before the retry was, so record the attempt on that job instead
+		existing := &batchv1.Job{}
+		if err := req.Client.Get(req.Ctx, kclient.ObjectKeyFromObject(retry), existing); err != nil {
+			return err
+		}
+		if existing.Annotations == nil {
+			existing.Annotations = map[string]string{}
+		}
+		existing.Annotations[labels.AcornJobAttempt] = retry.Annotations[labels.AcornJobAttempt]
+		return req.Client.Update(req.Ctx, existing)
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// toRetry returns a copy of the failed job to create as the given attempt.
+func toRetry(job *batchv1.Job, attempt int) *batchv1.Job {
+	retry := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            job.Name,
+			Namespace:       job.Namespace,
+			Labels:          withoutJobControllerLabels(job.Labels),
+			Annotations:     map[string]string{},
+			OwnerReferences: job.OwnerReferences,
+		},
+		Spec: *job.Spec.DeepCopy(),
+	}
+	for k, v := range job.Annotations {
+		if k != labels.AcornJobNextAttempt {
+			retry.Annotations[k] = v
+		}
+	}
+	retry.Annotations[labels.AcornJobAttempt] = strconv.Itoa(attempt)
+
+	retry.Spec.Selector = nil
+	retry.Spec.ManualSelector = nil
+	retry.Spec.Template.Labels = withoutJobControllerLabels(retry.Spec.Template.Labels)
+	return retry
+}
+
+func withoutJobControllerLabels(jobLabels map[string]string) map[string]string {
+	result := make(map[string]string, len(jobLabels))
+	for k, v := range jobLabels {
+		result[k] = v
+	}
+	for _, k := range jobControllerLabels {
+		delete(result, k)
+	}
+	return result
+}
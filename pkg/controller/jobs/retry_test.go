@@ -0,0 +1,83 @@
+package jobs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/router/tester"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestRetryWithBackoff(t *testing.T) {
+	failedJob := func(failed time.Time, attempt string) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "migrate",
+				Namespace: "app",
+				UID:       "1234",
+				Labels:    map[string]string{labels.AcornJobName: "migrate", batchv1.ControllerUidLabel: "1234"},
+				Annotations: map[string]string{
+					labels.AcornJobBackoff: "10s",
+					labels.AcornJobRetries: "2",
+					labels.AcornJobAttempt: attempt,
+				},
+			},
+			Spec: batchv1.JobSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{batchv1.ControllerUidLabel: "1234"}},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{labels.AcornJobName: "migrate", batchv1.ControllerUidLabel: "1234", batchv1.JobNameLabel: "migrate"},
+					},
+				},
+			},
+			Status: batchv1.JobStatus{
+				Conditions: []batchv1.JobCondition{{
+					Type:               batchv1.JobFailed,
+					Status:             corev1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(failed),
+				}},
+			},
+		}
+	}
+
+	// Not before the backoff of the second attempt is over
+	job := failedJob(time.Now(), "2")
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(job).Build()
+	resp := &tester.Response{}
+	require.NoError(t, RetryWithBackoff(router.Request{Ctx: context.Background(), Client: c, Object: job}, resp))
+	assert.Greater(t, resp.Delay, 10*time.Second)
+	assert.LessOrEqual(t, resp.Delay, 20*time.Second)
+
+	existing := &batchv1.Job{}
+	require.NoError(t, c.Get(context.Background(), router.Key("app", "migrate"), existing))
+	assert.NotEmpty(t, existing.Annotations[labels.AcornJobNextAttempt])
+
+	// Recreated as the next attempt once the backoff is over
+	job = failedJob(time.Now().Add(-time.Minute), "2")
+	c = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(job).Build()
+	require.NoError(t, RetryWithBackoff(router.Request{Ctx: context.Background(), Client: c, Object: job}, &tester.Response{}))
+
+	retry := &batchv1.Job{}
+	require.NoError(t, c.Get(context.Background(), router.Key("app", "migrate"), retry))
+	assert.NotEqual(t, job.UID, retry.UID)
+	assert.Equal(t, "3", retry.Annotations[labels.AcornJobAttempt])
+	assert.Nil(t, retry.Spec.Selector)
+	assert.Equal(t, map[string]string{labels.AcornJobName: "migrate"}, retry.Labels)
+	assert.Equal(t, map[string]string{labels.AcornJobName: "migrate"}, retry.Spec.Template.Labels)
+
+	// Not retried once the retries are exhausted
+	job = failedJob(time.Now().Add(-time.Hour), "3")
+	c = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(job).Build()
+	require.NoError(t, RetryWithBackoff(router.Request{Ctx: context.Background(), Client: c, Object: job}, &tester.Response{}))
+	require.NoError(t, c.Get(context.Background(), router.Key("app", "migrate"), existing))
+	assert.Equal(t, job.UID, existing.UID)
+}
@@ -0,0 +1,68 @@
+package preview
+
+import (
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/preview"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// SnapshotFinalizer holds preview apps until the snapshots their volumes were cloned from are removed
+const SnapshotFinalizer = labels.Prefix + "preview-snapshot-delete"
+
+// ExpirePreview removes preview apps once they expire. Previews don't expire while a dev session is running them.
+func ExpirePreview(req router.Request, resp router.Response) error {
+	app := req.Object.(*v1.AppInstance)
+	if app.Spec.Preview == nil || !app.DeletionTimestamp.IsZero() || app.Status.DevSession != nil {
+		return nil
+	}
+
+	expireTime := app.Spec.Preview.ExpireTime()
+	if expireTime.IsZero() {
+		return nil
+	}
+	if delay := time.Until(expireTime); delay > 0 {
+		resp.RetryAfter(delay)
+		return nil
+	}
+
+	return kclient.IgnoreNotFound(req.Client.Delete(req.Ctx, app))
+}
+
+// NeedsSnapshotFinalization only adds the snapshot finalizer to preview apps.
+func NeedsSnapshotFinalization(next router.Handler) router.Handler {
+	return router.HandlerFunc(func(req router.Request, resp router.Response) error {
+		if req.Object == nil {
+			return nil
+		}
+
+		app := req.Object.(*v1.AppInstance)
+		if !app.DeletionTimestamp.IsZero() || app.Spec.Preview != nil {
+			return next.Handle(req, resp)
+		}
+		return nil
+	})
+}
+
+// RemoveSnapshots removes the snapshots the volumes of a preview app were cloned from when the app is removed, whether
+// it expired or was removed by hand. Snapshots bound by the user are kept.
+func RemoveSnapshots(req router.Request, _ router.Response) error {
+	app := req.Object.(*v1.AppInstance)
+	for _, binding := range app.Spec.Volumes {
+		if binding.Snapshot == "" || binding.Snapshot != preview.SnapshotName(app.Name, binding.Target) {
+			continue
+		}
+		snapshot := &v1.VolumeSnapshotInstance{}
+		if err := req.Get(snapshot, app.Namespace, binding.Snapshot); err == nil {
+			if err := req.Client.Delete(req.Ctx, snapshot); kclient.IgnoreNotFound(err) != nil {
+				return err
+			}
+		} else if kclient.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package preview
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/router/tester"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestExpirePreview(t *testing.T) {
+	newApp := func(renewTime time.Time) *v1.AppInstance {
+		return &v1.AppInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "app-feature", Namespace: "acorn"},
+			Spec: v1.AppInstanceSpec{
+				Volumes: []v1.VolumeBinding{
+					{Target: "data", Snapshot: "app-feature-preview-data"},
+					{Target: "other", Snapshot: "other"},
+				},
+				Preview: &v1.AppPreview{
+					Branch:         "feature",
+					BaseApp:        "app",
+					TimeoutSeconds: 3600,
+					RenewTime:      metav1.NewTime(renewTime),
+				},
+			},
+		}
+	}
+	snapshot := func(name string) *v1.VolumeSnapshotInstance {
+		return &v1.VolumeSnapshotInstance{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "acorn"}}
+	}
+
+	// Not expired yet
+	app := newApp(time.Now().Add(-30 * time.Minute))
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(app, snapshot("app-feature-preview-data"), snapshot("other")).Build()
+	req := router.Request{Ctx: context.Background(), Client: c, Object: app}
+	resp := &tester.Response{}
+	require.NoError(t, ExpirePreview(req, resp))
+	assert.InDelta(t, 30*time.Minute, resp.Delay, float64(time.Minute))
+	require.NoError(t, c.Get(req.Ctx, router.Key("acorn", "app-feature"), &v1.AppInstance{}))
+
+	// Running in a dev session
+	app = newApp(time.Now().Add(-2 * time.Hour))
+	app.Status.DevSession = &v1.DevSessionInstanceSpec{}
+	req.Object = app
+	require.NoError(t, ExpirePreview(req, &tester.Response{}))
+	require.NoError(t, c.Get(req.Ctx, router.Key("acorn", "app-feature"), &v1.AppInstance{}))
+
+	// Expired
+	app = newApp(time.Now().Add(-2 * time.Hour))
+	req.Object = app
+	require.NoError(t, ExpirePreview(req, &tester.Response{}))
+	assert.True(t, apierrors.IsNotFound(c.Get(req.Ctx, router.Key("acorn", "app-feature"), &v1.AppInstance{})))
+
+	// The snapshots are removed with the app, whether it expired or was removed by hand
+	require.NoError(t, RemoveSnapshots(req, &tester.Response{}))
+	assert.True(t, apierrors.IsNotFound(c.Get(req.Ctx, router.Key("acorn", "app-feature-preview-data"), &v1.VolumeSnapshotInstance{})))
+	// Snapshots bound by the user are kept
+	require.NoError(t, c.Get(req.Ctx, router.Key("acorn", "other"), &v1.VolumeSnapshotInstance{}))
+}
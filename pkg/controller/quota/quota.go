@@ -84,52 +84,67 @@ func EnsureQuotaRequest(req router.Request, resp router.Response) error {
 		return err
 	}
 
-	// Create the quota request object and give calculate the standard numeric values
-	name, namespace, app := appInstance.Name, appInstance.Namespace, appInstance.Status.AppSpec
+	status := condition.Setter(appInstance, resp, v1.AppInstanceConditionQuota)
+
+	resources, err := Requested(req, appInstance)
+	if err != nil {
+		status.Error(err)
+		return err
+	}
+
+	// Create the quota request object with the resources the app requests
 	quotaRequest := &adminv1.QuotaRequestInstance{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: name, Namespace: namespace,
+			Name: appInstance.Name, Namespace: appInstance.Namespace,
 			Annotations: map[string]string{labels.AcornAppGeneration: strconv.FormatInt(appInstance.Generation, 10)},
 		},
 		Spec: adminv1.QuotaRequestInstanceSpec{
-			Resources: adminv1.QuotaRequestResources{
-				BaseResources: adminv1.BaseResources{
-					Jobs:    len(app.Jobs),
-					Volumes: len(app.Volumes),
-					Images:  len(app.Images),
-				},
-			},
+			Resources: resources,
 		},
 	}
 
-	status := condition.Setter(appInstance, resp, v1.AppInstanceConditionQuota)
+	resp.Objects(quotaRequest)
+	return nil
+}
+
+// Requested returns the resources the app requests from quota. It is what the quota request of the app is created with,
+// so it can also be used to tell what an app would request before it is deployed.
+func Requested(req router.Request, appInstance *v1.AppInstance) (adminv1.QuotaRequestResources, error) {
+	// Calculate the standard numeric values
+	app := appInstance.Status.AppSpec
+	resources := adminv1.QuotaRequestResources{
+		BaseResources: adminv1.BaseResources{
+			Jobs:    len(app.Jobs),
+			Volumes: len(app.Volumes),
+			Images:  len(app.Images),
+		},
+	}
 
-	// Add the more complex values to the quota request
-	addContainers(app.Containers, quotaRequest)
-	addCompute(app.Containers, appInstance, quotaRequest)
+	// Add the more complex values
+	AddContainers(app.Containers, &resources)
+	AddCompute(app.Containers, appInstance, &resources)
 	// TODO: This is a stop-gap until we figure out how to handle the compute resources of
 	//       jobs. The problem is that Jobs are not always running, so we can't just add
 	//       their compute resources to the quota request permananetly. To some degree it'll
 	//       have to be dynamic, but we can't do that until we have a better idea of how.
-	// addCompute(app.Jobs, appInstance, quotaRequest)
-	if err := addStorage(req, appInstance, quotaRequest); err != nil {
-		status.Error(err)
-		return err
+	// AddCompute(app.Jobs, appInstance, &resources)
+	if err := AddStorage(req, appInstance, &resources); err != nil {
+		return adminv1.QuotaRequestResources{}, err
 	}
 
-	resp.Objects(quotaRequest)
-	return nil
+	return resources, nil
 }
 
-// addContainers adds the number of containers and accounts for the scale of each container.
-func addContainers(containers map[string]v1.Container, quotaRequest *adminv1.QuotaRequestInstance) {
+// AddContainers adds the number of containers and accounts for the scale of each container. Autoscaled containers
+// are counted at their maximum replicas.
+func AddContainers(containers map[string]v1.Container, resources *adminv1.QuotaRequestResources) {
 	for _, container := range containers {
-		quotaRequest.Spec.Resources.Containers += int(replicas(container.Scale))
+		resources.Containers += int(Replicas(container))
 	}
 }
 
-// addCompute adds the compute resources of the containers passed to the quota request.
-func addCompute(containers map[string]v1.Container, appInstance *v1.AppInstance, quotaRequest *adminv1.QuotaRequestInstance) {
+// AddCompute adds the compute resources of the containers passed to the resources.
+func AddCompute(containers map[string]v1.Container, appInstance *v1.AppInstance, resources *adminv1.QuotaRequestResources) {
 	// For each workload, add their memory/cpu requests to the quota request
 	for name, container := range containers {
 		var (
@@ -148,11 +163,11 @@ func addCompute(containers map[string]v1.Container, appInstance *v1.AppInstance,
 		}
 
 		// Multiply the memory/cpu requests by the scale of the container
-		cpu.Mul(replicas(container.Scale))
-		memory.Mul(replicas(container.Scale))
+		cpu.Mul(Replicas(container))
+		memory.Mul(Replicas(container))
 
-		// Add the compute resources to the quota request
-		quotaRequest.Spec.Resources.Add(adminv1.QuotaRequestResources{BaseResources: adminv1.BaseResources{ComputeClasses: adminv1.ComputeClassResources{
+		// Add the compute resources
+		resources.Add(adminv1.QuotaRequestResources{BaseResources: adminv1.BaseResources{ComputeClasses: adminv1.ComputeClassResources{
 			computeClass: {
 				Memory: memory,
 				CPU:    cpu,
@@ -160,15 +175,15 @@ func addCompute(containers map[string]v1.Container, appInstance *v1.AppInstance,
 		}}})
 
 		// Recurse over any sidecars. Since sidecars can't have sidecars, this is safe.
-		addCompute(container.Sidecars, appInstance, quotaRequest)
+		AddCompute(container.Sidecars, appInstance, resources)
 	}
 }
 
-// addStorage adds the storage resources of the volumes passed to the quota request.
-func addStorage(req router.Request, appInstance *v1.AppInstance, quotaRequest *adminv1.QuotaRequestInstance) error {
+// AddStorage adds the storage resources of the volumes and the secrets of the app to the resources.
+func AddStorage(req router.Request, appInstance *v1.AppInstance, resources *adminv1.QuotaRequestResources) error {
 	app := appInstance.Status.AppSpec
 
-	// Add the volume storage needed to the quota request. We only parse net new volumes, not
+	// Add the volume storage needed. We only parse net new volumes, not
 	// existing ones that are then bound client-side.
 	for name, volume := range app.Volumes {
 		size := volume.Size
@@ -203,19 +218,19 @@ func addStorage(req router.Request, appInstance *v1.AppInstance, quotaRequest *a
 		}
 
 		volumeClass := appInstance.Status.ResolvedOfferings.Volumes[name].Class
-		quotaRequest.Spec.Resources.Add(adminv1.QuotaRequestResources{
+		resources.Add(adminv1.QuotaRequestResources{
 			BaseResources: adminv1.BaseResources{VolumeClasses: adminv1.VolumeClassResources{
 				volumeClass: {VolumeStorage: sizeQuantity},
 			}}})
 	}
 
-	// Add the secrets needed to the quota request. We only parse net new secrets, not
+	// Add the secrets needed. We only parse net new secrets, not
 	// existing ones that are then bound client-side.
 	for name := range app.Secrets {
 		if boundSecret(name, appInstance.Spec.Secrets) {
 			continue
 		}
-		quotaRequest.Spec.Resources.Secrets++
+		resources.Secrets++
 	}
 	return nil
 }
@@ -272,11 +287,15 @@ func isEnforced(req router.Request, namespace string) (bool, error) {
 	return project.Annotations[labels.ProjectEnforcedQuotaAnnotation] == "true", nil
 }
 
-// replicas returns the number of replicas based on an int32 pointer. If the
-// pointer is nil, it is assumed to be 1.
-func replicas(s *int32) int64 {
-	if s != nil {
-		return int64(*s)
+// Replicas returns the number of replicas of the container. Autoscaled containers
+// count at their maximum replicas, otherwise the scale is used. If the scale is nil,
+// it is assumed to be 1.
+func Replicas(container v1.Container) int64 {
+	if container.Autoscale != nil {
+		return int64(container.Autoscale.MaxReplicas)
+	}
+	if container.Scale != nil {
+		return int64(*container.Scale)
 	}
 	return 1
 }
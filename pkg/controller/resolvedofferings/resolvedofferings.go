@@ -41,6 +41,15 @@ func Calculate(req router.Request, resp router.Response) (err error) {
 	return nil
 }
 
+// Resolve sets the resolved offerings for an AppInstance that is not being reconciled, such as an app that is being
+// estimated before it is deployed.
+func Resolve(req router.Request, appInstance *internalv1.AppInstance) error {
+	if err := resolveVolumeClasses(req.Ctx, req.Client, appInstance); err != nil {
+		return err
+	}
+	return calculate(req, appInstance)
+}
+
 func calculate(req router.Request, appInstance *internalv1.AppInstance) error {
 	cfg, err := config.Get(req.Ctx, req.Client)
 	if err != nil {
@@ -9,12 +9,15 @@ import (
 	internaladminv1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/controller/acornimagebuildinstance"
 	"github.com/acorn-io/runtime/pkg/controller/appdefinition"
+	"github.com/acorn-io/runtime/pkg/controller/apprevision"
+	"github.com/acorn-io/runtime/pkg/controller/appschedule"
 	"github.com/acorn-io/runtime/pkg/controller/appstatus"
 	"github.com/acorn-io/runtime/pkg/controller/builder"
 	"github.com/acorn-io/runtime/pkg/controller/config"
 	"github.com/acorn-io/runtime/pkg/controller/defaults"
 	"github.com/acorn-io/runtime/pkg/controller/devsession"
 	"github.com/acorn-io/runtime/pkg/controller/eventinstance"
+	"github.com/acorn-io/runtime/pkg/controller/eventsubscription"
 	"github.com/acorn-io/runtime/pkg/controller/gc"
 	"github.com/acorn-io/runtime/pkg/controller/images"
 	"github.com/acorn-io/runtime/pkg/controller/ingress"
@@ -23,6 +26,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/controller/namespace"
 	"github.com/acorn-io/runtime/pkg/controller/networkpolicy"
 	"github.com/acorn-io/runtime/pkg/controller/permissions"
+	"github.com/acorn-io/runtime/pkg/controller/preview"
 	"github.com/acorn-io/runtime/pkg/controller/pvc"
 	"github.com/acorn-io/runtime/pkg/controller/quota"
 	"github.com/acorn-io/runtime/pkg/controller/resolvedofferings"
@@ -30,10 +34,12 @@ import (
 	"github.com/acorn-io/runtime/pkg/controller/secrets"
 	"github.com/acorn-io/runtime/pkg/controller/service"
 	"github.com/acorn-io/runtime/pkg/controller/tls"
+	"github.com/acorn-io/runtime/pkg/controller/volumesnapshot"
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/local/webhook"
 	"github.com/acorn-io/runtime/pkg/project"
 	"github.com/acorn-io/runtime/pkg/system"
+	"github.com/acorn-io/runtime/pkg/tracing"
 	"github.com/acorn-io/runtime/pkg/volume"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
@@ -61,8 +67,11 @@ func routes(router *router.Router, cfg *rest.Config, registryTransport http.Roun
 	apply.AddValidOwnerChange("acorn-install", "acorn-controller")
 	router.OnErrorHandler = appdefinition.OnError
 
-	appRouter := router.Type(&v1.AppInstance{}).Middleware(devsession.OverlayDevSession).IncludeFinalizing()
+	appRouter := router.Type(&v1.AppInstance{}).Middleware(tracing.Middleware, devsession.OverlayDevSession).IncludeFinalizing()
 	appRouter.HandlerFunc(appdefinition.AssignNamespace)
+	appRouter.HandlerFunc(preview.ExpirePreview)
+	appRouter.Middleware(preview.NeedsSnapshotFinalization).FinalizeFunc(preview.SnapshotFinalizer, preview.RemoveSnapshots)
+	appRouter.HandlerFunc(appschedule.ApplySchedule)
 
 	// AppImage preparation, checks and promotion
 	appRouter.HandlerFunc(appdefinition.PullAppImage(registryTransport)) // pulls image to .status.Staged.AppImage
@@ -88,11 +97,14 @@ func routes(router *router.Router, cfg *rest.Config, registryTransport http.Roun
 	appMeetsPreconditions.Middleware(appdefinition.ImagePulled).HandlerFunc(permissions.ConsumerPermissions)
 	appMeetsPreconditions.Middleware(appdefinition.ImagePulled).HandlerFunc(appdefinition.DeploySpec)
 	appMeetsPreconditions.Middleware(appdefinition.ImagePulled).HandlerFunc(secrets.CreateSecrets)
+	appMeetsPreconditions.Middleware(appdefinition.ImagePulled).HandlerFunc(apprevision.RecordRevision)
+	appMeetsPreconditions.HandlerFunc(volumesnapshot.ScheduleBackups)
 	appMeetsPreconditions.HandlerFunc(networkpolicy.ForApp)
 	appMeetsPreconditions.HandlerFunc(appdefinition.AddAcornProjectLabel)
 	appMeetsPreconditions.HandlerFunc(appdefinition.UpdateObservedFields)
 
 	appRouter.HandlerFunc(appstatus.GetStatus)
+	appRouter.HandlerFunc(appstatus.UsageStatus)
 	appRouter.HandlerFunc(appstatus.SetStatus)
 	appRouter.HandlerFunc(appstatus.ReadyStatus)
 	appRouter.HandlerFunc(appstatus.CLIStatus)
@@ -105,6 +117,10 @@ func routes(router *router.Router, cfg *rest.Config, registryTransport http.Roun
 
 	router.Type(&v1.DevSessionInstance{}).HandlerFunc(devsession.ExpireDevSession)
 
+	snapshotRouter := router.Type(&v1.VolumeSnapshotInstance{})
+	snapshotRouter.HandlerFunc(volumesnapshot.TakeSnapshot)
+	snapshotRouter.FinalizeFunc(labels.Prefix+"volume-snapshot-delete", volumesnapshot.DeleteSnapshot(registryTransport))
+
 	router.Type(&v1.ServiceInstance{}).HandlerFunc(service.RenderServices)
 
 	router.Type(&v1.ImageInstance{}).HandlerFunc(images.MigrateRemoteImages)
@@ -118,8 +134,10 @@ func routes(router *router.Router, cfg *rest.Config, registryTransport http.Roun
 	router.Type(&v1.ServiceInstance{}).HandlerFunc(gc.Orphans)
 
 	router.Type(&v1.EventInstance{}).HandlerFunc(eventinstance.GCExpired())
+	router.Type(&v1.EventSubscriptionInstance{}).HandlerFunc(eventsubscription.DeliverEvents())
 
 	router.Type(&batchv1.Job{}).Selector(managedSelector).HandlerFunc(jobs.JobCleanup)
+	router.Type(&batchv1.Job{}).Selector(managedSelector).HandlerFunc(jobs.RetryWithBackoff)
 	router.Type(&rbacv1.ClusterRole{}).Selector(managedSelector).HandlerFunc(gc.Orphans)
 	router.Type(&rbacv1.ClusterRoleBinding{}).Selector(managedSelector).HandlerFunc(gc.Orphans)
 	router.Type(&corev1.PersistentVolumeClaim{}).Selector(managedSelector).HandlerFunc(pvc.MarkAndSave)
@@ -134,6 +152,7 @@ func routes(router *router.Router, cfg *rest.Config, registryTransport http.Roun
 	router.Type(&corev1.Pod{}).Selector(managedSelector).HandlerFunc(gc.Orphans)
 	router.Type(&corev1.Pod{}).Selector(managedSelector).HandlerFunc(jobs.JobPodOrphanCleanup)
 	router.Type(&corev1.Pod{}).Selector(managedSelector).HandlerFunc(jobsHandler.SaveJobOutput)
+	router.Type(&corev1.Pod{}).Selector(managedSelector).HandlerFunc(jobs.SaveJobOutputs)
 	router.Type(&netv1.Ingress{}).Selector(managedSelector).Namespace(system.ImagesNamespace).HandlerFunc(gc.Orphans)
 	router.Type(&corev1.Secret{}).Selector(managedSelector).Name(system.DNSSecretName).Namespace(system.Namespace).HandlerFunc(secrets.HandleDNSSecret)
 	router.Type(&netv1.Ingress{}).Selector(managedSelector).Name(system.DNSIngressName).Namespace(system.Namespace).Middleware(ingress.RequireLBs).Handler(ingress.NewDNSHandler())
@@ -149,6 +168,7 @@ func routes(router *router.Router, cfg *rest.Config, registryTransport http.Roun
 
 	configRouter := router.Type(&corev1.ConfigMap{}).Namespace(system.Namespace).Name(system.ConfigName)
 	configRouter.Handler(config.NewDNSConfigHandler())
+	configRouter.Handler(config.NewGatewayHandler())
 	configRouter.HandlerFunc(builder.DeployRegistry)
 	configRouter.HandlerFunc(config.HandleAutoUpgradeInterval)
 	configRouter.HandlerFunc(volume.CreateEphemeralVolumeClass)
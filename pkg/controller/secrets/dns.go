@@ -28,7 +28,9 @@ func HandleDNSSecret(req router.Request, resp router.Response) error {
 		return err
 	}
 
-	if !slices.Contains(cfg.ClusterDomains, domain) {
+	// The records of the domain point to the shared Gateway instead of the DNS ingress when ports are published with
+	// Gateway API resources
+	if !slices.Contains(cfg.ClusterDomains, domain) || publish.UsesGateway(cfg) {
 		return nil
 	}
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/acorn-io/baaah/pkg/router"
 	"github.com/acorn-io/baaah/pkg/typed"
@@ -12,6 +13,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/jobs"
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/secrets"
+	"github.com/acorn-io/runtime/pkg/secrets/backends"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,9 +60,23 @@ func CreateSecrets(req router.Request, resp router.Response) (err error) {
 		appInstance.Status.AppStatus.Secrets = map[string]v1.SecretStatus{}
 	}
 
+	var refresh time.Duration
+	defer func() {
+		if refresh > 0 {
+			resp.RetryAfter(refresh)
+		}
+	}()
+
 	for _, entry := range secretsOrdered(appInstance) {
 		secretName := entry.name
 
+		if backends.IsRef(entry.secret.External) {
+			// Secrets from backends are re-read periodically so that changes in the backend are picked up
+			if interval := backends.RefreshIntervalFor(req.Ctx, req.Client, appInstance.Namespace, entry.secret.External); refresh == 0 || interval < refresh {
+				refresh = interval
+			}
+		}
+
 		secret, err := secrets.GetOrCreateSecret(allSecrets, req, appInstance, secretName)
 		if apierrors.IsNotFound(err) {
 			if status := (*apierrors.StatusError)(nil); errors.As(err, &status) && status.ErrStatus.Details != nil {
@@ -86,6 +102,10 @@ func CreateSecrets(req router.Request, resp router.Response) (err error) {
 			continue
 		}
 
+		if next := secrets.NextRotation(entry.secret, secret, time.Now()); next > 0 && (refresh == 0 || next < refresh) {
+			refresh = next
+		}
+
 		labelMap := map[string]string{
 			labels.AcornAppName:               appInstance.Name,
 			labels.AcornAppNamespace:          appInstance.Namespace,
@@ -102,6 +122,9 @@ func CreateSecrets(req router.Request, resp router.Response) (err error) {
 
 		annotations[labels.AcornAppGeneration] = strconv.FormatInt(appInstance.Generation, 10)
 		annotations[labels.AcornConfigHashAnnotation] = appInstance.Status.AppStatus.Secrets[secretName].ConfigHash
+		if rotatedAt := secret.Annotations[labels.AcornSecretRotatedAt]; rotatedAt != "" {
+			annotations[labels.AcornSecretRotatedAt] = rotatedAt
+		}
 
 		resp.Objects(&corev1.Secret{
 			ObjectMeta: metav1.ObjectMeta{
@@ -54,7 +54,13 @@ func RenderServices(req router.Request, resp router.Response) error {
 		}
 		resp.Objects(objs...)
 
-		// Copy all modifications made by the above publish.Ingress call
+		objs, err = publish.Gateway(req, svc)
+		if err != nil {
+			return err
+		}
+		resp.Objects(objs...)
+
+		// Copy all modifications made by the above publish.Ingress and publish.Gateway calls
 		svcInstance.Status.Endpoints = append(svcInstance.Status.Endpoints, svc.Status.Endpoints...)
 	}
 	svcInstance.Status.Conditions = svcCopy.Status.Conditions
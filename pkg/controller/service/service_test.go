@@ -86,6 +86,14 @@ func TestIngressLabelsNamespace(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/ingress/labels-namespace", namespace.AddNamespace)
 }
 
+func TestIngressPolicyTraefik(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/ingress/policy-traefik", RenderServices)
+}
+
+func TestIngressPolicyNginx(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/ingress/policy-nginx", RenderServices)
+}
+
 func TestLetsEncrypt(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/ingress/letsencrypt", RenderServices)
 }
@@ -124,3 +132,11 @@ func TestCustomCertsShouldNotSetCertManager(t *testing.T) {
 func TestCustomCertsWithAnnonationsShouldNotSetCertManagerDefaultIssuer(t *testing.T) {
 	tester.DefaultTest(t, scheme.Scheme, "testdata/ingress/customdomainwithannotations", RenderServices)
 }
+
+func TestGateway(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/gateway/basic", RenderServices)
+}
+
+func TestGatewayRouter(t *testing.T) {
+	tester.DefaultTest(t, scheme.Scheme, "testdata/gateway/router", RenderServices)
+}
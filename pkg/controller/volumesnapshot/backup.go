@@ -0,0 +1,145 @@
+package volumesnapshot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/name"
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/typed"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	cronv3 "github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	klabels "k8s.io/apimachinery/pkg/labels"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const defaultBackupKeep = 7
+
+// ScheduleBackups takes snapshots of the volumes of the app that have a backup schedule and deletes the oldest scheduled
+// snapshots beyond the number to keep. Scheduled snapshots are not owned by the app, so they outlive it.
+func ScheduleBackups(req router.Request, resp router.Response) error {
+	app := req.Object.(*v1.AppInstance)
+	if !app.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	var (
+		now  = time.Now()
+		wait time.Duration
+	)
+	for _, entry := range typed.Sorted(app.Status.AppSpec.Volumes) {
+		volumeName, volumeRequest := entry.Key, entry.Value
+		if volumeRequest.Backup == nil || volumeRequest.Backup.Schedule == "" {
+			continue
+		}
+
+		pvs := &corev1.PersistentVolumeList{}
+		if err := req.List(pvs, &kclient.ListOptions{
+			LabelSelector: klabels.SelectorFromSet(map[string]string{
+				labels.AcornAppName:      app.Name,
+				labels.AcornAppNamespace: app.Namespace,
+				labels.AcornVolumeName:   volumeName,
+			}),
+		}); err != nil {
+			return err
+		}
+		if len(pvs.Items) != 1 {
+			// The volume is not provisioned yet
+			continue
+		}
+
+		next, err := backupVolume(req, app, volumeName, &pvs.Items[0], *volumeRequest.Backup, now)
+		if err != nil {
+			return err
+		}
+		if wait == 0 || next < wait {
+			wait = next
+		}
+	}
+
+	if wait > 0 {
+		resp.RetryAfter(wait)
+	}
+	return nil
+}
+
+// backupVolume takes a snapshot of the volume if one is due and returns how long until the next one is.
+func backupVolume(req router.Request, app *v1.AppInstance, volumeName string, pv *corev1.PersistentVolume, backup v1.VolumeBackup, now time.Time) (time.Duration, error) {
+	schedule, err := cronv3.ParseStandard(toCronSchedule(backup.Schedule))
+	if err != nil {
+		return 0, fmt.Errorf("invalid backup schedule [%s] for volume %s: %w", backup.Schedule, volumeName, err)
+	}
+
+	snapshotLabels := map[string]string{
+		labels.AcornAppName:      app.Name,
+		labels.AcornVolumeName:   volumeName,
+		labels.AcornVolumeBackup: "true",
+	}
+
+	snapshots := &v1.VolumeSnapshotInstanceList{}
+	if err := req.List(snapshots, &kclient.ListOptions{
+		Namespace:     app.Namespace,
+		LabelSelector: klabels.SelectorFromSet(snapshotLabels),
+	}); err != nil {
+		return 0, err
+	}
+	sort.Slice(snapshots.Items, func(i, j int) bool {
+		return snapshots.Items[j].CreationTimestamp.Before(&snapshots.Items[i].CreationTimestamp)
+	})
+
+	last := pv.CreationTimestamp.Time
+	if len(snapshots.Items) > 0 {
+		last = snapshots.Items[0].CreationTimestamp.Time
+	}
+
+	next := schedule.Next(last)
+	if next.After(now) {
+		return next.Sub(now), prune(req, snapshots.Items, backup.Keep)
+	}
+
+	snapshot := &v1.VolumeSnapshotInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name.SafeConcatName(app.Name, volumeName, next.UTC().Format("20060102-1504")),
+			Namespace: app.Namespace,
+			Labels:    snapshotLabels,
+		},
+		Spec: v1.VolumeSnapshotInstanceSpec{
+			Volume:     pv.Name,
+			AppName:    app.Name,
+			VolumeName: volumeName,
+		},
+	}
+	if err := req.Client.Create(req.Ctx, snapshot); err != nil && !apierrors.IsAlreadyExists(err) {
+		return 0, err
+	}
+
+	return schedule.Next(now).Sub(now), prune(req, append([]v1.VolumeSnapshotInstance{*snapshot}, snapshots.Items...), backup.Keep)
+}
+
+// prune deletes the snapshots, sorted newest first, beyond the number to keep.
+func prune(req router.Request, snapshots []v1.VolumeSnapshotInstance, keep int32) error {
+	if keep <= 0 {
+		keep = defaultBackupKeep
+	}
+	for i := int(keep); i < len(snapshots); i++ {
+		if err := req.Client.Delete(req.Ctx, &snapshots[i]); kclient.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// toCronSchedule allows the schedule to be a predefined schedule without the leading @, like jobs in the Acornfile.
+func toCronSchedule(schedule string) string {
+	schedule = strings.TrimSpace(schedule)
+	if strings.ContainsAny(schedule, " @") {
+		return schedule
+	}
+	return "@" + schedule
+}
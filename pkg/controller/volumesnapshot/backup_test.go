@@ -0,0 +1,70 @@
+package volumesnapshot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestBackupVolume(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 30, 0, 0, time.UTC)
+	app := &v1.AppInstance{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "acorn"}}
+	pv := &corev1.PersistentVolume{ObjectMeta: metav1.ObjectMeta{Name: "pvc-1234", CreationTimestamp: metav1.NewTime(now.Add(-4 * time.Hour))}}
+
+	snapshot := func(name string, age time.Duration) *v1.VolumeSnapshotInstance {
+		return &v1.VolumeSnapshotInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "acorn",
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+				Labels: map[string]string{
+					labels.AcornAppName:      "app",
+					labels.AcornVolumeName:   "data",
+					labels.AcornVolumeBackup: "true",
+				},
+			},
+		}
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+		snapshot("old", 3*time.Hour),
+		snapshot("older", 4*time.Hour),
+	).Build()
+	req := router.Request{Ctx: context.Background(), Client: c}
+
+	wait, err := backupVolume(req, app, "data", pv, v1.VolumeBackup{Schedule: "hourly", Keep: 2}, now)
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Minute, wait)
+
+	snapshots := &v1.VolumeSnapshotInstanceList{}
+	require.NoError(t, c.List(req.Ctx, snapshots))
+	var names []string
+	for _, s := range snapshots.Items {
+		names = append(names, s.Name)
+		if s.Name != "old" {
+			assert.Equal(t, "pvc-1234", s.Spec.Volume)
+			assert.Equal(t, "data", s.Spec.VolumeName)
+		}
+	}
+	assert.ElementsMatch(t, []string{"app-data-20230601-1000", "old"}, names)
+
+	// Nothing is due until the next hour
+	require.NoError(t, c.Delete(req.Ctx, snapshot("old", 0)))
+	require.NoError(t, c.Create(req.Ctx, snapshot("recent", 10*time.Minute)))
+	wait, err = backupVolume(req, app, "data", pv, v1.VolumeBackup{Schedule: "0 * * * *", Keep: 2}, now)
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Minute, wait)
+
+	_, err = backupVolume(req, app, "data", pv, v1.VolumeBackup{Schedule: "sometimes"}, now)
+	assert.Error(t, err)
+}
@@ -0,0 +1,240 @@
+package volumesnapshot
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/name"
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/uncached"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/imagesystem"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/volume"
+	gname "github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sirupsen/logrus"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/retry"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const pollInterval = 10 * time.Second
+
+// TakeSnapshot takes the snapshot of the volume. A CSI VolumeSnapshot is used if there is a VolumeSnapshotClass for the
+// driver of the volume. Otherwise, a job archives the content of the volume to the internal registry. Nothing is emitted
+// once the snapshot is ready, so the VolumeSnapshot or job used to take it is cleaned up.
+func TakeSnapshot(req router.Request, resp router.Response) error {
+	snapshot := req.Object.(*v1.VolumeSnapshotInstance)
+	if snapshot.Status.Ready || snapshot.Status.Error != "" {
+		return nil
+	}
+
+	pv := &corev1.PersistentVolume{}
+	if err := req.Get(pv, "", snapshot.Spec.Volume); apierrors.IsNotFound(err) {
+		snapshot.Status.Error = fmt.Sprintf("volume %s not found", snapshot.Spec.Volume)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if pv.Spec.ClaimRef == nil {
+		snapshot.Status.Error = fmt.Sprintf("volume %s is not bound", snapshot.Spec.Volume)
+		return nil
+	}
+
+	var snapshotClass string
+	if pv.Spec.CSI != nil {
+		var err error
+		snapshotClass, err = volume.SnapshotClassForDriver(req.Ctx, req.Client, pv.Spec.CSI.Driver)
+		if err != nil {
+			return err
+		}
+	}
+
+	if snapshot.Status.Method == "" {
+		snapshot.Status.Method = v1.VolumeSnapshotMethodArchive
+		if snapshotClass != "" {
+			snapshot.Status.Method = v1.VolumeSnapshotMethodCSI
+		}
+		snapshot.Status.SourceNamespace = pv.Spec.ClaimRef.Namespace
+		if size, ok := pv.Spec.Capacity[corev1.ResourceStorage]; ok {
+			snapshot.Status.Size = v1.Quantity(size.String())
+		}
+	}
+
+	objLabels := map[string]string{
+		labels.AcornVolumeSnapshotName: snapshot.Name,
+		labels.AcornAppNamespace:       snapshot.Namespace,
+	}
+
+	if snapshot.Status.Method == v1.VolumeSnapshotMethodCSI {
+		return takeCSISnapshot(req, resp, snapshot, pv, snapshotClass, objLabels)
+	}
+	return takeArchiveSnapshot(req, resp, snapshot, pv, objLabels)
+}
+
+func takeCSISnapshot(req router.Request, resp router.Response, snapshot *v1.VolumeSnapshotInstance, pv *corev1.PersistentVolume, snapshotClass string, objLabels map[string]string) error {
+	snapshot.Status.SnapshotName = snapshot.Name
+	snapshot.Status.Driver = pv.Spec.CSI.Driver
+
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(volume.VolumeSnapshotGVK)
+	if err := req.Get(uncached.Get(existing), snapshot.Status.SourceNamespace, snapshot.Status.SnapshotName); apierrors.IsNotFound(err) {
+		if snapshotClass == "" {
+			snapshot.Status.Error = fmt.Sprintf("no volume snapshot class found for driver %s", pv.Spec.CSI.Driver)
+			return nil
+		}
+	} else if err != nil {
+		return err
+	} else {
+		if msg, _, _ := unstructured.NestedString(existing.Object, "status", "error", "message"); msg != "" {
+			snapshot.Status.Error = msg
+			return nil
+		}
+
+		ready, _, _ := unstructured.NestedBool(existing.Object, "status", "readyToUse")
+		contentName, _, _ := unstructured.NestedString(existing.Object, "status", "boundVolumeSnapshotContentName")
+		if ready && contentName != "" {
+			// Retain the content, so the snapshot survives the VolumeSnapshot being cleaned up
+			handle, err := retainContent(req, contentName)
+			if err != nil {
+				return err
+			}
+			snapshot.Status.SnapshotContentName = contentName
+			snapshot.Status.SnapshotHandle = handle
+			snapshot.Status.Ready = true
+			return nil
+		}
+	}
+
+	resp.Objects(volume.NewVolumeSnapshot(snapshot.Status.SourceNamespace, snapshot.Status.SnapshotName, snapshotClass, pv.Spec.ClaimRef.Name, objLabels))
+	resp.RetryAfter(pollInterval)
+	return nil
+}
+
+func retainContent(req router.Request, contentName string) (handle string, _ error) {
+	return handle, retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		content := &unstructured.Unstructured{}
+		content.SetGroupVersionKind(volume.VolumeSnapshotContentGVK)
+		if err := req.Get(uncached.Get(content), "", contentName); err != nil {
+			return err
+		}
+
+		handle, _, _ = unstructured.NestedString(content.Object, "status", "snapshotHandle")
+		if policy, _, _ := unstructured.NestedString(content.Object, "spec", "deletionPolicy"); policy == "Retain" {
+			return nil
+		}
+		if err := unstructured.SetNestedField(content.Object, "Retain", "spec", "deletionPolicy"); err != nil {
+			return err
+		}
+		return req.Client.Update(req.Ctx, content)
+	})
+}
+
+func takeArchiveSnapshot(req router.Request, resp router.Response, snapshot *v1.VolumeSnapshotInstance, pv *corev1.PersistentVolume, objLabels map[string]string) error {
+	repo, external, err := imagesystem.GetInternalRepoForNamespace(req.Ctx, req.Client, snapshot.Namespace)
+	if err != nil {
+		return err
+	}
+	snapshot.Status.Artifact = repo.Tag(string(snapshot.UID)).String()
+
+	jobName := name.SafeConcatName("snapshot", snapshot.Name)
+	job := &batchv1.Job{}
+	if err := req.Get(job, snapshot.Status.SourceNamespace, jobName); err == nil {
+		if job.Status.Succeeded > 0 {
+			snapshot.Status.Ready = true
+			return nil
+		}
+		for _, cond := range job.Status.Conditions {
+			if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
+				snapshot.Status.Error = fmt.Sprintf("failed to archive volume: %s", cond.Message)
+				return nil
+			}
+		}
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	args := []string{snapshot.Status.Artifact, volume.ArchivePath}
+	if !external {
+		args = append([]string{"--insecure"}, args...)
+	}
+	resp.Objects(volume.NewArchiveJob(snapshot.Status.SourceNamespace, jobName, pv.Spec.ClaimRef.Name, true, objLabels, args...))
+	resp.RetryAfter(pollInterval)
+	return nil
+}
+
+// DeleteSnapshot deletes the data of the snapshot, the retained CSI VolumeSnapshotContent or the archive in the internal
+// registry, when the snapshot is deleted.
+func DeleteSnapshot(transport http.RoundTripper) router.HandlerFunc {
+	return func(req router.Request, resp router.Response) error {
+		snapshot := req.Object.(*v1.VolumeSnapshotInstance)
+		if !snapshot.Status.Ready {
+			return nil
+		}
+
+		switch snapshot.Status.Method {
+		case v1.VolumeSnapshotMethodCSI:
+			return deleteContent(req, snapshot.Status.SnapshotContentName)
+		case v1.VolumeSnapshotMethodArchive:
+			return deleteArchive(req, transport, snapshot)
+		}
+		return nil
+	}
+}
+
+func deleteContent(req router.Request, contentName string) error {
+	if contentName == "" {
+		return nil
+	}
+
+	content := &unstructured.Unstructured{}
+	content.SetGroupVersionKind(volume.VolumeSnapshotContentGVK)
+	if err := req.Get(uncached.Get(content), "", contentName); apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	// Switch the content back to Delete, so the driver deletes the snapshot with it
+	if err := unstructured.SetNestedField(content.Object, "Delete", "spec", "deletionPolicy"); err != nil {
+		return err
+	}
+	if err := req.Client.Update(req.Ctx, content); err != nil {
+		return err
+	}
+	return kclient.IgnoreNotFound(req.Client.Delete(req.Ctx, content))
+}
+
+func deleteArchive(req router.Request, transport http.RoundTripper, snapshot *v1.VolumeSnapshotInstance) error {
+	_, external, err := imagesystem.GetInternalRepoForNamespace(req.Ctx, req.Client, snapshot.Namespace)
+	if err != nil {
+		return err
+	}
+
+	var opts []gname.Option
+	if !external {
+		opts = append(opts, gname.Insecure)
+	}
+	ref, err := gname.NewTag(snapshot.Status.Artifact, opts...)
+	if err != nil {
+		return err
+	}
+
+	remoteOpts := []remote.Option{remote.WithContext(req.Ctx), remote.WithTransport(transport)}
+	desc, err := remote.Head(ref, remoteOpts...)
+	if err != nil {
+		// Don't block deleting the snapshot on the registry, the archive is only orphaned
+		logrus.Warnf("failed to find archive %s of volume snapshot %s/%s: %v", snapshot.Status.Artifact, snapshot.Namespace, snapshot.Name, err)
+		return nil
+	}
+	if err := remote.Delete(ref.Context().Digest(desc.Digest.String()), remoteOpts...); err != nil {
+		logrus.Warnf("failed to delete archive %s of volume snapshot %s/%s: %v", snapshot.Status.Artifact, snapshot.Namespace, snapshot.Name, err)
+	}
+	return nil
+}
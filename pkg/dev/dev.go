@@ -374,6 +374,10 @@ func updateApp(ctx context.Context, c client.Client, appName string, client v1.D
 	update.Stop = new(bool)
 	update.AutoUpgrade = new(bool)
 	update.DevSessionTimeoutSeconds = opts.TimeoutSeconds
+	if opts.Run.Preview != nil {
+		// Previews are torn down, instead of being left stopped, when the session expires
+		update.DevSessionExpireAction = &v1.DevSessionInstanceExpireAction{Delete: true}
+	}
 	opts.Logger.Infof("Updating acorn [%s] to image [%s]", appName, image)
 	app, err := rulerequest.PromptUpdate(ctx, c, opts.Dangerous, appName, update)
 	if err != nil {
@@ -10,6 +10,7 @@ import (
 	"github.com/acorn-io/baaah/pkg/router"
 	"github.com/acorn-io/runtime/pkg/config"
 	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/publish"
 	"github.com/acorn-io/runtime/pkg/system"
 	"github.com/acorn-io/runtime/pkg/version"
 	"github.com/sirupsen/logrus"
@@ -18,6 +19,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/wait"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
 type Daemon struct {
@@ -75,7 +77,7 @@ func (d *Daemon) renewAndSync(ctx context.Context) (bool, error) {
 		return false, fmt.Errorf("DNS secret %v/%v exists but is missing domain (%v) or token", system.Namespace, system.DNSSecretName, domain)
 	}
 
-	if err := d.syncIngress(ctx, domain, token, *cfg.AcornDNSEndpoint, dnsSecret); err != nil {
+	if err := d.syncIngress(ctx, domain, token, *cfg.AcornDNSEndpoint, dnsSecret, publish.UsesGateway(cfg)); err != nil {
 		logrus.Errorf("Failed to sync ingress: %v", err)
 		return false, nil
 	}
@@ -97,18 +99,38 @@ func (d *Daemon) getDNSSecret(ctx context.Context) (*corev1.Secret, error) {
 	return dnsSecret, nil
 }
 
-func (d *Daemon) syncIngress(ctx context.Context, domain, token, acornDNSEndpoint string, secret *corev1.Secret) error {
-	var ingress netv1.Ingress
-	err := d.client.Get(ctx, router.Key(system.Namespace, system.DNSIngressName), &ingress)
-	if err != nil {
-		if apierrors.IsNotFound(err) {
-			return nil
+// recordSource returns the object the records of the domain point to and the records to renew, which is the DNS
+// ingress, or the shared Gateway if ports are published with the gateway backend. A nil object means there is nothing
+// to renew.
+func (d *Daemon) recordSource(ctx context.Context, domain string, useGateway bool) (kclient.Object, []RecordRequest, error) {
+	if useGateway {
+		var gateway gatewayv1beta1.Gateway
+		if err := d.client.Get(ctx, router.Key(system.Namespace, system.GatewayName), &gateway); apierrors.IsNotFound(err) {
+			return nil, nil, nil
+		} else if err != nil {
+			return nil, nil, fmt.Errorf("failed to get %v for DNS renewal: %w", system.GatewayName, err)
 		}
-		return fmt.Errorf("failed to get %v for DNS renewal: %w", system.DNSIngressName, err)
+		recordRequests, _ := GatewayToRecordRequestsAndHash(domain, &gateway)
+		return &gateway, recordRequests, nil
+	}
+
+	var ingress netv1.Ingress
+	if err := d.client.Get(ctx, router.Key(system.Namespace, system.DNSIngressName), &ingress); apierrors.IsNotFound(err) {
+		return nil, nil, nil
+	} else if err != nil {
+		return nil, nil, fmt.Errorf("failed to get %v for DNS renewal: %w", system.DNSIngressName, err)
 	}
 
 	// Build the system.IngressName ingress into a list of RecordRequests
 	recordRequests, _ := ToRecordRequestsAndHash(domain, &ingress)
+	return &ingress, recordRequests, nil
+}
+
+func (d *Daemon) syncIngress(ctx context.Context, domain, token, acornDNSEndpoint string, secret *corev1.Secret, useGateway bool) error {
+	obj, recordRequests, err := d.recordSource(ctx, domain, useGateway)
+	if err != nil || obj == nil {
+		return err
+	}
 
 	// Send the recordRequests to AcornDNS to renew and find any out of sync records
 	dnsClient := NewClient()
@@ -126,10 +148,12 @@ func (d *Daemon) syncIngress(ctx context.Context, domain, token, acornDNSEndpoin
 	// gets reprocessed by the acorn-controller. This will cause any out of sync records
 	// to be created or updated as necessary.
 	if len(response.OutOfSyncRecords) > 0 {
-		delete(ingress.Annotations, labels.AcornDNSHash)
-		err = d.client.Update(ctx, &ingress)
+		annotations := obj.GetAnnotations()
+		delete(annotations, labels.AcornDNSHash)
+		obj.SetAnnotations(annotations)
+		err = d.client.Update(ctx, obj)
 		if err != nil {
-			return fmt.Errorf("problem updating %v ingress: %v", ingress.Name, err)
+			return fmt.Errorf("problem updating %v: %v", obj.GetName(), err)
 		}
 	}
 
@@ -7,25 +7,23 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/acorn-io/z"
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/networking/v1"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
 // ToRecordRequestsAndHash creates wildcard DNS records based on the ingress and domain supplied. It
 // also returns a hash of those records, suitable for using over time to determine if the ingress's
 // records need to change.
 func ToRecordRequestsAndHash(domain string, ingress *v1.Ingress) ([]RecordRequest, string) {
-	var ipv4s, ipv6s, lbHosts, recordValues []string
+	var ips, lbHosts []string
 
 	for _, i := range ingress.Status.LoadBalancer.Ingress {
 		if i.IP != "" {
-			if strings.Contains(i.IP, ":") {
-				ipv6s = append(ipv6s, i.IP)
-			} else {
-				ipv4s = append(ipv4s, i.IP)
-			}
+			ips = append(ips, i.IP)
 		}
 		if i.Hostname != "" {
 			lbHosts = append(lbHosts, i.Hostname)
@@ -40,6 +38,37 @@ func ToRecordRequestsAndHash(domain string, ingress *v1.Ingress) ([]RecordReques
 		}
 	}
 
+	return toRecordRequestsAndHash(domain, hosts, ips, lbHosts)
+}
+
+// GatewayToRecordRequestsAndHash creates wildcard DNS records for the addresses of the shared Gateway that the HTTP
+// ports are published on when the gateway publish backend is used. It also returns a hash of those records, like
+// ToRecordRequestsAndHash.
+func GatewayToRecordRequestsAndHash(domain string, gateway *gatewayv1beta1.Gateway) ([]RecordRequest, string) {
+	var ips, lbHosts []string
+
+	for _, address := range gateway.Status.Addresses {
+		switch z.Dereference(address.Type) {
+		case "", gatewayv1beta1.IPAddressType:
+			ips = append(ips, address.Value)
+		case gatewayv1beta1.HostnameAddressType:
+			lbHosts = append(lbHosts, address.Value)
+		}
+	}
+
+	return toRecordRequestsAndHash(domain, []string{"*"}, ips, lbHosts)
+}
+
+func toRecordRequestsAndHash(domain string, hosts, ips, lbHosts []string) ([]RecordRequest, string) {
+	var ipv4s, ipv6s, recordValues []string
+	for _, ip := range ips {
+		if strings.Contains(ip, ":") {
+			ipv6s = append(ipv6s, ip)
+		} else {
+			ipv4s = append(ipv4s, ip)
+		}
+	}
+
 	var requests []RecordRequest
 	if len(lbHosts) > 0 {
 		var recordType string
@@ -3,8 +3,10 @@ package dns
 import (
 	"testing"
 
+	"github.com/acorn-io/z"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/networking/v1"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
 // TestToRecordRequests is a table test that asserts for given Ingress values, the expected RecordRequests are returned
@@ -28,6 +30,23 @@ func TestToRecordRequests(t *testing.T) {
 	assrt(t, ".foo.com", []string{"app.foo.com"}, nil, nil, []string{"localhost"}, []RecordRequest{{"*", RecordTypeA, []string{"127.0.0.1"}}})
 }
 
+func TestGatewayToRecordRequests(t *testing.T) {
+	gateway := &gatewayv1beta1.Gateway{
+		Status: gatewayv1beta1.GatewayStatus{
+			Addresses: []gatewayv1beta1.GatewayAddress{
+				{Value: "10.0.0.1"},
+				{Type: z.Pointer(gatewayv1beta1.IPAddressType), Value: "::1"},
+			},
+		},
+	}
+	recordReqs, _ := GatewayToRecordRequestsAndHash(".foo.com", gateway)
+	assert.Equal(t, []RecordRequest{{"*", RecordTypeA, []string{"10.0.0.1"}}, {"*", RecordTypeAAAA, []string{"::1"}}}, recordReqs)
+
+	gateway.Status.Addresses = append(gateway.Status.Addresses, gatewayv1beta1.GatewayAddress{Type: z.Pointer(gatewayv1beta1.HostnameAddressType), Value: "lb.example.com"})
+	recordReqs, _ = GatewayToRecordRequestsAndHash(".foo.com", gateway)
+	assert.Equal(t, []RecordRequest{{"*", RecordTypeCname, []string{"lb.example.com"}}}, recordReqs)
+}
+
 func assrt(t *testing.T, domain string, specRulesHosts, statusIPv4s, statusIPv6s, statusHosts []string, expectedRRs []RecordRequest) {
 	t.Helper()
 
@@ -0,0 +1,91 @@
+package egress
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// blockedPrefixes are the address ranges that are blocked on top of the special purpose ranges the netip package knows.
+var blockedPrefixes = []netip.Prefix{
+	// "This network", only 0.0.0.0 is unspecified
+	netip.MustParsePrefix("0.0.0.0/8"),
+	// Carrier-grade NAT, used by some clusters for pods and services
+	netip.MustParsePrefix("100.64.0.0/10"),
+}
+
+// BlockedAddress returns true if the controller must not connect to the address on behalf of project members:
+// loopback, link-local (including cloud metadata endpoints), private, unspecified and multicast addresses. Reaching
+// them would let a project member use the controller to read from the cluster or the node it runs on.
+func BlockedAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if addr.IsLoopback() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() || addr.IsPrivate() || addr.IsUnspecified() {
+		return true
+	}
+	for _, prefix := range blockedPrefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewClient returns a client that refuses to connect to blocked addresses, unless they are in one of the allowed
+// prefixes. The address is checked when it is dialed, after the host name is resolved, so that a host name can't
+// resolve to a blocked address later on.
+func NewClient(timeout time.Duration, allowed []netip.Prefix) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			addr := addrPort.Addr().Unmap()
+			for _, prefix := range allowed {
+				if prefix.Contains(addr) {
+					return nil
+				}
+			}
+			if BlockedAddress(addr) {
+				return fmt.Errorf("connecting to %s is not allowed", addr)
+			}
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			// A proxy would make the checked address the address of the proxy
+			Proxy:                 nil,
+			DialContext:           dialer.DialContext,
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   timeout,
+			ExpectContinueTimeout: time.Second,
+		},
+	}
+}
+
+// ParsePrefixes parses a list of IP addresses and CIDRs. A single address is parsed as the prefix of just that address.
+func ParsePrefixes(values []string) ([]netip.Prefix, error) {
+	result := make([]netip.Prefix, 0, len(values))
+	for _, value := range values {
+		if addr, err := netip.ParseAddr(value); err == nil {
+			result = append(result, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address or CIDR [%s]", value)
+		}
+		result = append(result, prefix.Masked())
+	}
+	return result, nil
+}
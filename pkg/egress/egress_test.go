@@ -0,0 +1,32 @@
+package egress
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockedAddress(t *testing.T) {
+	for _, addr := range []string{"127.0.0.1", "::1", "169.254.169.254", "10.43.0.1", "172.16.0.1", "192.168.1.1",
+		"100.64.0.1", "0.0.0.0", "::ffff:127.0.0.1", "fd00::1", "fe80::1", "224.0.0.1"} {
+		assert.True(t, BlockedAddress(netip.MustParseAddr(addr)), addr)
+	}
+	for _, addr := range []string{"1.1.1.1", "8.8.8.8", "2606:4700::1111"} {
+		assert.False(t, BlockedAddress(netip.MustParseAddr(addr)), addr)
+	}
+}
+
+func TestParsePrefixes(t *testing.T) {
+	prefixes, err := ParsePrefixes([]string{"10.43.0.10", "10.42.0.0/16", "::ffff:192.168.1.1"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []netip.Prefix{
+			netip.MustParsePrefix("10.43.0.10/32"),
+			netip.MustParsePrefix("10.42.0.0/16"),
+			netip.MustParsePrefix("192.168.1.1/32"),
+		}, prefixes)
+	}
+
+	_, err = ParsePrefixes([]string{"vault.example.com"})
+	assert.ErrorContains(t, err, "invalid address or CIDR [vault.example.com]")
+}
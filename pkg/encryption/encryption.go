@@ -2,11 +2,17 @@ package encryption
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"regexp"
 
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/encryption/nacl"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/z"
 	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/sets"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -31,10 +37,56 @@ func naclKeysToEncryptionKeyList(ctx context.Context, c kclient.Reader, namespac
 		if pubKey == "primary" {
 			continue
 		}
+		annotations := map[string]string{}
+		if z.Dereference(values[pubKey].Primary) {
+			annotations[labels.AcornEncryptionKeyPrimary] = "true"
+		}
 		out = append(out, apiv1.EncryptionKey{
 			KeyID:       pubKey,
-			Annotations: map[string]string{},
+			Annotations: annotations,
 		})
 	}
 	return out, nil
 }
+
+var encryptedDataRegexp = regexp.MustCompile(regexp.QuoteMeta(nacl.EncPrefix) + `[A-Za-z0-9_-]+` + regexp.QuoteMeta(nacl.EncSuffix))
+
+// KeyReferences returns, for each key of the namespace, the names of the apps with encrypted data that can only be
+// decrypted with that key. These apps will fail to decrypt their data if the key is retired.
+func KeyReferences(ctx context.Context, c kclient.Reader, namespace string, keys nacl.Keys) (map[string][]string, error) {
+	apps := &v1.AppInstanceList{}
+	if err := c.List(ctx, apps, kclient.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	result := map[string][]string{}
+	for _, app := range apps.Items {
+		data, err := json.Marshal([]any{app.Spec, app.Status.AppSpec})
+		if err != nil {
+			return nil, err
+		}
+
+		referenced := sets.New[string]()
+		for _, encData := range encryptedDataRegexp.FindAll(data, -1) {
+			keyIDs, err := nacl.KeyIDs(encData)
+			if err != nil {
+				continue
+			}
+			var available []string
+			for _, keyID := range keyIDs {
+				if _, ok := keys[keyID]; ok {
+					available = append(available, keyID)
+				}
+			}
+			if len(available) == 1 {
+				referenced.Insert(available[0])
+			}
+		}
+
+		for _, keyID := range sets.List(referenced) {
+			result[keyID] = append(result[keyID], app.Name)
+		}
+	}
+
+	return result, nil
+}
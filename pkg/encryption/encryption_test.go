@@ -0,0 +1,67 @@
+package encryption
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/encryption/nacl"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestKeyRotation(t *testing.T) {
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "acorn", UID: "0123456789abcdef"},
+	}).Build()
+
+	first, err := nacl.GetOrCreatePrimaryNaclKey(ctx, c, "acorn")
+	require.NoError(t, err)
+	firstID := nacl.KeyBytesToB64String(first.PublicKey)
+
+	encrypted, err := nacl.Encrypt("password", firstID)
+	require.NoError(t, err)
+	data, err := encrypted.Marshal()
+	require.NoError(t, err)
+
+	require.NoError(t, c.Create(ctx, &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "acorn"},
+		Spec: v1.AppInstanceSpec{
+			DeployArgs: v1.NewGenericMap(map[string]any{"password": data}),
+		},
+	}))
+
+	second, err := nacl.RotatePrimaryNaclKey(ctx, c, "acorn")
+	require.NoError(t, err)
+	secondID := nacl.KeyBytesToB64String(second.PublicKey)
+
+	keys, err := GetEncryptionKeyList(ctx, c, "acorn")
+	require.NoError(t, err)
+	assert.Len(t, keys, 2)
+	for _, key := range keys {
+		assert.Equal(t, key.KeyID == secondID, key.Annotations[labels.AcornEncryptionKeyPrimary] == "true")
+	}
+
+	// Data encrypted with the previous key can still be decrypted
+	decrypted, err := nacl.DecryptNamespacedData(ctx, c, []byte(data), "acorn")
+	require.NoError(t, err)
+	assert.Equal(t, "password", string(decrypted))
+
+	allKeys, err := nacl.GetAllNaclKeys(ctx, c, "acorn")
+	require.NoError(t, err)
+	references, err := KeyReferences(ctx, c, "acorn", allKeys)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{firstID: {"app"}}, references)
+
+	assert.Error(t, nacl.RetireNaclKey(ctx, c, "acorn", secondID))
+	require.NoError(t, nacl.RetireNaclKey(ctx, c, "acorn", firstID))
+
+	_, err = nacl.DecryptNamespacedData(ctx, c, []byte(data), "acorn")
+	assert.Error(t, err)
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"golang.org/x/crypto/nacl/box"
+	"golang.org/x/exp/maps"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -78,6 +79,15 @@ func (k *Key) Decrypt(encData []byte) ([]byte, error) {
 	return decryptedBytes, nil
 }
 
+// KeyIDs returns the public keys the encrypted data can be decrypted with.
+func KeyIDs(encData []byte) ([]string, error) {
+	preppedData, err := unwrapForDecryption(encData)
+	if err != nil {
+		return nil, err
+	}
+	return maps.Keys(preppedData), nil
+}
+
 func unwrapForDecryption(data []byte) (map[string][]byte, error) {
 	trimmedData := strings.TrimPrefix(string(data), EncPrefix)
 	trimmedData = strings.TrimSuffix(trimmedData, EncSuffix)
@@ -27,17 +27,19 @@ type Key struct {
 	AcornNamespace    string
 	Primary           *bool
 	PublicKey         *[32]byte
+	Created           *metav1.Time
 	acornNamespaceUID string
 	privateKey        *[32]byte
 }
 
 type naclKeyStore map[string]naclStoredKey
 type naclStoredKey struct {
-	AcornNamespace    string    `json:"acornNamespace,omitempty"`
-	Primary           *bool     `json:"primary,omitempty"`
-	AcornNamespaceUID string    `json:"acornNamespaceUID,omitempty"`
-	PrivateKey        *[32]byte `json:"privateKey,omitempty"`
-	PublicKey         *[32]byte `json:"publicKey,omitempty"`
+	AcornNamespace    string       `json:"acornNamespace,omitempty"`
+	Primary           *bool        `json:"primary,omitempty"`
+	AcornNamespaceUID string       `json:"acornNamespaceUID,omitempty"`
+	PrivateKey        *[32]byte    `json:"privateKey,omitempty"`
+	PublicKey         *[32]byte    `json:"publicKey,omitempty"`
+	Created           *metav1.Time `json:"created,omitempty"`
 }
 
 func GetOrCreatePrimaryNaclKey(ctx context.Context, c kclient.Client, namespace string) (*Key, error) {
@@ -102,6 +104,7 @@ func GetPublicKey(ctx context.Context, c kclient.Reader, namespace string) (stri
 func generateNewKeys(ctx context.Context, c kclient.Client, namespace string, existing *corev1.Secret) (*Key, error) {
 	naclKey := &Key{
 		AcornNamespace: namespace,
+		Created:        z.Pointer(metav1.Now()),
 	}
 	publicKey, privateKey, err := box.GenerateKey(rand.Reader)
 	if err != nil {
@@ -128,6 +131,92 @@ func generateNewKeys(ctx context.Context, c kclient.Client, namespace string, ex
 	return naclKey, createOrUpdateNaclKeySecret(ctx, c, naclKey, existing)
 }
 
+// RotatePrimaryNaclKey generates a new primary key for the namespace. The previous keys are kept so that data
+// encrypted with them can still be decrypted until they are retired.
+func RotatePrimaryNaclKey(ctx context.Context, c kclient.Client, namespace string) (*Key, error) {
+	existing, err := getExistingSecret(ctx, c, namespace)
+	if apierrors.IsNotFound(err) {
+		return generateNewKeys(ctx, c, namespace, nil)
+	} else if err != nil {
+		return nil, err
+	}
+
+	store, err := secretToKeyStore(existing)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, privateKey, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	for pubKey, keyInfo := range store {
+		keyInfo.Primary = z.Pointer(false)
+		store[pubKey] = keyInfo
+	}
+
+	naclKey := &Key{
+		AcornNamespace:    namespace,
+		Primary:           z.Pointer(true),
+		PublicKey:         publicKey,
+		Created:           z.Pointer(metav1.Now()),
+		acornNamespaceUID: string(existing.Data[naclNSUID]),
+		privateKey:        privateKey,
+	}
+	store[KeyBytesToB64String(publicKey)] = naclKey.toStoredKey()
+
+	return naclKey, updateKeyStore(ctx, c, existing, store)
+}
+
+// RetireNaclKey removes a key from the namespace. Data encrypted only with the key can no longer be decrypted after
+// it is retired. The primary key cannot be retired, a new primary key has to be rotated in first.
+func RetireNaclKey(ctx context.Context, c kclient.Client, namespace, publicKey string) error {
+	existing, err := getExistingSecret(ctx, c, namespace)
+	if apierrors.IsNotFound(err) {
+		return &ErrKeyNotFound{}
+	} else if err != nil {
+		return err
+	}
+
+	store, err := secretToKeyStore(existing)
+	if err != nil {
+		return err
+	}
+
+	keyInfo, ok := store[publicKey]
+	if !ok {
+		return &ErrKeyNotFound{}
+	}
+	if z.Dereference(keyInfo.Primary) {
+		return fmt.Errorf("key %s is the primary key of namespace %s and cannot be retired, rotate the key first", publicKey, namespace)
+	}
+
+	delete(store, publicKey)
+	return updateKeyStore(ctx, c, existing, store)
+}
+
+func secretToKeyStore(secret *corev1.Secret) (naclKeyStore, error) {
+	store := naclKeyStore{}
+	if keyData, ok := secret.Data[naclStoreKey]; ok {
+		if err := json.Unmarshal(keyData, &store); err != nil {
+			return nil, err
+		}
+	}
+	return store, nil
+}
+
+func updateKeyStore(ctx context.Context, c kclient.Client, existing *corev1.Secret, store naclKeyStore) error {
+	keyData, err := json.Marshal(store)
+	if err != nil {
+		return err
+	}
+
+	updatedSecret := existing.DeepCopy()
+	updatedSecret.Data[naclStoreKey] = keyData
+	return c.Update(ctx, updatedSecret)
+}
+
 func getExistingSecret(ctx context.Context, c kclient.Reader, namespace string) (*corev1.Secret, error) {
 	nsString, err := naclSecretName(ctx, c, namespace)
 	if err != nil {
@@ -219,6 +308,7 @@ func secretToNaclKeys(secret *corev1.Secret, namespace string) (Keys, error) {
 			AcornNamespace:    keyInfo.AcornNamespace,
 			Primary:           keyInfo.Primary,
 			PublicKey:         pubKey,
+			Created:           keyInfo.Created,
 			privateKey:        keyInfo.PrivateKey,
 			acornNamespaceUID: string(uid),
 		}
@@ -245,17 +335,21 @@ func (k *Key) toSecretData(existingData map[string][]byte) (map[string][]byte, e
 			}
 		}
 	}
-	stringKey := KeyBytesToB64String(k.PublicKey)
-	store[stringKey] = naclStoredKey{
+	store[KeyBytesToB64String(k.PublicKey)] = k.toStoredKey()
+
+	to[naclStoreKey], err = json.Marshal(store)
+	return to, err
+}
+
+func (k *Key) toStoredKey() naclStoredKey {
+	return naclStoredKey{
 		AcornNamespace:    k.AcornNamespace,
 		Primary:           k.Primary,
 		AcornNamespaceUID: k.acornNamespaceUID,
 		PrivateKey:        k.privateKey,
 		PublicKey:         k.PublicKey,
+		Created:           k.Created,
 	}
-
-	to[naclStoreKey], err = json.Marshal(store)
-	return to, err
 }
 
 func naclk8sKey(namespace, name string) kclient.ObjectKey {
@@ -0,0 +1,206 @@
+package estimate
+
+import (
+	"context"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/typed"
+	"github.com/acorn-io/baaah/pkg/uncached"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	adminv1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/computeclasses"
+	"github.com/acorn-io/runtime/pkg/controller/quota"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/volume"
+	"github.com/acorn-io/z"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const gib = 1 << 30
+
+// App returns the estimate of the resources, cost and quota of an app. The resolved offerings of the app must already
+// be set.
+func App(ctx context.Context, c kclient.Client, appInstance *v1.AppInstance) (*apiv1.AppEstimate, error) {
+	computeClasses := map[string]*adminv1.ProjectComputeClassInstance{}
+	getComputeClass := func(name string) (*adminv1.ProjectComputeClassInstance, error) {
+		if cc, ok := computeClasses[name]; ok {
+			return cc, nil
+		}
+		cc, err := computeclasses.GetAsProjectComputeClassInstance(ctx, c, appInstance.Namespace, name)
+		if apierrors.IsNotFound(err) {
+			cc, err = nil, nil
+		}
+		computeClasses[name] = cc
+		return cc, err
+	}
+
+	estimate := &apiv1.AppEstimate{}
+	if err := addWorkloads(estimate, appInstance, getComputeClass); err != nil {
+		return nil, err
+	}
+
+	if len(appInstance.Status.AppSpec.Volumes) > 0 {
+		volumeClasses, _, err := volume.GetVolumeClassInstances(ctx, c, appInstance.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		if err := addVolumes(estimate, appInstance, volumeClasses); err != nil {
+			return nil, err
+		}
+	}
+
+	quotaResult, err := quotaEstimate(ctx, c, appInstance)
+	if err != nil {
+		return nil, err
+	}
+	estimate.Quota = quotaResult
+
+	return estimate, nil
+}
+
+// addWorkloads adds the containers and their sidecars to the estimate. Jobs are left out, like they are from quota,
+// because they do not run all the time.
+func addWorkloads(estimate *apiv1.AppEstimate, appInstance *v1.AppInstance, getComputeClass func(string) (*adminv1.ProjectComputeClassInstance, error)) error {
+	add := func(name string, replicas int64) error {
+		offering, ok := appInstance.Status.ResolvedOfferings.Containers[name]
+		if !ok {
+			offering = appInstance.Status.ResolvedOfferings.Containers[""]
+		}
+
+		workload := apiv1.WorkloadEstimate{
+			Name:         name,
+			ComputeClass: offering.Class,
+			Replicas:     replicas,
+			CPU:          z.Dereference(offering.CPU),
+			Memory:       z.Dereference(offering.Memory),
+		}
+
+		cc, err := getComputeClass(offering.Class)
+		if err != nil {
+			return err
+		}
+		if cc != nil && cc.Price != nil {
+			workload.MonthlyCost = z.Pointer(float64(replicas) *
+				(cc.Price.CPU*float64(workload.CPU)/1000 + cc.Price.Memory*float64(workload.Memory)/gib))
+		}
+
+		estimate.Workloads = append(estimate.Workloads, workload)
+		estimate.Total.Add(apiv1.ResourceEstimate{
+			CPU:         workload.CPU * replicas,
+			Memory:      workload.Memory * replicas,
+			MonthlyCost: workload.MonthlyCost,
+		})
+		return nil
+	}
+
+	for _, entry := range typed.Sorted(appInstance.Status.AppSpec.Containers) {
+		replicas := quota.Replicas(entry.Value)
+		if err := add(entry.Key, replicas); err != nil {
+			return err
+		}
+		for _, sidecar := range typed.SortedKeys(entry.Value.Sidecars) {
+			if err := add(sidecar, replicas); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// addVolumes adds the volumes of the app to the estimate. Volumes that are bound to an existing volume are left out
+// because their storage is already used.
+func addVolumes(estimate *apiv1.AppEstimate, appInstance *v1.AppInstance, volumeClasses map[string]adminv1.ProjectVolumeClassInstance) error {
+	bindings := volume.SliceToMap(appInstance.Spec.Volumes, func(vb v1.VolumeBinding) string {
+		return vb.Target
+	})
+
+	for _, name := range typed.SortedKeys(appInstance.Status.AppSpec.Volumes) {
+		if bindings[name].Volume != "" {
+			continue
+		}
+
+		offering := appInstance.Status.ResolvedOfferings.Volumes[name]
+		if offering.Size == "" {
+			continue
+		}
+		size, err := resource.ParseQuantity(string(offering.Size))
+		if err != nil {
+			return err
+		}
+
+		vol := apiv1.VolumeEstimate{
+			Name:        name,
+			VolumeClass: offering.Class,
+			Size:        size.Value(),
+		}
+		if price := volumeClasses[offering.Class].Price; price != nil {
+			vol.MonthlyCost = z.Pointer(price.Storage * float64(vol.Size) / gib)
+		}
+
+		estimate.Volumes = append(estimate.Volumes, vol)
+		estimate.Total.Add(apiv1.ResourceEstimate{
+			Storage:     vol.Size,
+			MonthlyCost: vol.MonthlyCost,
+		})
+	}
+
+	return nil
+}
+
+// quotaEstimate returns the quota the app would request along with the quota currently allocated to it and to its
+// project, if the project has quota enforced.
+func quotaEstimate(ctx context.Context, c kclient.Client, appInstance *v1.AppInstance) (apiv1.QuotaEstimate, error) {
+	project := &v1.ProjectInstance{}
+	if err := c.Get(ctx, router.Key("", appInstance.Namespace), project); err != nil {
+		return apiv1.QuotaEstimate{}, err
+	}
+	if project.Annotations[labels.ProjectEnforcedQuotaAnnotation] != "true" {
+		return apiv1.QuotaEstimate{}, nil
+	}
+
+	// Compute the request the same way the quota controller does, so that it matches what will be allocated
+	requested, err := quota.Requested(router.Request{
+		Client: uncachedClient{Client: c},
+		Object: appInstance,
+		Ctx:    ctx,
+	}, appInstance)
+	if err != nil {
+		return apiv1.QuotaEstimate{}, err
+	}
+
+	result := apiv1.QuotaEstimate{
+		Enforced:  true,
+		Requested: requested,
+	}
+
+	quotaRequests := &adminv1.QuotaRequestInstanceList{}
+	if err := c.List(ctx, quotaRequests, kclient.InNamespace(appInstance.Namespace)); err != nil {
+		return apiv1.QuotaEstimate{}, err
+	}
+	for _, quotaRequest := range quotaRequests.Items {
+		if quotaRequest.Name == appInstance.Name {
+			result.Allocated.Add(quotaRequest.Status.AllocatedResources)
+		}
+		result.ProjectAllocated.Add(quotaRequest.Status.AllocatedResources)
+	}
+
+	return result, nil
+}
+
+// uncachedClient unwraps the objects that are marked to be read uncached, which only the client of the controller
+// understands. The client of the API server doesn't cache, so they are read as is.
+type uncachedClient struct {
+	kclient.Client
+}
+
+func (u uncachedClient) Get(ctx context.Context, key kclient.ObjectKey, obj kclient.Object, opts ...kclient.GetOption) error {
+	return u.Client.Get(ctx, key, uncached.Unwrap(obj).(kclient.Object), opts...)
+}
+
+func (u uncachedClient) List(ctx context.Context, list kclient.ObjectList, opts ...kclient.ListOption) error {
+	return u.Client.List(ctx, uncached.UnwrapList(list), opts...)
+}
@@ -0,0 +1,164 @@
+package estimate
+
+import (
+	"context"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	adminv1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/acorn-io/z"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func testApp() *v1.AppInstance {
+	return &v1.AppInstance{
+		Spec: v1.AppInstanceSpec{
+			Volumes: []v1.VolumeBinding{{Target: "existing", Volume: "old-data"}},
+			Secrets: []v1.SecretBinding{{Target: "bound", Secret: "creds"}},
+		},
+		Status: v1.AppInstanceStatus{
+			EmbeddedAppStatus: v1.EmbeddedAppStatus{
+				AppSpec: v1.AppSpec{
+					Containers: map[string]v1.Container{
+						"web": {
+							Scale: z.Pointer[int32](2),
+							Sidecars: map[string]v1.Container{
+								"proxy": {},
+							},
+						},
+						"worker": {
+							Autoscale: &v1.Autoscale{MaxReplicas: 3},
+						},
+					},
+					Jobs: map[string]v1.Container{
+						"migrate": {},
+					},
+					Volumes: map[string]v1.VolumeRequest{
+						"data":     {Size: "10Gi"},
+						"existing": {},
+					},
+					Secrets: map[string]v1.Secret{
+						"bound": {},
+						"new":   {},
+					},
+				},
+				ResolvedOfferings: v1.ResolvedOfferings{
+					Containers: map[string]v1.ContainerResolvedOffering{
+						"": {
+							Class:  "small",
+							CPU:    z.Pointer[int64](100),
+							Memory: z.Pointer[int64](128 << 20),
+						},
+						"web": {
+							Class:  "large",
+							CPU:    z.Pointer[int64](1000),
+							Memory: z.Pointer[int64](1 << 30),
+						},
+					},
+					Volumes: map[string]v1.VolumeResolvedOffering{
+						"data": {
+							Class: "fast",
+							Size:  "10Gi",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestEstimate(t *testing.T) {
+	prices := map[string]*adminv1.ProjectComputeClassInstance{
+		"large": {Price: &adminv1.ComputeClassPrice{CPU: 20, Memory: 4}},
+		"small": {},
+	}
+
+	app := testApp()
+	estimate := &apiv1.AppEstimate{}
+	err := addWorkloads(estimate, app, func(name string) (*adminv1.ProjectComputeClassInstance, error) {
+		return prices[name], nil
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	err = addVolumes(estimate, app, map[string]adminv1.ProjectVolumeClassInstance{
+		"fast": {Price: &adminv1.VolumeClassPrice{Storage: 0.1}},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, []apiv1.WorkloadEstimate{
+		{
+			Name:         "web",
+			ComputeClass: "large",
+			Replicas:     2,
+			CPU:          1000,
+			Memory:       1 << 30,
+			MonthlyCost:  z.Pointer(48.0),
+		},
+		{
+			Name:         "proxy",
+			ComputeClass: "small",
+			Replicas:     2,
+			CPU:          100,
+			Memory:       128 << 20,
+		},
+		{
+			Name:         "worker",
+			ComputeClass: "small",
+			Replicas:     3,
+			CPU:          100,
+			Memory:       128 << 20,
+		},
+	}, estimate.Workloads)
+
+	// The volume bound to an existing volume is not counted
+	assert.Equal(t, []apiv1.VolumeEstimate{
+		{
+			Name:        "data",
+			VolumeClass: "fast",
+			Size:        10 << 30,
+			MonthlyCost: z.Pointer(1.0),
+		},
+	}, estimate.Volumes)
+
+	assert.Equal(t, apiv1.ResourceEstimate{
+		CPU:         2500,
+		Memory:      2<<30 + 5*128<<20,
+		Storage:     10 << 30,
+		MonthlyCost: z.Pointer(49.0),
+	}, estimate.Total)
+}
+
+func TestQuotaEstimate(t *testing.T) {
+	app := testApp()
+	app.Name, app.Namespace = "app", "acorn"
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(&v1.ProjectInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "acorn",
+			Annotations: map[string]string{labels.ProjectEnforcedQuotaAnnotation: "true"},
+		},
+	}).Build()
+
+	result, err := quotaEstimate(context.Background(), c, app)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	requested := result.Requested
+	assert.True(t, result.Enforced)
+	assert.Equal(t, 5, requested.Containers)
+	assert.Equal(t, 1, requested.Jobs)
+	assert.Equal(t, 2, requested.Volumes)
+	assert.Equal(t, 2, requested.Secrets)
+	assert.True(t, requested.ComputeClasses["large"].CPU.Equal(resource.MustParse("2")))
+	assert.True(t, requested.ComputeClasses["small"].Memory.Equal(resource.MustParse("512Mi")))
+	assert.True(t, requested.VolumeClasses["fast"].VolumeStorage.Equal(resource.MustParse("10Gi")))
+}
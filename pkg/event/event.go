@@ -42,6 +42,20 @@ func NewRecorder(c kclient.Client) RecorderFunc {
 	}
 }
 
+// NewInstanceRecorder returns a Recorder that stores events as EventInstances with c directly, for recording events
+// where the API server is not in the path, like in controllers.
+func NewInstanceRecorder(c kclient.Client) RecorderFunc {
+	return func(ctx context.Context, e *apiv1.Event) error {
+		id, err := ContentID(e)
+		if err != nil {
+			return fmt.Errorf("failed to generate event name from content: %w", err)
+		}
+		e.Name = id
+
+		return c.Create(ctx, (*internalv1.EventInstance)(e))
+	}
+}
+
 var (
 	scheme = runtime.NewScheme()
 )
@@ -0,0 +1,117 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/acorn-io/baaah/pkg/typed"
+	"github.com/acorn-io/baaah/pkg/yaml"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"k8s.io/apimachinery/pkg/runtime"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	kyaml "sigs.k8s.io/yaml"
+)
+
+// Chart describes the Helm chart an app is exported to. The version defaults to 0.1.0.
+type Chart struct {
+	Name       string
+	Version    string
+	AppVersion string
+	DeployArgs map[string]any
+}
+
+// YAML returns the objects as a stream of YAML documents.
+func (r *Result) YAML() ([]byte, error) {
+	return yaml.Export(scheme.Scheme, typed.MapSlice(r.Objects, func(obj kclient.Object) runtime.Object {
+		return obj
+	})...)
+}
+
+// WriteChart writes the objects as a Helm chart to the directory. The templates are the rendered objects and take no
+// values, since the deploy args are evaluated by the Acornfile and not by Helm. The deploy args the app was rendered with
+// are recorded in an annotation of the chart instead. The notes are shown when the chart is installed.
+func (r *Result) WriteChart(dir string, chart Chart) error {
+	if err := os.MkdirAll(filepath.Join(dir, "templates"), 0755); err != nil {
+		return err
+	}
+
+	version := chart.Version
+	if version == "" {
+		version = "0.1.0"
+	}
+	chartYAML := map[string]any{
+		"apiVersion":  "v2",
+		"name":        chart.Name,
+		"description": fmt.Sprintf("Exported from the Acorn app %s", chart.Name),
+		"type":        "application",
+		"version":     version,
+		"appVersion":  chart.AppVersion,
+	}
+	if len(chart.DeployArgs) > 0 {
+		args, err := json.Marshal(chart.DeployArgs)
+		if err != nil {
+			return err
+		}
+		chartYAML["annotations"] = map[string]string{
+			labels.AcornDeployArgs: string(args),
+		}
+	}
+	if err := writeYAML(filepath.Join(dir, "Chart.yaml"), chartYAML); err != nil {
+		return err
+	}
+
+	values := fmt.Sprintf("# The templates of this chart take no values. They were rendered with the deploy args in the %s\n"+
+		"# annotation of Chart.yaml, export the app again to change them.\n", labels.AcornDeployArgs)
+	if err := os.WriteFile(filepath.Join(dir, "values.yaml"), []byte(values), 0644); err != nil {
+		return err
+	}
+
+	seen := map[string]bool{}
+	for _, obj := range r.Objects {
+		data, err := yaml.Export(scheme.Scheme, obj)
+		if err != nil {
+			return err
+		}
+		gvk, err := apiutil.GVKForObject(obj, scheme.Scheme)
+		if err != nil {
+			return err
+		}
+		file := strings.ToLower(gvk.Kind) + "-" + obj.GetName()
+		if seen[file] {
+			// Objects of the same kind and name live in different namespaces
+			file += "-" + obj.GetNamespace()
+		}
+		seen[file] = true
+		file = filepath.Join(dir, "templates", file+".yaml")
+		if err := os.WriteFile(file, escapeTemplate(data), 0644); err != nil {
+			return err
+		}
+	}
+
+	if len(r.Notes) == 0 {
+		return nil
+	}
+	notes := "Some features of the app were not exported:\n"
+	for _, note := range r.Notes {
+		notes += "- " + note + "\n"
+	}
+	return os.WriteFile(filepath.Join(dir, "templates", "NOTES.txt"), escapeTemplate([]byte(notes)), 0644)
+}
+
+// escapeTemplate quotes the template delimiters in the data, so that Helm writes it as is.
+func escapeTemplate(data []byte) []byte {
+	return []byte(strings.ReplaceAll(string(data), "{{", "{{`{{`}}"))
+}
+
+func writeYAML(file string, obj any) error {
+	data, err := kyaml.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, data, 0644)
+}
@@ -0,0 +1,172 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/typed"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/controller/appdefinition"
+	"github.com/acorn-io/runtime/pkg/controller/service"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/acorn-io/runtime/pkg/tags"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// Result is what an app is exported to.
+type Result struct {
+	// Objects are the Kubernetes objects that run the app
+	Objects []kclient.Object
+	// Notes describe the features of the app that could not be exported
+	Notes []string
+}
+
+// App renders the Kubernetes objects of the app without a cluster, by running the handlers of the controller against
+// an empty client. The objects are placed in the namespace given, or the namespace of the app if it is empty.
+func App(ctx context.Context, app *apiv1.App, namespace string) (*Result, error) {
+	appInstance := apiv1.AppToAppInstance(app.DeepCopy())
+	if tags.SHAPattern.MatchString(appInstance.Status.AppImage.ID) {
+		return nil, fmt.Errorf("the image of app %s is only stored in the internal registry, tag and push it to a registry and update the app to use it before exporting", app.Name)
+	}
+	if namespace != "" {
+		appInstance.Status.Namespace = namespace
+	}
+	// Conditions are recomputed below, so don't report the state of the cluster the app was read from
+	appInstance.Status.Conditions = nil
+
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	result := &Result{
+		Notes: notes(appInstance),
+	}
+
+	objs, err := handle(ctx, c, appInstance, appdefinition.DeploySpec)
+	if err != nil {
+		return nil, err
+	}
+	if cond := appInstance.Status.Condition(v1.AppInstanceConditionDefined); cond.Error {
+		result.Notes = append(result.Notes, fmt.Sprintf("some values could not be rendered: %s", cond.Message))
+	}
+
+	for _, obj := range objs {
+		switch o := obj.(type) {
+		case *v1.ServiceInstance:
+			svcObjs, err := handle(ctx, c, o, service.RenderServices)
+			if err != nil {
+				return nil, err
+			}
+			result.add(svcObjs)
+		case *v1.AppInstance:
+			if o != appInstance {
+				result.Notes = append(result.Notes, fmt.Sprintf("nested acorn %s is not exported, export it separately", o.Name))
+			}
+		case *corev1.Secret:
+			if o.Labels[labels.AcornPullSecret] == "true" {
+				result.Notes = append(result.Notes, fmt.Sprintf("registry credentials are not exported, create the image pull secret %s if the images are in a private registry", o.Name))
+				continue
+			}
+			result.add([]kclient.Object{obj})
+		default:
+			result.add([]kclient.Object{obj})
+		}
+	}
+
+	cfg, err := config.Get(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	result.Notes = append(result.Notes, generatedHostnames(result.Objects, cfg.ClusterDomains)...)
+
+	return result, nil
+}
+
+// add appends the Kubernetes objects, skipping the objects that only the Acorn controller understands.
+func (r *Result) add(objs []kclient.Object) {
+	for _, obj := range objs {
+		gvk, err := apiutil.GVKForObject(obj, scheme.Scheme)
+		if err == nil && gvk.Group == v1.SchemeGroupVersion.Group {
+			continue
+		}
+		r.Objects = append(r.Objects, obj)
+	}
+}
+
+// generatedHostnames returns a note for every hostname of the ingresses that is generated by Acorn DNS.
+func generatedHostnames(objs []kclient.Object, clusterDomains []string) (result []string) {
+	for _, obj := range objs {
+		ingress, ok := obj.(*netv1.Ingress)
+		if !ok {
+			continue
+		}
+		for _, rule := range ingress.Spec.Rules {
+			for _, domain := range clusterDomains {
+				if strings.HasSuffix(rule.Host, domain) {
+					result = append(result, fmt.Sprintf("hostname %s is generated by Acorn DNS and will not resolve, publish the port with a hostname of your own", rule.Host))
+					break
+				}
+			}
+		}
+	}
+	return result
+}
+
+// notes returns the features of the app that only work when it is run by Acorn.
+func notes(appInstance *v1.AppInstance) (result []string) {
+	if appInstance.Spec.GetAutoUpgrade() || appInstance.Spec.GetNotifyUpgrade() {
+		result = append(result, "auto-upgrade is not exported, the images of the app are pinned to the versions currently running")
+	}
+
+	for _, entry := range typed.Sorted(appInstance.Status.AppSpec.Secrets) {
+		if entry.Value.External != "" {
+			continue
+		}
+		result = append(result, fmt.Sprintf("secret %s (%s) is generated by Acorn and is not exported, create it before applying the objects", entry.Key, entry.Value.Type))
+	}
+
+	return result
+}
+
+// handle runs the handler for the object and returns the objects it creates.
+func handle(ctx context.Context, c kclient.Client, obj kclient.Object, handler router.HandlerFunc) ([]kclient.Object, error) {
+	gvk, err := apiutil.GVKForObject(obj, scheme.Scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &response{}
+	err = handler(router.Request{
+		Client:    c,
+		Object:    obj,
+		Ctx:       ctx,
+		GVK:       gvk,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+		Key:       router.Key(obj.GetNamespace(), obj.GetName()).String(),
+	}, resp)
+	return resp.objects, err
+}
+
+type response struct {
+	objects []kclient.Object
+}
+
+func (r *response) Attributes() map[string]any {
+	return map[string]any{}
+}
+
+func (r *response) DisablePrune() {}
+
+func (r *response) RetryAfter(time.Duration) {}
+
+func (r *response) Objects(objs ...kclient.Object) {
+	r.objects = append(r.objects, objs...)
+}
@@ -0,0 +1,122 @@
+package export
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/acorn-io/z"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+func testApp() *apiv1.App {
+	return &apiv1.App{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web",
+			Namespace: "acorn",
+			UID:       "1234567890abcdef",
+		},
+		Spec: v1.AppInstanceSpec{
+			Image:       "ghcr.io/acme/web:v1",
+			AutoUpgrade: z.Pointer(true),
+		},
+		Status: apiv1.AppStatus{
+			Namespace: "web-app",
+			AppImage: v1.AppImage{
+				ID: "ghcr.io/acme/web:v1",
+			},
+			AppSpec: v1.AppSpec{
+				Containers: map[string]v1.Container{
+					"web": {
+						Image: "nginx",
+						Ports: []v1.PortDef{{Port: 80, TargetPort: 8080, Protocol: v1.ProtocolHTTP, Publish: true}},
+						Environment: []v1.EnvVar{
+							{Name: "GREETING", Value: "{{ hello }}"},
+						},
+					},
+				},
+				Secrets: map[string]v1.Secret{
+					"token": {Type: "token"},
+				},
+			},
+		},
+	}
+}
+
+func TestApp(t *testing.T) {
+	result, err := App(context.Background(), testApp(), "prod")
+	require.NoError(t, err)
+
+	var objs []string
+	for _, obj := range result.Objects {
+		gvk, err := apiutil.GVKForObject(obj, scheme.Scheme)
+		require.NoError(t, err)
+		objs = append(objs, gvk.Kind+" "+obj.GetNamespace()+"/"+obj.GetName())
+	}
+	assert.Contains(t, objs, "Deployment prod/web")
+	assert.Contains(t, objs, "Service prod/web")
+	assert.Contains(t, objs, "Ingress prod/web-acorn-domain")
+	for _, obj := range objs {
+		// Objects only understood by the Acorn controller and pull secrets are not exported
+		assert.NotContains(t, obj, "ServiceInstance")
+		assert.NotContains(t, obj, "-pull-")
+	}
+
+	assert.Equal(t, []string{
+		"auto-upgrade is not exported, the images of the app are pinned to the versions currently running",
+		"secret token (token) is generated by Acorn and is not exported, create it before applying the objects",
+		"registry credentials are not exported, create the image pull secret web-pull-1234567890ab if the images are in a private registry",
+		"hostname web-web-ca1b7aca.local.oss-acorn.io is generated by Acorn DNS and will not resolve, publish the port with a hostname of your own",
+	}, result.Notes)
+}
+
+func TestAppInternalImage(t *testing.T) {
+	app := testApp()
+	app.Status.AppImage.ID = "2f5ee1b6c3a0d6b4b5b0e2b1f7b5c2d1e3f4a5b6c7d8e9f0a1b2c3d4e5f6a7b8"
+	_, err := App(context.Background(), app, "")
+	assert.ErrorContains(t, err, "only stored in the internal registry")
+}
+
+func TestWriteChart(t *testing.T) {
+	result, err := App(context.Background(), testApp(), "")
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	require.NoError(t, result.WriteChart(dir, Chart{
+		Name:       "web",
+		AppVersion: "ghcr.io/acme/web:v1",
+		DeployArgs: map[string]any{"replicas": 2},
+	}))
+
+	chart, err := os.ReadFile(filepath.Join(dir, "Chart.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, `annotations:
+  acorn.io/deploy-args: '{"replicas":2}'
+apiVersion: v2
+appVersion: ghcr.io/acme/web:v1
+description: Exported from the Acorn app web
+name: web
+type: application
+version: 0.1.0
+`, string(chart))
+
+	values, err := os.ReadFile(filepath.Join(dir, "values.yaml"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(values), "replicas")
+
+	deployment, err := os.ReadFile(filepath.Join(dir, "templates", "deployment-web.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(deployment), "namespace: web-app")
+	assert.Contains(t, string(deployment), "value: '{{`{{`}} hello }}'")
+
+	notes, err := os.ReadFile(filepath.Join(dir, "templates", "NOTES.txt"))
+	require.NoError(t, err)
+	assert.Contains(t, string(notes), "- auto-upgrade is not exported")
+}
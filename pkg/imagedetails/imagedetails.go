@@ -131,7 +131,7 @@ func getImageDetails(ctx context.Context, c kclient.Client, namespace, imageName
 		}, nil
 	}
 
-	permissions := getPermissions(details.AppSpec)
+	permissions := Permissions(details.AppSpec)
 
 	var nestedImages []apiv1.NestedImage
 	if opts.IncludeNested {
@@ -175,8 +175,8 @@ func getNested(ctx context.Context, c kclient.Client, namespace, image string, a
 	return
 }
 
-// getPermissions extracts requested permissions from all containers, jobs, services and nested acorns in the app
-func getPermissions(appSpec *v1.AppSpec) (result []v1.Permissions) {
+// Permissions extracts requested permissions from all containers, jobs, services and nested acorns in the app
+func Permissions(appSpec *v1.AppSpec) (result []v1.Permissions) {
 	result = append(result, containerPermissions(appSpec.Containers)...)
 	result = append(result, containerPermissions(appSpec.Functions)...)
 	result = append(result, containerPermissions(appSpec.Jobs)...)
@@ -196,6 +196,10 @@ func Install(ctx context.Context, image string, opts *Options) error {
 		return err
 	}
 
+	if err = publish.ValidateBackend(finalConfForValidation); err != nil {
+		return err
+	}
+
 	if err = system.ValidateResources(
 		*finalConfForValidation.ControllerMemory, *finalConfForValidation.ControllerCPU,
 		*finalConfForValidation.APIServerMemory, *finalConfForValidation.APIServerCPU,
@@ -236,7 +240,7 @@ func Install(ctx context.Context, image string, opts *Options) error {
 	if ok, err := config.IsDockerDesktop(ctx, c); err != nil {
 		return err
 	} else if ok {
-		if finalConfForValidation.IngressClassName == nil {
+		if finalConfForValidation.IngressClassName == nil && !publish.UsesGateway(finalConfForValidation) {
 			installIngressController, err = missingIngressClass(ctx, c)
 			if err != nil {
 				return err
@@ -0,0 +1,64 @@
+package install
+
+import (
+	"testing"
+
+	"github.com/acorn-io/runtime/pkg/publish"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiextensionv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestTraefikResources(t *testing.T) {
+	objs, err := TraefikResources()
+	require.NoError(t, err)
+
+	var (
+		dep  appsv1.Deployment
+		role rbacv1.ClusterRole
+		crds = map[string]apiextensionv1.CustomResourceDefinition{}
+	)
+	for _, obj := range objs {
+		u, ok := obj.(*unstructured.Unstructured)
+		require.True(t, ok)
+
+		switch u.GetKind() {
+		case "Deployment":
+			require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &dep))
+		case "ClusterRole":
+			require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &role))
+		case "CustomResourceDefinition":
+			var crd apiextensionv1.CustomResourceDefinition
+			require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &crd))
+			crds[crd.Name] = crd
+		}
+	}
+
+	// The middlewares of port policies are only loaded by the kubernetescrd provider, which must not let an app
+	// reference the middlewares of another namespace.
+	require.Len(t, dep.Spec.Template.Spec.Containers, 1)
+	args := dep.Spec.Template.Spec.Containers[0].Args
+	assert.Contains(t, args, "--providers.kubernetescrd")
+	assert.Contains(t, args, "--providers.kubernetescrd.allowcrossnamespace=false")
+
+	middleware, ok := crds["middlewares."+publish.TraefikMiddlewareGVK.Group]
+	require.True(t, ok)
+	assert.Equal(t, publish.TraefikMiddlewareGVK.Kind, middleware.Spec.Names.Kind)
+	require.Len(t, middleware.Spec.Versions, 1)
+	assert.Equal(t, publish.TraefikMiddlewareGVK.Version, middleware.Spec.Versions[0].Name)
+
+	// The provider waits for all of the traefik.io resources it watches, so each of them needs a CRD
+	for _, rule := range role.Rules {
+		if !assert.ObjectsAreEqual(rule.APIGroups, []string{"traefik.io", "traefik.containo.us"}) {
+			continue
+		}
+		for _, resource := range rule.Resources {
+			assert.Contains(t, crds, resource+".traefik.io")
+		}
+	}
+	assert.Len(t, crds, 9)
+}
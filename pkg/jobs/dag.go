@@ -0,0 +1,65 @@
+package jobs
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/acorn-io/baaah/pkg/typed"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+)
+
+// Steps returns the step of each job in the order the jobs run in given their dependencies on other jobs, starting at 1,
+// and the number of steps. A job that depends on no other job is in the first step, any other job is in the step after
+// the last of the jobs it depends on. An error is returned if the dependencies of the jobs form a cycle.
+func Steps(jobs map[string]v1.Container) (map[string]int, int, error) {
+	var (
+		steps    = make(map[string]int, len(jobs))
+		visiting = map[string]bool{}
+		path     []string
+		maxStep  int
+		visit    func(string) (int, error)
+	)
+
+	visit = func(name string) (int, error) {
+		if step, ok := steps[name]; ok {
+			return step, nil
+		}
+		if visiting[name] {
+			cycle := append(slices.Clone(path[slices.Index(path, name):]), name)
+			return 0, fmt.Errorf("job dependency cycle: %s", strings.Join(cycle, " -> "))
+		}
+
+		visiting[name] = true
+		path = append(path, name)
+		defer func() {
+			visiting[name] = false
+			path = path[:len(path)-1]
+		}()
+
+		step := 1
+		for _, dep := range jobs[name].Dependencies {
+			if _, ok := jobs[dep.TargetName]; !ok {
+				continue
+			}
+			depStep, err := visit(dep.TargetName)
+			if err != nil {
+				return 0, err
+			}
+			step = max(step, depStep+1)
+		}
+
+		steps[name] = step
+		return step, nil
+	}
+
+	for _, name := range typed.SortedKeys(jobs) {
+		step, err := visit(name)
+		if err != nil {
+			return nil, 0, err
+		}
+		maxStep = max(maxStep, step)
+	}
+
+	return steps, maxStep, nil
+}
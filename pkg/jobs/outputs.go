@@ -0,0 +1,129 @@
+package jobs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/acorn-io/aml/pkg/jsonschema"
+	"github.com/acorn-io/baaah/pkg/name"
+	"github.com/acorn-io/baaah/pkg/typed"
+)
+
+// The named outputs of a job are the keys of a JSON object the job writes to OutputsFile. When the job succeeds, the
+// helper container of the job records the file as its termination message, so the outputs are captured even if the pod
+// has already exited by the time they are read. The outputs are validated against the outputSchema of the job, stored
+// on the status of the job and can be referenced from other workloads of the app as @{jobs.<job>.outputs.<key>}.
+const (
+	OutputsFile = "/run/secrets/outputs.json"
+	// MaxOutputsSize is the maximum size of the outputs file in bytes, which is the size limit of a termination message
+	MaxOutputsSize = 4096
+	// OutputsSecretKey is the key of the outputs in the secret they are saved to
+	OutputsSecretKey = "outputs"
+
+	// outputsErrorPrefix is written by the helper container in place of the outputs when they can't be recorded
+	outputsErrorPrefix = "error: "
+)
+
+func GetJobOutputsSecretName(namespace, jobName string) string {
+	return name.SafeHashConcatName(jobName, "outputs", namespace)
+}
+
+// ParseOutputs parses the outputs recorded for a job and validates them against the schema, if not nil. String outputs
+// are returned as is, any other output is returned as JSON.
+func ParseOutputs(data []byte, schema *jsonschema.Schema) (map[string]string, error) {
+	if msg, ok := strings.CutPrefix(string(data), outputsErrorPrefix); ok {
+		return nil, fmt.Errorf("failed to record outputs: %s", strings.TrimSpace(msg))
+	}
+	if len(data) > MaxOutputsSize {
+		return nil, fmt.Errorf("outputs are %d bytes, the limit is %d bytes", len(data), MaxOutputsSize)
+	}
+
+	outputs := map[string]any{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&outputs); err != nil {
+		return nil, fmt.Errorf("outputs in %s must be a JSON object: %w", OutputsFile, err)
+	}
+
+	if schema != nil {
+		if err := validateOutputs(outputs, schema); err != nil {
+			return nil, err
+		}
+	}
+
+	result := make(map[string]string, len(outputs))
+	for key, value := range outputs {
+		if s, ok := value.(string); ok {
+			result[key] = s
+			continue
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		result[key] = string(data)
+	}
+	return result, nil
+}
+
+func validateOutputs(outputs map[string]any, schema *jsonschema.Schema) error {
+	for _, key := range schema.Required {
+		if _, ok := outputs[key]; !ok {
+			return fmt.Errorf("missing required output [%s]", key)
+		}
+	}
+	for _, key := range typed.SortedKeys(outputs) {
+		property, ok := schema.Properties[key]
+		if !ok {
+			if !schema.AdditionalProperties && len(schema.Properties) > 0 {
+				return fmt.Errorf("output [%s] is not defined in the output schema", key)
+			}
+			continue
+		}
+		if err := validateType(outputs[key], property); err != nil {
+			return fmt.Errorf("invalid output [%s]: %w", key, err)
+		}
+	}
+	return nil
+}
+
+func validateType(value any, property jsonschema.Property) error {
+	var ok bool
+	switch property.Type {
+	case "":
+		return nil
+	case "string":
+		_, ok = value.(string)
+	case "number":
+		_, ok = value.(json.Number)
+	case "integer":
+		var n json.Number
+		if n, ok = value.(json.Number); ok {
+			_, err := n.Int64()
+			ok = err == nil
+		}
+	case "boolean":
+		_, ok = value.(bool)
+	case "object":
+		_, ok = value.(map[string]any)
+	case "array":
+		var items []any
+		if items, ok = value.([]any); ok && len(property.Items) > 0 {
+			for i, item := range items {
+				if err := validateType(item, property.Items[0].Property); err != nil {
+					return fmt.Errorf("item %d: %w", i, err)
+				}
+			}
+		}
+	case "null":
+		ok = value == nil
+	default:
+		return fmt.Errorf("unsupported type [%s] in output schema", property.Type)
+	}
+	if !ok {
+		return fmt.Errorf("expected %s", property.Type)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/acorn-io/aml/pkg/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOutputs(t *testing.T) {
+	schema := &jsonschema.Schema{
+		Required: []string{"url"},
+		Properties: map[string]jsonschema.Property{
+			"url":   {Type: "string"},
+			"port":  {Type: "integer"},
+			"ready": {Type: "boolean"},
+			"tags":  {Type: "array", Items: []jsonschema.Schema{{Property: jsonschema.Property{Type: "string"}}}},
+		},
+	}
+
+	outputs, err := ParseOutputs([]byte(`{"url": "https://example.com", "port": 8080, "ready": true, "tags": ["a", "b"]}`), schema)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"url":   "https://example.com",
+		"port":  "8080",
+		"ready": "true",
+		"tags":  `["a","b"]`,
+	}, outputs)
+
+	tests := []struct {
+		name        string
+		data        string
+		expectError string
+	}{
+		{name: "not an object", data: `["url"]`, expectError: "must be a JSON object"},
+		{name: "missing required", data: `{"port": 8080}`, expectError: "missing required output [url]"},
+		{name: "wrong type", data: `{"url": "x", "port": "8080"}`, expectError: "invalid output [port]: expected integer"},
+		{name: "not an integer", data: `{"url": "x", "port": 80.5}`, expectError: "invalid output [port]: expected integer"},
+		{name: "wrong item type", data: `{"url": "x", "tags": [1]}`, expectError: "invalid output [tags]: item 0: expected string"},
+		{name: "undefined", data: `{"url": "x", "other": "y"}`, expectError: "output [other] is not defined"},
+		{name: "helper error", data: "error: outputs.json is 5000 bytes, the limit is 4096 bytes\n", expectError: "failed to record outputs: outputs.json is 5000 bytes"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseOutputs([]byte(tt.data), schema)
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.expectError)
+			}
+		})
+	}
+
+	// Without a schema any JSON object is accepted
+	outputs, err = ParseOutputs([]byte(`{"other": {"nested": 1}}`), nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"other": `{"nested":1}`}, outputs)
+}
@@ -0,0 +1,72 @@
+package jobs
+
+import (
+	"strconv"
+	"time"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// DefaultRetries is the number of times a failed job without a schedule is retried if it does not set retries
+	DefaultRetries = 1000
+	// DefaultScheduledRetries is the number of times a failed run of a scheduled job is retried if it does not set
+	// retries, which is the default backoff limit of Kubernetes jobs
+	DefaultScheduledRetries = 6
+	// DefaultBackoff is the backoff of a job that sets a timeout but no backoff, which matches the initial backoff of
+	// the Kubernetes job controller
+	DefaultBackoff = "10s"
+
+	maxBackoff = 10 * time.Minute
+)
+
+// Retries returns the number of times a failed run of the job is retried.
+func Retries(container v1.Container) int32 {
+	if container.Retries != nil {
+		return *container.Retries
+	}
+	if container.Schedule != "" {
+		return DefaultScheduledRetries
+	}
+	return DefaultRetries
+}
+
+// RecreatedPerAttempt returns whether each attempt of the job is run as a new Kubernetes job instead of by the
+// Kubernetes job controller. This is the case for jobs with a backoff, and for jobs with a timeout so that the deadline
+// of the Kubernetes job applies to a single attempt.
+func RecreatedPerAttempt(container v1.Container) bool {
+	return container.Backoff != "" || container.Timeout != ""
+}
+
+// Backoff returns how long to wait after the given failed attempt before the next attempt, which is the base backoff
+// doubled for each attempt before it, up to ten minutes.
+func Backoff(base time.Duration, attempt int) time.Duration {
+	wait := base
+	for i := 1; i < attempt && wait < maxBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, maxBackoff)
+}
+
+// Attempt returns the number of the attempt the job is, which is only greater than one for jobs that are retried with a
+// backoff.
+func Attempt(job *batchv1.Job) int {
+	attempt, err := strconv.Atoi(job.Annotations[labels.AcornJobAttempt])
+	if err != nil || attempt < 1 {
+		return 1
+	}
+	return attempt
+}
+
+// FailedTime returns the time the job failed, or false if it has not failed.
+func FailedTime(job *batchv1.Job) (time.Time, bool) {
+	for _, cond := range job.Status.Conditions {
+		if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
+			return cond.LastTransitionTime.Time, true
+		}
+	}
+	return time.Time{}, false
+}
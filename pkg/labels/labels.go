@@ -26,16 +26,29 @@ const (
 	AcornAppUID                            = Prefix + "app-uid"
 	AcornVolumeName                        = Prefix + "volume-name"
 	AcornVolumeClass                       = Prefix + "volume-class"
+	AcornVolumeSnapshotName                = Prefix + "volume-snapshot-name"
+	AcornVolumeBackup                      = Prefix + "volume-backup"
 	AcornSecretName                        = Prefix + "secret-name"
 	AcornSecretSourceNamespace             = Prefix + "secret-source-namespace"
 	AcornSecretSourceName                  = Prefix + "secret-source-name"
 	AcornSecretGenerated                   = Prefix + "secret-generated"
+	AcornSecretRotatedAt                   = Prefix + "secret-rotated-at"
+	AcornEncryptionKeyPrimary              = Prefix + "encryption-key-primary"
 	AcornContainerName                     = Prefix + "container-name"
 	AcornFunctionName                      = Prefix + "function-name"
 	AcornRouterName                        = Prefix + "router-name"
+	AcornActivator                         = Prefix + "activator"
+	AcornColdStartTime                     = Prefix + "cold-start-time"
+	AcornColdStartDuration                 = Prefix + "cold-start-duration"
+	AcornScaledToZeroTime                  = Prefix + "scaled-to-zero-time"
 	AcornJobName                           = Prefix + "job-name"
+	AcornJobRetries                        = Prefix + "job-retries"
+	AcornJobBackoff                        = Prefix + "job-backoff"
+	AcornJobAttempt                        = Prefix + "job-attempt"
+	AcornJobNextAttempt                    = Prefix + "job-next-attempt"
 	AcornAppImage                          = Prefix + "app-image"
 	AcornAppDevHash                        = Prefix + "app-dev-hash"
+	AcornDeployArgs                        = Prefix + "deploy-args"
 	AcornManaged                           = Prefix + "managed"
 	AcornContainerSpec                     = Prefix + "container-spec"
 	AcornImageMapping                      = Prefix + "image-mapping"
@@ -46,6 +59,7 @@ const (
 	AcornSecretRevPrefix                   = "secret-rev." + Prefix
 	AcornPublishURL                        = Prefix + "publish-url"
 	AcornTargets                           = Prefix + "targets"
+	AcornGatewayCertificate                = Prefix + "gateway-certificate"
 	AcornDNSHash                           = Prefix + "dns-hash"
 	AcornLinkName                          = Prefix + "link-name"
 	AcornDNSState                          = Prefix + "applied-dns-state"
@@ -63,6 +77,10 @@ const (
 	AcornPermissions                       = Prefix + "permissions"
 	AcornConfigHashAnnotation              = Prefix + "config-hash"
 	AcornContainerResolvedOfferings        = Prefix + "container-resolved-offerings"
+	AcornRolloutTrack                      = Prefix + "rollout-track"
+	AcornRolloutHash                       = Prefix + "rollout-hash"
+	AcornTraceParent                       = Prefix + "traceparent"
+	AcornApplied                           = Prefix + "applied"
 
 	IdentityPrefix                = "identity." + Prefix
 	AcornIdentityAccountServerURL = IdentityPrefix + "account-server-url"
@@ -128,6 +128,21 @@ func (mr *MockClientMockRecorder) AppDelete(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppDelete", reflect.TypeOf((*MockClient)(nil).AppDelete), arg0, arg1)
 }
 
+// AppEstimate mocks base method.
+func (m *MockClient) AppEstimate(arg0 context.Context, arg1 string, arg2 *v10.AppInstanceSpec) (*v1.AppEstimate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppEstimate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1.AppEstimate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppEstimate indicates an expected call of AppEstimate.
+func (mr *MockClientMockRecorder) AppEstimate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppEstimate", reflect.TypeOf((*MockClient)(nil).AppEstimate), arg0, arg1, arg2)
+}
+
 // AppGet mocks base method.
 func (m *MockClient) AppGet(arg0 context.Context, arg1 string) (*v1.App, error) {
 	m.ctrl.T.Helper()
@@ -216,6 +231,55 @@ func (mr *MockClientMockRecorder) AppPullImage(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppPullImage", reflect.TypeOf((*MockClient)(nil).AppPullImage), arg0, arg1)
 }
 
+// AppRevisionList mocks base method.
+func (m *MockClient) AppRevisionList(arg0 context.Context, arg1 string) ([]v1.AppRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppRevisionList", arg0, arg1)
+	ret0, _ := ret[0].([]v1.AppRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppRevisionList indicates an expected call of AppRevisionList.
+func (mr *MockClientMockRecorder) AppRevisionList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppRevisionList", reflect.TypeOf((*MockClient)(nil).AppRevisionList), arg0, arg1)
+}
+
+// AppRollback mocks base method.
+func (m *MockClient) AppRollback(arg0 context.Context, arg1 string, arg2 int64) (*v1.App, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppRollback", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1.App)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppRollback indicates an expected call of AppRollback.
+func (mr *MockClientMockRecorder) AppRollback(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppRollback", reflect.TypeOf((*MockClient)(nil).AppRollback), arg0, arg1, arg2)
+}
+
+// AppRollout mocks base method.
+func (m *MockClient) AppRollout(arg0 context.Context, arg1 string, arg2 v10.RolloutAction, arg3 ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AppRollout", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppRollout indicates an expected call of AppRollout.
+func (mr *MockClientMockRecorder) AppRollout(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppRollout", reflect.TypeOf((*MockClient)(nil).AppRollout), varargs...)
+}
+
 // AppRun mocks base method.
 func (m *MockClient) AppRun(arg0 context.Context, arg1 string, arg2 *client.AppRunOptions) (*v1.App, error) {
 	m.ctrl.T.Helper()
@@ -914,6 +978,51 @@ func (mr *MockClientMockRecorder) SecretGet(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretGet", reflect.TypeOf((*MockClient)(nil).SecretGet), arg0, arg1)
 }
 
+// SecretKeyList mocks base method.
+func (m *MockClient) SecretKeyList(arg0 context.Context) ([]v1.SecretKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecretKeyList", arg0)
+	ret0, _ := ret[0].([]v1.SecretKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecretKeyList indicates an expected call of SecretKeyList.
+func (mr *MockClientMockRecorder) SecretKeyList(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretKeyList", reflect.TypeOf((*MockClient)(nil).SecretKeyList), arg0)
+}
+
+// SecretKeyRetire mocks base method.
+func (m *MockClient) SecretKeyRetire(arg0 context.Context, arg1 string) (*v1.SecretKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecretKeyRetire", arg0, arg1)
+	ret0, _ := ret[0].(*v1.SecretKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecretKeyRetire indicates an expected call of SecretKeyRetire.
+func (mr *MockClientMockRecorder) SecretKeyRetire(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretKeyRetire", reflect.TypeOf((*MockClient)(nil).SecretKeyRetire), arg0, arg1)
+}
+
+// SecretKeyRotate mocks base method.
+func (m *MockClient) SecretKeyRotate(arg0 context.Context) (*v1.SecretKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecretKeyRotate", arg0)
+	ret0, _ := ret[0].(*v1.SecretKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecretKeyRotate indicates an expected call of SecretKeyRotate.
+func (mr *MockClientMockRecorder) SecretKeyRotate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretKeyRotate", reflect.TypeOf((*MockClient)(nil).SecretKeyRotate), arg0)
+}
+
 // SecretList mocks base method.
 func (m *MockClient) SecretList(arg0 context.Context) ([]v1.Secret, error) {
 	m.ctrl.T.Helper()
@@ -1034,6 +1143,66 @@ func (mr *MockClientMockRecorder) VolumeList(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeList", reflect.TypeOf((*MockClient)(nil).VolumeList), arg0)
 }
 
+// VolumeSnapshotCreate mocks base method.
+func (m *MockClient) VolumeSnapshotCreate(arg0 context.Context, arg1, arg2 string) (*v1.VolumeSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeSnapshotCreate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1.VolumeSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeSnapshotCreate indicates an expected call of VolumeSnapshotCreate.
+func (mr *MockClientMockRecorder) VolumeSnapshotCreate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeSnapshotCreate", reflect.TypeOf((*MockClient)(nil).VolumeSnapshotCreate), arg0, arg1, arg2)
+}
+
+// VolumeSnapshotDelete mocks base method.
+func (m *MockClient) VolumeSnapshotDelete(arg0 context.Context, arg1 string) (*v1.VolumeSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeSnapshotDelete", arg0, arg1)
+	ret0, _ := ret[0].(*v1.VolumeSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeSnapshotDelete indicates an expected call of VolumeSnapshotDelete.
+func (mr *MockClientMockRecorder) VolumeSnapshotDelete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeSnapshotDelete", reflect.TypeOf((*MockClient)(nil).VolumeSnapshotDelete), arg0, arg1)
+}
+
+// VolumeSnapshotGet mocks base method.
+func (m *MockClient) VolumeSnapshotGet(arg0 context.Context, arg1 string) (*v1.VolumeSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeSnapshotGet", arg0, arg1)
+	ret0, _ := ret[0].(*v1.VolumeSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeSnapshotGet indicates an expected call of VolumeSnapshotGet.
+func (mr *MockClientMockRecorder) VolumeSnapshotGet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeSnapshotGet", reflect.TypeOf((*MockClient)(nil).VolumeSnapshotGet), arg0, arg1)
+}
+
+// VolumeSnapshotList mocks base method.
+func (m *MockClient) VolumeSnapshotList(arg0 context.Context) ([]v1.VolumeSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeSnapshotList", arg0)
+	ret0, _ := ret[0].([]v1.VolumeSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeSnapshotList indicates an expected call of VolumeSnapshotList.
+func (mr *MockClientMockRecorder) VolumeSnapshotList(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeSnapshotList", reflect.TypeOf((*MockClient)(nil).VolumeSnapshotList), arg0)
+}
+
 // MockProjectClientFactory is a mock of ProjectClientFactory interface.
 type MockProjectClientFactory struct {
 	ctrl     *gomock.Controller
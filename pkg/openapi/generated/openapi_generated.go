@@ -37,9 +37,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.AcornImageBuild":                                      schema_pkg_apis_apiacornio_v1_AcornImageBuild(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.AcornImageBuildList":                                  schema_pkg_apis_apiacornio_v1_AcornImageBuildList(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.App":                                                  schema_pkg_apis_apiacornio_v1_App(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.AppEstimate":                                          schema_pkg_apis_apiacornio_v1_AppEstimate(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.AppInfo":                                              schema_pkg_apis_apiacornio_v1_AppInfo(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.AppList":                                              schema_pkg_apis_apiacornio_v1_AppList(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.AppPullImage":                                         schema_pkg_apis_apiacornio_v1_AppPullImage(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.AppRevision":                                          schema_pkg_apis_apiacornio_v1_AppRevision(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.AppRevisionList":                                      schema_pkg_apis_apiacornio_v1_AppRevisionList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.AppRollout":                                           schema_pkg_apis_apiacornio_v1_AppRollout(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.AppStatus":                                            schema_pkg_apis_apiacornio_v1_AppStatus(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Builder":                                              schema_pkg_apis_apiacornio_v1_Builder(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.BuilderList":                                          schema_pkg_apis_apiacornio_v1_BuilderList(ref),
@@ -64,6 +68,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.EncryptionKey":                                        schema_pkg_apis_apiacornio_v1_EncryptionKey(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Event":                                                schema_pkg_apis_apiacornio_v1_Event(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.EventList":                                            schema_pkg_apis_apiacornio_v1_EventList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.EventSubscription":                                    schema_pkg_apis_apiacornio_v1_EventSubscription(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.EventSubscriptionList":                                schema_pkg_apis_apiacornio_v1_EventSubscriptionList(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.IconOptions":                                          schema_pkg_apis_apiacornio_v1_IconOptions(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.IgnoreCleanup":                                        schema_pkg_apis_apiacornio_v1_IgnoreCleanup(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Image":                                                schema_pkg_apis_apiacornio_v1_Image(ref),
@@ -89,12 +95,16 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.PortForwardOptions":                                   schema_pkg_apis_apiacornio_v1_PortForwardOptions(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Project":                                              schema_pkg_apis_apiacornio_v1_Project(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ProjectList":                                          schema_pkg_apis_apiacornio_v1_ProjectList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.QuotaEstimate":                                        schema_pkg_apis_apiacornio_v1_QuotaEstimate(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Region":                                               schema_pkg_apis_apiacornio_v1_Region(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.RegionList":                                           schema_pkg_apis_apiacornio_v1_RegionList(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.RegionSpec":                                           schema_pkg_apis_apiacornio_v1_RegionSpec(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.RegionStatus":                                         schema_pkg_apis_apiacornio_v1_RegionStatus(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.RegistryAuth":                                         schema_pkg_apis_apiacornio_v1_RegistryAuth(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ResourceEstimate":                                     schema_pkg_apis_apiacornio_v1_ResourceEstimate(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Secret":                                               schema_pkg_apis_apiacornio_v1_Secret(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.SecretKey":                                            schema_pkg_apis_apiacornio_v1_SecretKey(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.SecretKeyList":                                        schema_pkg_apis_apiacornio_v1_SecretKeyList(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.SecretList":                                           schema_pkg_apis_apiacornio_v1_SecretList(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Service":                                              schema_pkg_apis_apiacornio_v1_Service(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ServiceList":                                          schema_pkg_apis_apiacornio_v1_ServiceList(ref),
@@ -103,9 +113,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeClassList":                                      schema_pkg_apis_apiacornio_v1_VolumeClassList(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeColumns":                                        schema_pkg_apis_apiacornio_v1_VolumeColumns(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeCreateOptions":                                  schema_pkg_apis_apiacornio_v1_VolumeCreateOptions(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeEstimate":                                       schema_pkg_apis_apiacornio_v1_VolumeEstimate(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeList":                                           schema_pkg_apis_apiacornio_v1_VolumeList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeSnapshot":                                       schema_pkg_apis_apiacornio_v1_VolumeSnapshot(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeSnapshotList":                                   schema_pkg_apis_apiacornio_v1_VolumeSnapshotList(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeSpec":                                           schema_pkg_apis_apiacornio_v1_VolumeSpec(ref),
 		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeStatus":                                         schema_pkg_apis_apiacornio_v1_VolumeStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.WorkloadEstimate":                                     schema_pkg_apis_apiacornio_v1_WorkloadEstimate(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AWSSecretsManagerSecretBackend":                  schema_pkg_apis_internalacornio_v1_AWSSecretsManagerSecretBackend(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Acorn":                                           schema_pkg_apis_internalacornio_v1_Acorn(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AcornBuild":                                      schema_pkg_apis_internalacornio_v1_AcornBuild(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AcornBuilderSpec":                                schema_pkg_apis_internalacornio_v1_AcornBuilderSpec(ref),
@@ -114,6 +129,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AcornImageBuildInstanceSpec":                     schema_pkg_apis_internalacornio_v1_AcornImageBuildInstanceSpec(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AcornImageBuildInstanceStatus":                   schema_pkg_apis_internalacornio_v1_AcornImageBuildInstanceStatus(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AcornStatus":                                     schema_pkg_apis_internalacornio_v1_AcornStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ActivationStatus":                                schema_pkg_apis_internalacornio_v1_ActivationStatus(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Alias":                                           schema_pkg_apis_internalacornio_v1_Alias(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppColumns":                                      schema_pkg_apis_internalacornio_v1_AppColumns(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppImage":                                        schema_pkg_apis_internalacornio_v1_AppImage(ref),
@@ -122,11 +138,20 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppInstanceList":                                 schema_pkg_apis_internalacornio_v1_AppInstanceList(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppInstanceSpec":                                 schema_pkg_apis_internalacornio_v1_AppInstanceSpec(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppInstanceStatus":                               schema_pkg_apis_internalacornio_v1_AppInstanceStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppPreview":                                      schema_pkg_apis_internalacornio_v1_AppPreview(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppRevisionInstance":                             schema_pkg_apis_internalacornio_v1_AppRevisionInstance(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppRevisionInstanceList":                         schema_pkg_apis_internalacornio_v1_AppRevisionInstanceList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppRevisionInstanceSpec":                         schema_pkg_apis_internalacornio_v1_AppRevisionInstanceSpec(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppSchedule":                                     schema_pkg_apis_internalacornio_v1_AppSchedule(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppScheduleStatus":                               schema_pkg_apis_internalacornio_v1_AppScheduleStatus(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppSpec":                                         schema_pkg_apis_internalacornio_v1_AppSpec(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppStatus":                                       schema_pkg_apis_internalacornio_v1_AppStatus(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppStatusStaged":                                 schema_pkg_apis_internalacornio_v1_AppStatusStaged(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Array":                                           schema_pkg_apis_internalacornio_v1_Array(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Assistant":                                       schema_pkg_apis_internalacornio_v1_Assistant(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Autoscale":                                       schema_pkg_apis_internalacornio_v1_Autoscale(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AutoscaleMetric":                                 schema_pkg_apis_internalacornio_v1_AutoscaleMetric(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AutoscaleStatus":                                 schema_pkg_apis_internalacornio_v1_AutoscaleStatus(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Build":                                           schema_pkg_apis_internalacornio_v1_Build(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.BuildContext":                                    schema_pkg_apis_internalacornio_v1_BuildContext(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.BuildRecord":                                     schema_pkg_apis_internalacornio_v1_BuildRecord(ref),
@@ -157,17 +182,24 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EmbeddedAppStatus":                               schema_pkg_apis_internalacornio_v1_EmbeddedAppStatus(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Endpoint":                                        schema_pkg_apis_internalacornio_v1_Endpoint(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EnvVar":                                          schema_pkg_apis_internalacornio_v1_EnvVar(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventDelivery":                                   schema_pkg_apis_internalacornio_v1_EventDelivery(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventInstance":                                   schema_pkg_apis_internalacornio_v1_EventInstance(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventInstanceList":                               schema_pkg_apis_internalacornio_v1_EventInstanceList(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventResource":                                   schema_pkg_apis_internalacornio_v1_EventResource(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSubscriptionInstance":                       schema_pkg_apis_internalacornio_v1_EventSubscriptionInstance(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSubscriptionInstanceList":                   schema_pkg_apis_internalacornio_v1_EventSubscriptionInstanceList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSubscriptionInstanceSpec":                   schema_pkg_apis_internalacornio_v1_EventSubscriptionInstanceSpec(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSubscriptionInstanceStatus":                 schema_pkg_apis_internalacornio_v1_EventSubscriptionInstanceStatus(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ExecProbe":                                       schema_pkg_apis_internalacornio_v1_ExecProbe(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ExpressionError":                                 schema_pkg_apis_internalacornio_v1_ExpressionError(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Field":                                           schema_pkg_apis_internalacornio_v1_Field(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.FieldType":                                       schema_pkg_apis_internalacornio_v1_FieldType(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.File":                                            schema_pkg_apis_internalacornio_v1_File(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.FileSecretBackend":                               schema_pkg_apis_internalacornio_v1_FileSecretBackend(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.GeneratedService":                                schema_pkg_apis_internalacornio_v1_GeneratedService(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.GenericMap":                                      v1.GenericMap{}.OpenAPIDefinition(),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.HTTPProbe":                                       schema_pkg_apis_internalacornio_v1_HTTPProbe(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.HeaderModifier":                                  schema_pkg_apis_internalacornio_v1_HeaderModifier(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Image":                                           schema_pkg_apis_internalacornio_v1_Image(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImageAllowRuleInstance":                          schema_pkg_apis_internalacornio_v1_ImageAllowRuleInstance(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ImageAllowRuleInstanceList":                      schema_pkg_apis_internalacornio_v1_ImageAllowRuleInstanceList(ref),
@@ -188,8 +220,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions":                                     schema_pkg_apis_internalacornio_v1_Permissions(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Platform":                                        schema_pkg_apis_internalacornio_v1_Platform(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PolicyRule":                                      schema_pkg_apis_internalacornio_v1_PolicyRule(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortAuth":                                        schema_pkg_apis_internalacornio_v1_PortAuth(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortBinding":                                     schema_pkg_apis_internalacornio_v1_PortBinding(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortDef":                                         schema_pkg_apis_internalacornio_v1_PortDef(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortPolicy":                                      schema_pkg_apis_internalacornio_v1_PortPolicy(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortPublish":                                     schema_pkg_apis_internalacornio_v1_PortPublish(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Probe":                                           schema_pkg_apis_internalacornio_v1_Probe(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Profile":                                         schema_pkg_apis_internalacornio_v1_Profile(ref),
@@ -197,14 +231,21 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ProjectInstanceList":                             schema_pkg_apis_internalacornio_v1_ProjectInstanceList(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ProjectInstanceSpec":                             schema_pkg_apis_internalacornio_v1_ProjectInstanceSpec(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ProjectInstanceStatus":                           schema_pkg_apis_internalacornio_v1_ProjectInstanceStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RateLimit":                                       schema_pkg_apis_internalacornio_v1_RateLimit(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ReplicasSummary":                                 schema_pkg_apis_internalacornio_v1_ReplicasSummary(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ResolvedOfferings":                               schema_pkg_apis_internalacornio_v1_ResolvedOfferings(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Rollout":                                         schema_pkg_apis_internalacornio_v1_Rollout(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RolloutStatus":                                   schema_pkg_apis_internalacornio_v1_RolloutStatus(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RolloutStep":                                     schema_pkg_apis_internalacornio_v1_RolloutStep(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Route":                                           schema_pkg_apis_internalacornio_v1_Route(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RouteMatch":                                      schema_pkg_apis_internalacornio_v1_RouteMatch(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RouteTarget":                                     schema_pkg_apis_internalacornio_v1_RouteTarget(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Router":                                          schema_pkg_apis_internalacornio_v1_Router(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RouterStatus":                                    schema_pkg_apis_internalacornio_v1_RouterStatus(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Scheduling":                                      schema_pkg_apis_internalacornio_v1_Scheduling(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ScopedLabel":                                     schema_pkg_apis_internalacornio_v1_ScopedLabel(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Secret":                                          schema_pkg_apis_internalacornio_v1_Secret(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretBackend":                                   schema_pkg_apis_internalacornio_v1_SecretBackend(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretBinding":                                   schema_pkg_apis_internalacornio_v1_SecretBinding(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretReference":                                 schema_pkg_apis_internalacornio_v1_SecretReference(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretStatus":                                    schema_pkg_apis_internalacornio_v1_SecretStatus(ref),
@@ -220,14 +261,21 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignatureRules":                                  schema_pkg_apis_internalacornio_v1_SignatureRules(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SignedBy":                                        schema_pkg_apis_internalacornio_v1_SignedBy(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.TCPProbe":                                        schema_pkg_apis_internalacornio_v1_TCPProbe(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Usage":                                           schema_pkg_apis_internalacornio_v1_Usage(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.UserContext":                                     schema_pkg_apis_internalacornio_v1_UserContext(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VCS":                                             schema_pkg_apis_internalacornio_v1_VCS(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VaultSecretBackend":                              schema_pkg_apis_internalacornio_v1_VaultSecretBackend(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeBackup":                                    schema_pkg_apis_internalacornio_v1_VolumeBackup(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeBinding":                                   schema_pkg_apis_internalacornio_v1_VolumeBinding(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeDefault":                                   schema_pkg_apis_internalacornio_v1_VolumeDefault(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeMount":                                     schema_pkg_apis_internalacornio_v1_VolumeMount(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeRequest":                                   schema_pkg_apis_internalacornio_v1_VolumeRequest(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeResolvedOffering":                          schema_pkg_apis_internalacornio_v1_VolumeResolvedOffering(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSecretMount":                               schema_pkg_apis_internalacornio_v1_VolumeSecretMount(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSnapshotInstance":                          schema_pkg_apis_internalacornio_v1_VolumeSnapshotInstance(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSnapshotInstanceList":                      schema_pkg_apis_internalacornio_v1_VolumeSnapshotInstanceList(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSnapshotInstanceSpec":                      schema_pkg_apis_internalacornio_v1_VolumeSnapshotInstanceSpec(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSnapshotInstanceStatus":                    schema_pkg_apis_internalacornio_v1_VolumeSnapshotInstanceStatus(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeStatus":                                    schema_pkg_apis_internalacornio_v1_VolumeStatus(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.acornAliases":                                    schema_pkg_apis_internalacornio_v1_acornAliases(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.containerAliases":                                schema_pkg_apis_internalacornio_v1_containerAliases(ref),
@@ -244,6 +292,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ClusterVolumeClassInstance":                schema_pkg_apis_internaladminacornio_v1_ClusterVolumeClassInstance(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ClusterVolumeClassInstanceList":            schema_pkg_apis_internaladminacornio_v1_ClusterVolumeClassInstanceList(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassMemory":                        schema_pkg_apis_internaladminacornio_v1_ComputeClassMemory(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassPrice":                         schema_pkg_apis_internaladminacornio_v1_ComputeClassPrice(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeResources":                          schema_pkg_apis_internaladminacornio_v1_ComputeResources(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ImageRoleAuthorizationInstance":            schema_pkg_apis_internaladminacornio_v1_ImageRoleAuthorizationInstance(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ImageRoleAuthorizationInstanceList":        schema_pkg_apis_internaladminacornio_v1_ImageRoleAuthorizationInstanceList(ref),
@@ -260,6 +309,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.QuotaRequestResources":                     schema_pkg_apis_internaladminacornio_v1_QuotaRequestResources(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.RoleAuthorizations":                        schema_pkg_apis_internaladminacornio_v1_RoleAuthorizations(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.RoleRef":                                   schema_pkg_apis_internaladminacornio_v1_RoleRef(ref),
+		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.VolumeClassPrice":                          schema_pkg_apis_internaladminacornio_v1_VolumeClassPrice(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.VolumeClassSize":                           schema_pkg_apis_internaladminacornio_v1_VolumeClassSize(ref),
 		"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.VolumeResources":                           schema_pkg_apis_internaladminacornio_v1_VolumeResources(ref),
 		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                                       schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
@@ -774,12 +824,17 @@ func schema_pkg_apis_adminacornio_v1_ClusterComputeClass(ref common.ReferenceCal
 							Ref: ref("k8s.io/api/core/v1.ResourceRequirements"),
 						},
 					},
+					"price": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassPrice"),
+						},
+					},
 				},
 				Required: []string{"default"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassMemory", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassMemory", "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassPrice", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -1011,12 +1066,17 @@ func schema_pkg_apis_adminacornio_v1_ClusterVolumeClass(ref common.ReferenceCall
 							},
 						},
 					},
+					"price": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.VolumeClassPrice"),
+						},
+					},
 				},
 				Required: []string{"storageClassName", "description"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.VolumeClassSize", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.VolumeClassPrice", "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.VolumeClassSize", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -1262,12 +1322,17 @@ func schema_pkg_apis_adminacornio_v1_ProjectComputeClass(ref common.ReferenceCal
 							Ref: ref("k8s.io/api/core/v1.ResourceRequirements"),
 						},
 					},
+					"price": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassPrice"),
+						},
+					},
 				},
 				Required: []string{"default"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassMemory", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassMemory", "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassPrice", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -1405,12 +1470,17 @@ func schema_pkg_apis_adminacornio_v1_ProjectVolumeClass(ref common.ReferenceCall
 							},
 						},
 					},
+					"price": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.VolumeClassPrice"),
+						},
+					},
 				},
 				Required: []string{"storageClassName", "description"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.VolumeClassSize", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.VolumeClassPrice", "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.VolumeClassSize", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -1696,6 +1766,86 @@ func schema_pkg_apis_apiacornio_v1_App(ref common.ReferenceCallback) common.Open
 	}
 }
 
+func schema_pkg_apis_apiacornio_v1_AppEstimate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AppEstimate is the CPU, memory and volume storage an app needs, the monthly cost of those resources if the compute and volume classes declare prices, and how the app fits in the quota of the project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Input Params Spec is the spec of the app to estimate. If not set, the existing app is estimated as it is deployed.",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppInstanceSpec"),
+						},
+					},
+					"workloads": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Output Params",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.WorkloadEstimate"),
+									},
+								},
+							},
+						},
+					},
+					"volumes": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeEstimate"),
+									},
+								},
+							},
+						},
+					},
+					"total": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ResourceEstimate"),
+						},
+					},
+					"quota": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.QuotaEstimate"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.QuotaEstimate", "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ResourceEstimate", "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeEstimate", "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.WorkloadEstimate", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppInstanceSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
 func schema_pkg_apis_apiacornio_v1_AppInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1824,6 +1974,149 @@ func schema_pkg_apis_apiacornio_v1_AppPullImage(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_apiacornio_v1_AppRevision(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppRevisionInstanceSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppRevisionInstanceSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_apiacornio_v1_AppRevisionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.AppRevision"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.AppRevision", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_apiacornio_v1_AppRollout(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"containers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Containers limits the action to the given containers. If empty, the action applies to all containers with a rollout in progress.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
 func schema_pkg_apis_apiacornio_v1_AppStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1835,6 +2128,11 @@ func schema_pkg_apis_apiacornio_v1_AppStatus(ref common.ReferenceCallback) commo
 							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionInstanceSpec"),
 						},
 					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppScheduleStatus"),
+						},
+					},
 					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"integer"},
@@ -1967,7 +2265,7 @@ func schema_pkg_apis_apiacornio_v1_AppStatus(ref common.ReferenceCallback) commo
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppColumns", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppImage", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppStatusStaged", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.CommonSummary", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Condition", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Defaults", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionInstanceSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ResolvedOfferings"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppColumns", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppImage", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppScheduleStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppStatusStaged", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.CommonSummary", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Condition", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Defaults", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionInstanceSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ResolvedOfferings"},
 	}
 }
 
@@ -2149,12 +2447,17 @@ func schema_pkg_apis_apiacornio_v1_ComputeClass(ref common.ReferenceCallback) co
 							},
 						},
 					},
+					"price": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassPrice"),
+						},
+					},
 				},
 				Required: []string{"default"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ComputeClassMemory", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ComputeClassMemory", "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassPrice", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -2510,6 +2813,32 @@ func schema_pkg_apis_apiacornio_v1_Config(ref common.ReferenceCallback) common.O
 							},
 						},
 					},
+					"publishBackend": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"gatewayClassName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"secretBackendAllowedAddresses": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 					"awsIdentityProviderArn": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
@@ -2522,6 +2851,12 @@ func schema_pkg_apis_apiacornio_v1_Config(ref common.ReferenceCallback) common.O
 							Format: "",
 						},
 					},
+					"functionIdleTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
 					"features": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"object"},
@@ -2629,7 +2964,7 @@ func schema_pkg_apis_apiacornio_v1_Config(ref common.ReferenceCallback) common.O
 						},
 					},
 				},
-				Required: []string{"ingressClassName", "clusterDomains", "letsEncrypt", "letsEncryptEmail", "letsEncryptTOSAgree", "setPodSecurityEnforceProfile", "podSecurityEnforceProfile", "httpEndpointPattern", "internalClusterDomain", "acornDNS", "acornDNSEndpoint", "autoUpgradeInterval", "recordBuilds", "publishBuilders", "builderPerProject", "internalRegistryPrefix", "ignoreUserLabelsAndAnnotations", "allowUserLabels", "allowUserAnnotations", "allowUserMetadataNamespaces", "workloadMemoryDefault", "workloadMemoryMaximum", "useCustomCABundle", "propagateProjectAnnotations", "propagateProjectLabels", "manageVolumeClasses", "volumeSizeDefault", "networkPolicies", "ingressControllerNamespace", "allowTrafficFromNamespace", "serviceLBAnnotations", "awsIdentityProviderArn", "eventTTL", "features", "certManagerIssuer", "profile", "autoConfigureKarpenterDontEvictAnnotations", "controllerMemory", "controllerCPU", "apiServerMemory", "apiServerCPU", "buildkitdMemory", "buildkitdCPU", "buildkitdServiceMemory", "buildkitdServiceCPU", "registryMemory", "registryCPU", "ignoreResourceRequirements", "requireComputeClass"},
+				Required: []string{"ingressClassName", "clusterDomains", "letsEncrypt", "letsEncryptEmail", "letsEncryptTOSAgree", "setPodSecurityEnforceProfile", "podSecurityEnforceProfile", "httpEndpointPattern", "internalClusterDomain", "acornDNS", "acornDNSEndpoint", "autoUpgradeInterval", "recordBuilds", "publishBuilders", "builderPerProject", "internalRegistryPrefix", "ignoreUserLabelsAndAnnotations", "allowUserLabels", "allowUserAnnotations", "allowUserMetadataNamespaces", "workloadMemoryDefault", "workloadMemoryMaximum", "useCustomCABundle", "propagateProjectAnnotations", "propagateProjectLabels", "manageVolumeClasses", "volumeSizeDefault", "networkPolicies", "ingressControllerNamespace", "allowTrafficFromNamespace", "serviceLBAnnotations", "publishBackend", "gatewayClassName", "secretBackendAllowedAddresses", "awsIdentityProviderArn", "eventTTL", "functionIdleTimeout", "features", "certManagerIssuer", "profile", "autoConfigureKarpenterDontEvictAnnotations", "controllerMemory", "controllerCPU", "apiServerMemory", "apiServerCPU", "buildkitdMemory", "buildkitdCPU", "buildkitdServiceMemory", "buildkitdServiceCPU", "registryMemory", "registryCPU", "ignoreResourceRequirements", "requireComputeClass"},
 			},
 		},
 	}
@@ -3087,6 +3422,18 @@ func schema_pkg_apis_apiacornio_v1_ContainerReplicaSpec(ref common.ReferenceCall
 							Format:      "int32",
 						},
 					},
+					"autoscale": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Autoscale is only available on containers, not sidecars or jobs. When set, Scale is ignored.",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Autoscale"),
+						},
+					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout is only available on containers, not sidecars or jobs",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Rollout"),
+						},
+					},
 					"schedule": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Schedule is only available on jobs",
@@ -3109,6 +3456,34 @@ func schema_pkg_apis_apiacornio_v1_ContainerReplicaSpec(ref common.ReferenceCall
 							},
 						},
 					},
+					"retries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retries is only available on jobs, it is the number of times a failed job is retried",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"backoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backoff is only available on jobs, it is the delay before the first retry of a failed job, doubled for each retry after it. If empty the Kubernetes job controller backoff is used, or ten seconds if a timeout is set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is only available on jobs, it is how long each attempt of a job may run before it is failed. Jobs with a timeout are recreated for each attempt, so the timeout does not limit the total time of all attempts.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"concurrencyPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConcurrencyPolicy is only available on scheduled jobs",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"init": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Init is only available on sidecars",
@@ -3136,6 +3511,12 @@ func schema_pkg_apis_apiacornio_v1_ContainerReplicaSpec(ref common.ReferenceCall
 							Ref:         ref("github.com/acorn-io/aml/pkg/jsonschema.Schema"),
 						},
 					},
+					"outputSchema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OutputSchema is only available on jobs, it is the schema the named outputs of the job are validated against",
+							Ref:         ref("github.com/acorn-io/aml/pkg/jsonschema.Schema"),
+						},
+					},
 					"appName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
@@ -3171,7 +3552,7 @@ func schema_pkg_apis_apiacornio_v1_ContainerReplicaSpec(ref common.ReferenceCall
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/aml/pkg/jsonschema.Schema", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Build", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Container", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Dependency", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EnvVar", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.File", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.MetricsDef", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortDef", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Probe", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.UserContext", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeMount"},
+			"github.com/acorn-io/aml/pkg/jsonschema.Schema", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Autoscale", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Build", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Container", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Dependency", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EnvVar", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.File", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.MetricsDef", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortDef", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Probe", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Rollout", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.UserContext", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeMount"},
 	}
 }
 
@@ -3243,6 +3624,11 @@ func schema_pkg_apis_apiacornio_v1_ContainerReplicaStatus(ref common.ReferenceCa
 							Ref:     ref("k8s.io/api/core/v1.ContainerState"),
 						},
 					},
+					"usage": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Usage"),
+						},
+					},
 					"ready": {
 						SchemaProps: spec.SchemaProps{
 							Default: false,
@@ -3282,7 +3668,7 @@ func schema_pkg_apis_apiacornio_v1_ContainerReplicaStatus(ref common.ReferenceCa
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainerReplicaColumns", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.ContainerState", "k8s.io/api/core/v1.ContainerStatus"},
+			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.ContainerReplicaColumns", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Usage", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.ContainerState", "k8s.io/api/core/v1.ContainerStatus"},
 	}
 }
 
@@ -3709,6 +4095,18 @@ func schema_pkg_apis_apiacornio_v1_EmbeddedContainer(ref common.ReferenceCallbac
 							Format:      "int32",
 						},
 					},
+					"autoscale": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Autoscale is only available on containers, not sidecars or jobs. When set, Scale is ignored.",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Autoscale"),
+						},
+					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout is only available on containers, not sidecars or jobs",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Rollout"),
+						},
+					},
 					"schedule": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Schedule is only available on jobs",
@@ -3731,6 +4129,34 @@ func schema_pkg_apis_apiacornio_v1_EmbeddedContainer(ref common.ReferenceCallbac
 							},
 						},
 					},
+					"retries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retries is only available on jobs, it is the number of times a failed job is retried",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"backoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backoff is only available on jobs, it is the delay before the first retry of a failed job, doubled for each retry after it. If empty the Kubernetes job controller backoff is used, or ten seconds if a timeout is set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is only available on jobs, it is how long each attempt of a job may run before it is failed. Jobs with a timeout are recreated for each attempt, so the timeout does not limit the total time of all attempts.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"concurrencyPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConcurrencyPolicy is only available on scheduled jobs",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"init": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Init is only available on sidecars",
@@ -3758,12 +4184,18 @@ func schema_pkg_apis_apiacornio_v1_EmbeddedContainer(ref common.ReferenceCallbac
 							Ref:         ref("github.com/acorn-io/aml/pkg/jsonschema.Schema"),
 						},
 					},
+					"outputSchema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OutputSchema is only available on jobs, it is the schema the named outputs of the job are validated against",
+							Ref:         ref("github.com/acorn-io/aml/pkg/jsonschema.Schema"),
+						},
+					},
 				},
 				Required: []string{"probes"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/aml/pkg/jsonschema.Schema", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Build", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Container", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Dependency", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EnvVar", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.File", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.MetricsDef", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortDef", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Probe", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.UserContext", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeMount"},
+			"github.com/acorn-io/aml/pkg/jsonschema.Schema", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Autoscale", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Build", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Container", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Dependency", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EnvVar", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.File", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.MetricsDef", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortDef", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Probe", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Rollout", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.UserContext", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeMount"},
 	}
 }
 
@@ -3946,6 +4378,100 @@ func schema_pkg_apis_apiacornio_v1_EventList(ref common.ReferenceCallback) commo
 	}
 }
 
+func schema_pkg_apis_apiacornio_v1_EventSubscription(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSubscriptionInstanceSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSubscriptionInstanceStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSubscriptionInstanceSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSubscriptionInstanceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_apiacornio_v1_EventSubscriptionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.EventSubscription"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.EventSubscription", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
 func schema_pkg_apis_apiacornio_v1_IconOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5225,6 +5751,49 @@ func schema_pkg_apis_apiacornio_v1_ProjectList(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_pkg_apis_apiacornio_v1_QuotaEstimate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuotaEstimate compares the resources an app requests against the quota allocated in its project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enforced": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enforced is true if the project has quota enforced. The other fields are only set if it is.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"requested": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Requested is the quota the app would request",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.QuotaRequestResources"),
+						},
+					},
+					"allocated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Allocated is the quota currently allocated to the app, zero if the app does not exist yet",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.QuotaRequestResources"),
+						},
+					},
+					"projectAllocated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProjectAllocated is the quota currently allocated to all the apps in the project, including this one",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.QuotaRequestResources"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.QuotaRequestResources"},
+	}
+}
+
 func schema_pkg_apis_apiacornio_v1_Region(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5406,6 +5975,47 @@ func schema_pkg_apis_apiacornio_v1_RegistryAuth(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_apiacornio_v1_ResourceEstimate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceEstimate is the total resources of all the workloads and volumes of one or more apps.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPU is millicores",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"memory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory is bytes",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"storage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Storage is bytes",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"monthlyCost": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MonthlyCost is only set if at least one of the classes used has a price",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_apiacornio_v1_Secret(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5474,11 +6084,12 @@ func schema_pkg_apis_apiacornio_v1_Secret(ref common.ReferenceCallback) common.O
 	}
 }
 
-func schema_pkg_apis_apiacornio_v1_SecretList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_apiacornio_v1_SecretKey(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "SecretKey is a key that encrypted data in a project can be decrypted with. The name of a SecretKey is its public key.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
 						SchemaProps: spec.SchemaProps{
@@ -5497,32 +6108,40 @@ func schema_pkg_apis_apiacornio_v1_SecretList(ref common.ReferenceCallback) comm
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"items": {
+					"primary": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Primary is true for the key that new data should be encrypted with",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"referencedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReferencedBy is the names of the apps with encrypted data that can only be decrypted with this key",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Secret"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Secret", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_apiacornio_v1_Service(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_apiacornio_v1_SecretKeyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -5545,30 +6164,32 @@ func schema_pkg_apis_apiacornio_v1_Service(ref common.ReferenceCallback) common.
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceInstanceSpec"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"status": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceInstanceStatus"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.SecretKey"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceInstanceSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceInstanceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.SecretKey", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_apiacornio_v1_ServiceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_apiacornio_v1_SecretList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -5601,7 +6222,7 @@ func schema_pkg_apis_apiacornio_v1_ServiceList(ref common.ReferenceCallback) com
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Service"),
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Secret"),
 									},
 								},
 							},
@@ -5612,11 +6233,105 @@ func schema_pkg_apis_apiacornio_v1_ServiceList(ref common.ReferenceCallback) com
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Service", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Secret", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_apiacornio_v1_Volume(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_apiacornio_v1_Service(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceInstanceSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceInstanceStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceInstanceSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceInstanceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_apiacornio_v1_ServiceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Service"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.Service", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_apiacornio_v1_Volume(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -5748,12 +6463,17 @@ func schema_pkg_apis_apiacornio_v1_VolumeClass(ref common.ReferenceCallback) com
 							},
 						},
 					},
+					"price": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.VolumeClassPrice"),
+						},
+					},
 				},
 				Required: []string{"storageClassName", "description"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.VolumeClassSize", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.VolumeClassPrice", "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.VolumeClassSize", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -5855,6 +6575,45 @@ func schema_pkg_apis_apiacornio_v1_VolumeCreateOptions(ref common.ReferenceCallb
 	}
 }
 
+func schema_pkg_apis_apiacornio_v1_VolumeEstimate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeEstimate is the storage of one volume. Volumes bound to existing volumes are not included.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"volumeClass": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Size is the bytes of storage of the volume",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"monthlyCost": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MonthlyCost is the cost of the volume, only set if the volume class has a price",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_apiacornio_v1_VolumeList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5903,6 +6662,100 @@ func schema_pkg_apis_apiacornio_v1_VolumeList(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_pkg_apis_apiacornio_v1_VolumeSnapshot(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSnapshotInstanceSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSnapshotInstanceStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSnapshotInstanceSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSnapshotInstanceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_apiacornio_v1_VolumeSnapshotList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeSnapshot"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1.VolumeSnapshot", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
 func schema_pkg_apis_apiacornio_v1_VolumeSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5998,59 +6851,137 @@ func schema_pkg_apis_apiacornio_v1_VolumeStatus(ref common.ReferenceCallback) co
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_Acorn(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_apiacornio_v1_WorkloadEstimate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "WorkloadEstimate is the resources of one container or sidecar. Jobs are not included because they do not run all the time.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"labels": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ScopedLabel"),
-									},
-								},
-							},
-						},
-					},
-					"annotations": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ScopedLabel"),
-									},
-								},
-							},
-						},
-					},
 					"name": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"description": {
+					"computeClass": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"image": {
+					"replicas": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Replicas is the scale of the container, or the maximum replicas if it is autoscaled",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"build": {
+					"cpu": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AcornBuild"),
+							Description: "CPU is the millicores requested by each replica",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"memory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory is the bytes of memory requested by each replica",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"monthlyCost": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MonthlyCost is the cost of all the replicas, only set if the compute class has a price",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_AWSSecretsManagerSecretBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"credentialsSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialsSecret is the name of a secret in the project with the \"accessKeyID\", \"secretAccessKey\" and optional \"sessionToken\" keys",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_Acorn(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ScopedLabel"),
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ScopedLabel"),
+									},
+								},
+							},
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"build": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AcornBuild"),
 						},
 					},
 					"profiles": {
@@ -6603,6 +7534,45 @@ func schema_pkg_apis_internalacornio_v1_AcornStatus(ref common.ReferenceCallback
 	}
 }
 
+func schema_pkg_apis_internalacornio_v1_ActivationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ActivationStatus is the status of a function that is scaled from zero on request.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"scaledToZero": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaledToZero is true while the function has no replicas",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"scaledToZeroTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastColdStart": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastColdStart is the time the last request that scaled the function from zero was received",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"coldStartLatency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ColdStartLatency is the time the last request that scaled the function from zero waited for a ready replica",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_internalacornio_v1_Alias(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7071,11 +8041,21 @@ func schema_pkg_apis_internalacornio_v1_AppInstanceSpec(ref common.ReferenceCall
 							},
 						},
 					},
+					"preview": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppPreview"),
+						},
+					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppSchedule"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.GenericMap", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.NameValue", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortBinding", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ScopedLabel", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretBinding", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceBinding", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeBinding"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppPreview", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppSchedule", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.GenericMap", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.NameValue", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortBinding", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ScopedLabel", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretBinding", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceBinding", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeBinding"},
 	}
 }
 
@@ -7090,6 +8070,11 @@ func schema_pkg_apis_internalacornio_v1_AppInstanceStatus(ref common.ReferenceCa
 							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionInstanceSpec"),
 						},
 					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppScheduleStatus"),
+						},
+					},
 					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"integer"},
@@ -7236,92 +8221,353 @@ func schema_pkg_apis_internalacornio_v1_AppInstanceStatus(ref common.ReferenceCa
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppColumns", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppImage", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppStatusStaged", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.CommonSummary", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Condition", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Defaults", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionInstanceSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ResolvedOfferings", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Scheduling"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppColumns", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppImage", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppScheduleStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppStatusStaged", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.CommonSummary", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Condition", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Defaults", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionInstanceSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ResolvedOfferings", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Scheduling"},
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_AppSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_AppPreview(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "AppPreview marks the app as the preview environment of a branch. Previews are removed once they expire.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"labels": {
+					"branch": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Branch is the VCS branch the preview is running",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"annotations": {
+					"baseApp": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "BaseApp is the app the bound secrets and volumes of the preview were cloned from",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"name": {
+					"timeoutSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "TimeoutSeconds is how long after the preview was last deployed it expires, zero means never",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"description": {
+					"renewTime": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "RenewTime is when the preview was last deployed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"readme": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_AppRevisionInstance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AppRevisionInstance is a snapshot of the spec and resolved image of an app at the time it was successfully deployed. Revisions are used to roll an app back to a previous known-good state.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"info": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"icon": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"containers": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppRevisionInstanceSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppRevisionInstanceSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_AppRevisionInstanceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Container"),
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppRevisionInstance"),
 									},
 								},
 							},
 						},
 					},
-					"functions": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppRevisionInstance", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_AppRevisionInstanceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"appName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AppName is the name of the app this revision belongs to",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision is a monotonically increasing number, starting at 1, for the app",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"appSpec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AppSpec is the spec of the app at this revision. The Stop field is never recorded.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppInstanceSpec"),
+						},
+					},
+					"imageID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImageID is the ID of the image the app was running at this revision",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imageDigest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImageDigest is the digest of the image the app was running at this revision",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppInstanceSpec"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_AppSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AppSchedule stops and starts the app on cron schedules, for example to stop it outside of working hours.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"stop": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Stop is the cron schedule the app is stopped on",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is the cron schedule the app is started on",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeZone is the IANA time zone the schedules are in, defaults to UTC",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_AppScheduleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule is the schedule of the app, or the default of the project, the transitions are computed from",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppSchedule"),
+						},
+					},
+					"lastAction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastAction is the last transition the schedule applied to the app",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTransition is when the last transition was scheduled",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"nextAction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextAction is the next transition the schedule applies to the app",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nextTransition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextTransition is when the next transition is scheduled",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppSchedule", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_AppSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"readme": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"info": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"icon": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"containers": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Container"),
+									},
+								},
+							},
+						},
+					},
+					"functions": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
@@ -7592,6 +8838,11 @@ func schema_pkg_apis_internalacornio_v1_AppStatus(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"usage": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Usage"),
+						},
+					},
 					"stopped": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"boolean"},
@@ -7614,7 +8865,7 @@ func schema_pkg_apis_internalacornio_v1_AppStatus(ref common.ReferenceCallback)
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AcornStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ContainerStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Endpoint", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.JobStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RouterStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeStatus"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AcornStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ContainerStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Endpoint", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.JobStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RouterStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Usage", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeStatus"},
 	}
 }
 
@@ -7837,69 +9088,176 @@ func schema_pkg_apis_internalacornio_v1_Assistant(ref common.ReferenceCallback)
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_Build(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_Autoscale(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"context": {
+					"minReplicas": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
-					"additionalContexts": {
+					"maxReplicas": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
-					"dockerfile": {
+					"cpu": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "CPU is the target average CPU utilization, as a percentage of the requested CPU",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"dockerfileContents": {
+					"memory": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Memory is the target average memory utilization, as a percentage of the requested memory",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"target": {
+					"metric": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Metric is a custom metric served by the metrics endpoint of the container",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AutoscaleMetric"),
 						},
 					},
-					"baseImage": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AutoscaleMetric"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_AutoscaleMetric(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"contextDirs": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
+							Description: "Target is the average value of the metric per replica, as a quantity such as \"100\" or \"500m\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_AutoscaleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"maxReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"desiredReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"currentReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_Build(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"context": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"additionalContexts": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"dockerfile": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"dockerfileContents": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"baseImage": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"contextDirs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
 							},
 						},
 					},
@@ -8711,6 +10069,18 @@ func schema_pkg_apis_internalacornio_v1_Container(ref common.ReferenceCallback)
 							Format:      "int32",
 						},
 					},
+					"autoscale": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Autoscale is only available on containers, not sidecars or jobs. When set, Scale is ignored.",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Autoscale"),
+						},
+					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout is only available on containers, not sidecars or jobs",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Rollout"),
+						},
+					},
 					"schedule": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Schedule is only available on jobs",
@@ -8733,6 +10103,34 @@ func schema_pkg_apis_internalacornio_v1_Container(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"retries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retries is only available on jobs, it is the number of times a failed job is retried",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"backoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backoff is only available on jobs, it is the delay before the first retry of a failed job, doubled for each retry after it. If empty the Kubernetes job controller backoff is used, or ten seconds if a timeout is set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is only available on jobs, it is how long each attempt of a job may run before it is failed. Jobs with a timeout are recreated for each attempt, so the timeout does not limit the total time of all attempts.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"concurrencyPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConcurrencyPolicy is only available on scheduled jobs",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"init": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Init is only available on sidecars",
@@ -8760,12 +10158,18 @@ func schema_pkg_apis_internalacornio_v1_Container(ref common.ReferenceCallback)
 							Ref:         ref("github.com/acorn-io/aml/pkg/jsonschema.Schema"),
 						},
 					},
+					"outputSchema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OutputSchema is only available on jobs, it is the schema the named outputs of the job are validated against",
+							Ref:         ref("github.com/acorn-io/aml/pkg/jsonschema.Schema"),
+						},
+					},
 				},
 				Required: []string{"probes"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/aml/pkg/jsonschema.Schema", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Build", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Container", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Dependency", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EnvVar", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.File", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.MetricsDef", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortDef", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Probe", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.UserContext", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeMount"},
+			"github.com/acorn-io/aml/pkg/jsonschema.Schema", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Autoscale", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Build", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Container", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Dependency", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EnvVar", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.File", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.MetricsDef", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortDef", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Probe", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Rollout", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.UserContext", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeMount"},
 	}
 }
 
@@ -9025,11 +10429,31 @@ func schema_pkg_apis_internalacornio_v1_ContainerStatus(ref common.ReferenceCall
 							},
 						},
 					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RolloutStatus"),
+						},
+					},
+					"autoscale": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AutoscaleStatus"),
+						},
+					},
+					"activation": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ActivationStatus"),
+						},
+					},
+					"usage": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Usage"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DependencyStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ExpressionError"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ActivationStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AutoscaleStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DependencyStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ExpressionError", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RolloutStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Usage"},
 	}
 }
 
@@ -9268,8 +10692,16 @@ func schema_pkg_apis_internalacornio_v1_DevSessionInstanceExpireAction(ref commo
 				Properties: map[string]spec.Schema{
 					"stop": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "Stop stops the app",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"delete": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Delete removes the app",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
@@ -9365,11 +10797,17 @@ func schema_pkg_apis_internalacornio_v1_DevSessionInstanceSpec(ref common.Refere
 							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppInstanceSpec"),
 						},
 					},
+					"expireAction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpireAction is applied to the app when the session expires without being released",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionInstanceExpireAction"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppInstanceSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionInstanceClient", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppInstanceSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionInstanceClient", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionInstanceExpireAction", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -9417,6 +10855,11 @@ func schema_pkg_apis_internalacornio_v1_EmbeddedAppStatus(ref common.ReferenceCa
 							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionInstanceSpec"),
 						},
 					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppScheduleStatus"),
+						},
+					},
 					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"integer"},
@@ -9549,7 +10992,7 @@ func schema_pkg_apis_internalacornio_v1_EmbeddedAppStatus(ref common.ReferenceCa
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppColumns", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppImage", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppStatusStaged", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.CommonSummary", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Condition", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Defaults", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionInstanceSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ResolvedOfferings"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppColumns", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppImage", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppScheduleStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppStatusStaged", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.CommonSummary", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Condition", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Defaults", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DevSessionInstanceSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Permissions", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ResolvedOfferings"},
 	}
 }
 
@@ -9639,6 +11082,55 @@ func schema_pkg_apis_internalacornio_v1_EnvVar(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_pkg_apis_internalacornio_v1_EventDelivery(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EventDelivery is a failed delivery of an event.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"eventName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"eventType": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"attempts": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"lastAttempt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"nextAttempt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_internalacornio_v1_EventInstance(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -9821,46 +11313,285 @@ func schema_pkg_apis_internalacornio_v1_EventResource(ref common.ReferenceCallba
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_ExecProbe(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_EventSubscriptionInstance(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "EventSubscriptionInstance delivers the events of a project that match its filters to an HTTP endpoint.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"command": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSubscriptionInstanceSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSubscriptionInstanceStatus"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSubscriptionInstanceSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSubscriptionInstanceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_ExpressionError(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_EventSubscriptionInstanceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"dependencyNotFound": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DependencyNotFound"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"expression": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSubscriptionInstance"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventSubscriptionInstance", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_EventSubscriptionInstanceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the http or https endpoint events are POSTed to",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"types": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Types filters the events delivered by type, all types are delivered if empty",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"severities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Severities filters the events delivered by severity, all severities are delivered if empty",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"appNames": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AppNames filters the events delivered by the app they are related to, all events are delivered if empty",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"signingSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SigningSecretName is the name of a secret in the project whose \"key\" is used to sign payloads with HMAC-SHA256",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"format": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Format is the format of the payload, acorn or cloudevents. The default is acorn.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxRetries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxRetries is the number of times the delivery of an event is retried before it is dead lettered. The default is 5.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_EventSubscriptionInstanceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastObserved": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastObserved and LastEventName are the position of the last event that was delivered or dead lettered. Events observed before the subscription was created are not delivered.",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.MicroTime"),
+						},
+					},
+					"lastEventName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"delivered": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Delivered is the number of events delivered",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastDelivery": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastDelivery is the time the last event was delivered",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"retry": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retry is the delivery that failed and is waiting to be retried",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventDelivery"),
+						},
+					},
+					"deadLetters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeadLetters are the most recent events that could not be delivered",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventDelivery"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.EventDelivery", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.MicroTime", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_ExecProbe(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"command": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_ExpressionError(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"dependencyNotFound": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DependencyNotFound"),
+						},
+					},
+					"expression": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
 							Format: "",
 						},
 					},
@@ -10016,6 +11747,25 @@ func schema_pkg_apis_internalacornio_v1_File(ref common.ReferenceCallback) commo
 	}
 }
 
+func schema_pkg_apis_internalacornio_v1_FileSecretBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"directory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Directory is relative to the directory of the project, named after the project, in the secret backend directory mounted in the controller",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_internalacornio_v1_GeneratedService(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -10067,6 +11817,47 @@ func schema_pkg_apis_internalacornio_v1_HTTPProbe(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_internalacornio_v1_HeaderModifier(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"set": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"remove": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_internalacornio_v1_Image(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -10752,21 +12543,73 @@ func schema_pkg_apis_internalacornio_v1_JobStatus(ref common.ReferenceCallback)
 							},
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DependencyStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ExpressionError", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
-	}
-}
-
-func schema_pkg_apis_internalacornio_v1_MetricsDef(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"port": {
+					"step": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Step is the position of the job in the order the jobs of the app run in given their dependencies on each other, starting at 1, and Steps is the number of steps in that order",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"steps": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"attempt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Attempt is the number of the current or last attempt to run the job, out of MaxAttempts",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxAttempts": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"nextAttempt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"outputs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Outputs are the named outputs of the last successful run of the job",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"usage": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Usage"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.DependencyStatus", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ExpressionError", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.Usage", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_MetricsDef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"port": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"integer"},
 							Format: "int32",
@@ -11111,6 +12954,32 @@ func schema_pkg_apis_internalacornio_v1_PolicyRule(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_internalacornio_v1_PortAuth(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"basicSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BasicSecret is the name of a secret of type basic in the app whose username and password are required",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"forwardURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ForwardURL is the URL of a service, such as an OIDC proxy, that each request is forwarded to for authentication",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_internalacornio_v1_PortBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -11169,9 +13038,16 @@ func schema_pkg_apis_internalacornio_v1_PortBinding(ref common.ReferenceCallback
 							Format: "",
 						},
 					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortPolicy"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortPolicy"},
 	}
 }
 
@@ -11223,9 +13099,56 @@ func schema_pkg_apis_internalacornio_v1_PortDef(ref common.ReferenceCallback) co
 							Format: "int32",
 						},
 					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortPolicy"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortPolicy"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_PortPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PortPolicy restricts who can reach a published HTTP port. It is enforced by the ingress controller the port is published with.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"auth": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortAuth"),
+						},
+					},
+					"allowedCIDRs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedCIDRs are the source IP ranges requests are accepted from, all sources are allowed if empty",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"rateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RateLimit"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortAuth", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RateLimit"},
 	}
 }
 
@@ -11265,9 +13188,16 @@ func schema_pkg_apis_internalacornio_v1_PortPublish(ref common.ReferenceCallback
 							Format: "int32",
 						},
 					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortPolicy"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.PortPolicy"},
 	}
 }
 
@@ -11480,9 +13410,31 @@ func schema_pkg_apis_internalacornio_v1_ProjectInstanceSpec(ref common.Reference
 							},
 						},
 					},
+					"secretBackends": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretBackends are the backends that external secrets of the apps in the project are resolved from. A backend is selected by the scheme of the reference, for example \"vault://path#key\".",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretBackend"),
+									},
+								},
+							},
+						},
+					},
+					"defaultAppSchedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultAppSchedule stops and starts the apps in the project that don't have a schedule of their own",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppSchedule"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AppSchedule", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.SecretBackend"},
 	}
 }
 
@@ -11506,145 +13458,434 @@ func schema_pkg_apis_internalacornio_v1_ProjectInstanceStatus(ref common.Referen
 					},
 					"supportedRegions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SupportedRegions on the status field should be an explicit list of supported regions. That is, if the user specifies \"*\" for supported regions, then the status value should be the list of all regions. This is to avoid having to make another call to explicitly list all regions.",
+							Description: "SupportedRegions on the status field should be an explicit list of supported regions. That is, if the user specifies \"*\" for supported regions, then the status value should be the list of all regions. This is to avoid having to make another call to explicitly list all regions.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_RateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"requestsPerSecond": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_ReplicasSummary(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"RunningCount": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"MaxReplicaRestartCount": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"DesiredCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DesiredCount and CurrentCount are the replicas the autoscaler wants and the replicas it last observed. They are only set for autoscaled containers.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"CurrentCount": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"TransitioningMessages": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"ErrorMessages": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"RunningCount", "MaxReplicaRestartCount", "DesiredCount", "CurrentCount", "TransitioningMessages", "ErrorMessages"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_ResolvedOfferings(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"volumes": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeResolvedOffering"),
+									},
+								},
+							},
+						},
+					},
+					"volumeSize": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"containers": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ContainerResolvedOffering"),
+									},
+								},
+							},
+						},
+					},
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ContainerResolvedOffering", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeResolvedOffering", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_Rollout(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"strategy": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"steps": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Steps are only used by the canary strategy",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RolloutStep"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RolloutStep"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_RolloutStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"strategy": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"stableHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StableHash is the pod template hash of the version currently serving traffic",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"canaryHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CanaryHash is the pod template hash of the version being rolled out",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"step": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"weight": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"stepStartTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"requestedAction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequestedAction is set through the API and is consumed by the controller on the next reconcile",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_RolloutStep(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"weight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Weight is the percentage of replicas, and therefore traffic, that run the new version during this step. The replicas of the container must be able to represent it, each version runs at least one replica.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"pause": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pause is how long to wait before moving to the next step. If empty the rollout waits to be promoted.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_Route(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"targetServiceName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"targetPort": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"pathType": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets split the requests of the route across services by weight. When set, TargetServiceName and TargetPort are ignored.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RouteTarget"),
 									},
 								},
 							},
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_internalacornio_v1_ReplicasSummary(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"RunningCount": {
+					"match": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "Match restricts the route to requests with the given headers, cookies and methods. Routes with the same path are matched in order and the first route that matches a request serves it.",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RouteMatch"),
 						},
 					},
-					"MaxReplicaRestartCount": {
+					"requestHeaders": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "RequestHeaders modifies the headers of the requests sent to the targets",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.HeaderModifier"),
 						},
 					},
-					"TransitioningMessages": {
+					"responseHeaders": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "ResponseHeaders modifies the headers of the responses returned from the targets",
+							Ref:         ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.HeaderModifier"),
 						},
 					},
-					"ErrorMessages": {
+					"stripPrefix": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "StripPrefix removes the path of the route from the requests sent to the targets",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"RunningCount", "MaxReplicaRestartCount", "TransitioningMessages", "ErrorMessages"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.HeaderModifier", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RouteMatch", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RouteTarget"},
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_ResolvedOfferings(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_RouteMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"volumes": {
+					"headers": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeResolvedOffering"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"volumeSize": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-						},
-					},
-					"containers": {
+					"cookies": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ContainerResolvedOffering"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"region": {
+					"methods": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ContainerResolvedOffering", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeResolvedOffering", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
-func schema_pkg_apis_internalacornio_v1_Route(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_internalacornio_v1_RouteTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"path": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
 					"targetServiceName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
@@ -11657,10 +13898,11 @@ func schema_pkg_apis_internalacornio_v1_Route(ref common.ReferenceCallback) comm
 							Format: "int32",
 						},
 					},
-					"pathType": {
+					"weight": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Weight is the share of the requests of the route sent to the target relative to the weights of the other targets",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
@@ -12022,6 +14264,55 @@ func schema_pkg_apis_internalacornio_v1_Secret(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_pkg_apis_internalacornio_v1_SecretBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the scheme used to reference the backend, defaults to the type",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"refreshInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RefreshInterval is how often secrets resolved from the backend are refreshed, defaults to 5m",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"vault": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VaultSecretBackend"),
+						},
+					},
+					"awsSecretsManager": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AWSSecretsManagerSecretBackend"),
+						},
+					},
+					"file": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.FileSecretBackend"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.AWSSecretsManagerSecretBackend", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.FileSecretBackend", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VaultSecretBackend"},
+	}
+}
+
 func schema_pkg_apis_internalacornio_v1_SecretBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -12721,6 +15012,13 @@ func schema_pkg_apis_internalacornio_v1_ServiceInstanceSpec(ref common.Reference
 							Format: "",
 						},
 					},
+					"rolloutTrack": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RolloutTrack restricts the service to the pods of one track of a blue-green rollout",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"function": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
@@ -12772,6 +15070,13 @@ func schema_pkg_apis_internalacornio_v1_ServiceInstanceSpec(ref common.Reference
 							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.ServiceConsumer"),
 						},
 					},
+					"activated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Activated services of functions route to the activator of the app, which scales the function from zero on request",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"appName": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Fields from app",
@@ -13181,6 +15486,59 @@ func schema_pkg_apis_internalacornio_v1_TCPProbe(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_pkg_apis_internalacornio_v1_Usage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Usage is the CPU and memory used by the replicas of a container or job, or by all the workloads of an app, as reported by the metrics.k8s.io API.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPU is in millicores, Memory and MemoryLimit are in bytes. MemoryLimit is the sum of the limits of the containers that have one.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"memory": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"memoryLimit": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"nearMemoryLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NearMemoryLimit is true if any container is using at least 90% of its memory limit",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"oomKilledRestarts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OOMKilledRestarts is the number of restarts of the containers whose last termination was because they ran out of memory",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_internalacornio_v1_UserContext(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -13231,6 +15589,13 @@ func schema_pkg_apis_internalacornio_v1_VCS(ref common.ReferenceCallback) common
 							Format: "",
 						},
 					},
+					"branch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Branch the branch checked out in the vcs repository when building the running app, empty if HEAD was detached",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"clean": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Clean a true value indicates the build contained no modified or untracked files according to git",
@@ -13272,6 +15637,71 @@ func schema_pkg_apis_internalacornio_v1_VCS(ref common.ReferenceCallback) common
 	}
 }
 
+func schema_pkg_apis_internalacornio_v1_VaultSecretBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"address": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"mount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mount is the path of the KV version 2 secrets engine, defaults to \"secret\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the Vault Enterprise namespace",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tokenSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenSecret is the name of a secret in the project with the Vault token in the \"token\" key",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_VolumeBackup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule is the cron schedule snapshots of the volume are taken on",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"keep": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Keep is the number of scheduled snapshots that are kept, older snapshots are deleted",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_internalacornio_v1_VolumeBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -13316,6 +15746,13 @@ func schema_pkg_apis_internalacornio_v1_VolumeBinding(ref common.ReferenceCallba
 							Format: "",
 						},
 					},
+					"snapshot": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Snapshot is the name of a volume snapshot the content of the volume is restored from",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -13478,9 +15915,16 @@ func schema_pkg_apis_internalacornio_v1_VolumeRequest(ref common.ReferenceCallba
 							},
 						},
 					},
+					"backup": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeBackup"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeBackup"},
 	}
 }
 
@@ -13546,6 +15990,214 @@ func schema_pkg_apis_internalacornio_v1_VolumeSecretMount(ref common.ReferenceCa
 	}
 }
 
+func schema_pkg_apis_internalacornio_v1_VolumeSnapshotInstance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeSnapshotInstance is a point in time copy of the data of a volume that new volumes can be restored from.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSnapshotInstanceSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSnapshotInstanceStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSnapshotInstanceSpec", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSnapshotInstanceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_VolumeSnapshotInstanceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSnapshotInstance"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.VolumeSnapshotInstance", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_VolumeSnapshotInstanceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"volume": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Volume is the name of the PersistentVolume the snapshot is taken of",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"appName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AppName is the name of the app the volume belonged to when the snapshot was taken",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeName is the name of the volume in the app",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_internalacornio_v1_VolumeSnapshotInstanceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"ready": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ready is true once the snapshot can be restored from",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"sourceNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SourceNamespace is the namespace of the PersistentVolumeClaim the snapshot was taken from",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"snapshotName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SnapshotName is the name of the CSI VolumeSnapshot in the source namespace",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"driver": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Driver is the CSI driver of the snapshot",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"snapshotContentName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SnapshotContentName is the name of the CSI VolumeSnapshotContent that retains the snapshot",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"snapshotHandle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SnapshotHandle is the handle of the snapshot in the CSI driver",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"artifact": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Artifact is the reference of the archive in the internal registry",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Size is the size of the volume the snapshot was taken of, used as the size of restored volumes",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_internalacornio_v1_VolumeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -13937,9 +16589,44 @@ func schema_pkg_apis_internalacornio_v1_routeTarget(ref common.ReferenceCallback
 							Format: "",
 						},
 					},
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RouteTarget"),
+									},
+								},
+							},
+						},
+					},
+					"match": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RouteMatch"),
+						},
+					},
+					"requestHeaders": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.HeaderModifier"),
+						},
+					},
+					"responseHeaders": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.HeaderModifier"),
+						},
+					},
+					"stripPrefix": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.HeaderModifier", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RouteMatch", "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1.RouteTarget"},
 	}
 }
 
@@ -14177,12 +16864,17 @@ func schema_pkg_apis_internaladminacornio_v1_ClusterComputeClassInstance(ref com
 							Ref: ref("k8s.io/api/core/v1.ResourceRequirements"),
 						},
 					},
+					"price": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassPrice"),
+						},
+					},
 				},
 				Required: []string{"default"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassMemory", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassMemory", "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassPrice", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -14414,12 +17106,17 @@ func schema_pkg_apis_internaladminacornio_v1_ClusterVolumeClassInstance(ref comm
 							},
 						},
 					},
+					"price": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.VolumeClassPrice"),
+						},
+					},
 				},
 				Required: []string{"storageClassName", "description"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.VolumeClassSize", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.VolumeClassPrice", "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.VolumeClassSize", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -14521,6 +17218,33 @@ func schema_pkg_apis_internaladminacornio_v1_ComputeClassMemory(ref common.Refer
 	}
 }
 
+func schema_pkg_apis_internaladminacornio_v1_ComputeClassPrice(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ComputeClassPrice is the optional unit price of the resources of a compute class, used to estimate the cost of apps. Prices are per month in whatever currency the administrator chooses.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPU is the price of one CPU core per month",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"memory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory is the price of one GiB of memory per month",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_internaladminacornio_v1_ComputeResources(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -14783,12 +17507,17 @@ func schema_pkg_apis_internaladminacornio_v1_ProjectComputeClassInstance(ref com
 							Ref: ref("k8s.io/api/core/v1.ResourceRequirements"),
 						},
 					},
+					"price": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassPrice"),
+						},
+					},
 				},
 				Required: []string{"default"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassMemory", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassMemory", "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.ComputeClassPrice", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -14926,12 +17655,17 @@ func schema_pkg_apis_internaladminacornio_v1_ProjectVolumeClassInstance(ref comm
 							},
 						},
 					},
+					"price": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.VolumeClassPrice"),
+						},
+					},
 				},
 				Required: []string{"storageClassName", "description"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.VolumeClassSize", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.VolumeClassPrice", "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1.VolumeClassSize", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -15299,6 +18033,26 @@ func schema_pkg_apis_internaladminacornio_v1_RoleRef(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_internaladminacornio_v1_VolumeClassPrice(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeClassPrice is the optional unit price of the storage of a volume class, used to estimate the cost of apps. Prices are per month in whatever currency the administrator chooses.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"storage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Storage is the price of one GiB of storage per month",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_internaladminacornio_v1_VolumeClassSize(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
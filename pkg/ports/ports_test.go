@@ -142,3 +142,21 @@ func TestCollectPorts(t *testing.T) {
 		})
 	}
 }
+
+func TestApplyBindingsPolicy(t *testing.T) {
+	policy := &v1.PortPolicy{AllowedCIDRs: []string{"10.0.0.0/8"}}
+	bound := ApplyBindings(v1.PublishModeDefined, []v1.PortPublish{
+		{Hostname: "app.example.com", TargetPort: 80, Policy: policy},
+	}, []v1.PortDef{
+		{Port: 80, TargetPort: 8080, Protocol: v1.ProtocolHTTP},
+		{Port: 81, TargetPort: 8081, Protocol: v1.ProtocolHTTP, Publish: true},
+	})
+
+	// The policy applies to the port on both its own and its generated hostname, but not to the other port
+	assert.Equal(t, []v1.PortDef{{Port: 80, TargetPort: 8080, Protocol: v1.ProtocolHTTP, Policy: policy}},
+		bound[ListenDef{Hostname: "app.example.com", Protocol: v1.ProtocolHTTP}])
+	assert.Equal(t, []v1.PortDef{
+		{Port: 80, TargetPort: 8080, Protocol: v1.ProtocolHTTP, Policy: policy},
+		{Port: 81, TargetPort: 8081, Protocol: v1.ProtocolHTTP, Publish: true},
+	}, bound[ListenDef{Protocol: v1.ProtocolHTTP}])
+}
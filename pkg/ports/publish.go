@@ -118,6 +118,7 @@ func PortPublishForService(serviceName string, bindings []v1.PortBinding) (resul
 				Protocol:   binding.Protocol,
 				Hostname:   binding.Hostname,
 				TargetPort: binding.TargetPort,
+				Policy:     binding.Policy,
 			})
 		}
 	}
@@ -136,6 +137,15 @@ func ApplyBindings(mode v1.PublishMode, bindings []v1.PortPublish, ports []v1.Po
 			published bool
 		)
 
+		// A policy given when publishing an HTTP port replaces the policy of the port on every hostname it is
+		// published on, otherwise the port would still be reachable without it on its generated hostname.
+		for _, binding := range bindings {
+			if binding.Policy != nil && isHTTP(port) && matches(binding, port) {
+				port.Policy = binding.Policy
+				break
+			}
+		}
+
 		for _, binding := range bindings {
 			if matches(binding, port) {
 				published = true
@@ -173,6 +183,10 @@ func ApplyBindings(mode v1.PublishMode, bindings []v1.PortPublish, ports []v1.Po
 	return
 }
 
+func isHTTP(port v1.PortDef) bool {
+	return port.Protocol == v1.ProtocolHTTP || port.Protocol == v1.ProtocolHTTP2
+}
+
 func portMatches(binding v1.PortPublish, port v1.PortDef) bool {
 	return binding.TargetPort == 0 || binding.TargetPort == port.Port
 }
@@ -0,0 +1,38 @@
+package preview
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/name"
+)
+
+// DefaultTTL is how long after it was last deployed a preview expires if no TTL is given
+const DefaultTTL = 72 * time.Hour
+
+var invalidChars = regexp.MustCompile("[^a-z0-9-]+")
+
+// Slug returns the branch in a form that is valid in app names and hostnames.
+func Slug(branch string) string {
+	return strings.Trim(invalidChars.ReplaceAllString(strings.ToLower(branch), "-"), "-")
+}
+
+// AppName returns the name of the preview of the base app for the branch.
+func AppName(base, branch string) string {
+	return name.SafeConcatName(Slug(base), Slug(branch))
+}
+
+// Hostname returns the hostname the preview for the branch publishes instead of hostname. The branch is prefixed to the
+// first label, so the hostname stays in the same domain as the one of the base app.
+func Hostname(hostname, branch string) string {
+	if hostname == "" {
+		return ""
+	}
+	return Slug(branch) + "-" + hostname
+}
+
+// SnapshotName returns the name of the snapshot the volume of the preview app is cloned from.
+func SnapshotName(appName, volumeName string) string {
+	return name.SafeConcatName(appName, "preview", volumeName)
+}
@@ -0,0 +1,19 @@
+package preview
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppName(t *testing.T) {
+	assert.Equal(t, "my-app-feature-login", AppName("my-app", "feature/Login"))
+	assert.Equal(t, "my-app-fix-123", AppName("My_App", "--fix..123--"))
+	assert.LessOrEqual(t, len(AppName("my-app", "feature/"+strings.Repeat("x", 100))), 63)
+}
+
+func TestHostname(t *testing.T) {
+	assert.Equal(t, "feature-login-app.example.com", Hostname("app.example.com", "feature/login"))
+	assert.Equal(t, "", Hostname("", "feature/login"))
+}
@@ -31,6 +31,9 @@ var (
 	}
 
 	DefaultVolumeSize = "10G"
+
+	// PublishBackendDefault is the default value for the PublishBackend field
+	PublishBackendDefault = "ingress"
 )
 
 func defaultProfile() apiv1.Config {
@@ -43,6 +46,8 @@ func defaultProfile() apiv1.Config {
 		CertManagerIssuer:              new(string),
 		EventTTL:                       new(string),
 		Features:                       FeatureDefaults,
+		FunctionIdleTimeout:            new(string),
+		GatewayClassName:               new(string),
 		HTTPEndpointPattern:            z.Pointer(HTTPEndpointPatternDefault),
 		IgnoreUserLabelsAndAnnotations: new(bool),
 		IngressClassName:               new(string),
@@ -57,6 +62,7 @@ func defaultProfile() apiv1.Config {
 		NetworkPolicies:                new(bool),
 		PodSecurityEnforceProfile:      "baseline",
 		Profile:                        new(string),
+		PublishBackend:                 z.Pointer(PublishBackendDefault),
 		PublishBuilders:                new(bool),
 		RecordBuilds:                   new(bool),
 		SetPodSecurityEnforceProfile:   z.Pointer(true),
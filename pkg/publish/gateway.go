@@ -0,0 +1,466 @@
+package publish
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/acorn-io/baaah/pkg/name"
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/typed"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/ports"
+	"github.com/acorn-io/runtime/pkg/system"
+	"github.com/acorn-io/z"
+	"golang.org/x/exp/maps"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	klabels "k8s.io/apimachinery/pkg/labels"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	BackendIngress = "ingress"
+	BackendGateway = "gateway"
+
+	tcpGateway = "tcp"
+)
+
+// UsesGateway returns true if ports are published with Gateway API resources instead of Ingresses and LoadBalancer
+// Services.
+func UsesGateway(cfg *apiv1.Config) bool {
+	return z.Dereference(cfg.PublishBackend) == BackendGateway
+}
+
+func ValidateBackend(cfg *apiv1.Config) error {
+	switch z.Dereference(cfg.PublishBackend) {
+	case "", BackendIngress:
+		return nil
+	case BackendGateway:
+		if z.Dereference(cfg.GatewayClassName) == "" {
+			return fmt.Errorf("gateway-class-name must be set when the publish backend is %s", BackendGateway)
+		}
+		return nil
+	}
+	return fmt.Errorf("invalid publish backend [%s], must be %s or %s", *cfg.PublishBackend, BackendIngress, BackendGateway)
+}
+
+// Gateway publishes the HTTP and TCP ports of the service with Gateway API resources. HTTP ports get the same hostnames
+// and certificates they would get with an Ingress and are routed with HTTPRoutes attached to the shared Gateway, see
+// SharedGateway. TCP ports are published on a Gateway of their own, so that each service gets its own addresses like
+// it would with a LoadBalancer Service, and are routed with TCPRoutes, or with TLSRoutes if they are bound to a
+// hostname.
+func Gateway(req router.Request, svc *v1.ServiceInstance) (result []kclient.Object, _ error) {
+	if svc.Spec.PublishMode == v1.PublishModeNone {
+		return nil, nil
+	}
+
+	cfg, err := config.Get(req.Ctx, req.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	if !UsesGateway(cfg) {
+		return nil, nil
+	}
+
+	// Gateway API has no standard way to enforce port policies, so refuse to publish the ports without them
+	for _, port := range svc.Spec.Ports {
+		if port.Policy != nil {
+			return nil, fmt.Errorf("port [%d] has a policy which is not supported with the %s publish backend", port.TargetPort, BackendGateway)
+		}
+	}
+	for _, binding := range svc.Spec.Publish {
+		if binding.Policy != nil {
+			return nil, fmt.Errorf("port [%d] is published with a policy which is not supported with the %s publish backend", binding.TargetPort, BackendGateway)
+		}
+	}
+
+	httpBindings := ports.ApplyBindings(svc.Spec.PublishMode, svc.Spec.Publish, ports.ByProtocol(svc.Spec.Ports, true, v1.ProtocolHTTP, v1.ProtocolHTTP2))
+	if len(httpBindings) > 0 {
+		objs, err := httpRoutes(req, cfg, svc, httpBindings)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, objs...)
+	}
+
+	tcpBindings := ports.ApplyBindings(svc.Spec.PublishMode, svc.Spec.Publish, ports.ByProtocol(svc.Spec.Ports, true, v1.ProtocolTCP))
+	if len(tcpBindings) > 0 {
+		objs, err := tcpGateways(cfg, svc, tcpBindings)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, objs...)
+	}
+
+	return result, nil
+}
+
+// httpRoutes returns an HTTPRoute for each hostname the HTTP ports of the service are published on. The certificate
+// the shared Gateway should terminate TLS with for the hostname is set in the labels.AcornGatewayCertificate
+// annotation of the route as namespace/name. Certificates found for the hostnames are copied to the namespace of the
+// service and granted to the shared Gateway. Custom domains without a certificate get one from cert-manager, which
+// issues it in the namespace of the shared Gateway.
+func httpRoutes(req router.Request, cfg *apiv1.Config, svc *v1.ServiceInstance, bindings ports.BoundPorts) (result []kclient.Object, _ error) {
+	appInstance := &v1.AppInstance{}
+	if err := req.Client.Get(req.Ctx, router.Key(svc.Spec.AppNamespace, svc.Spec.AppName), appInstance); err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	acornDNSDomain, err := getAcornDNSDomain(req)
+	if err != nil {
+		return nil, err
+	}
+
+	hosts, err := publishedHosts(cfg, svc, appInstance, acornDNSDomain, bindings)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsCerts, err := getCerts(req, svc.Spec.AppNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		certs  = map[string]TLSCert{}
+		toCopy []TLSCert
+		copied = map[string]bool{}
+		seen   = map[string]bool{}
+	)
+	for _, host := range hosts {
+		for _, cert := range tlsCerts {
+			// Find the first cert and stop looking
+			if cert.certForThisDomain(host.Hostname) {
+				certs[host.Hostname] = cert
+				if !copied[cert.SecretName] {
+					copied[cert.SecretName] = true
+					toCopy = append(toCopy, cert)
+				}
+				break
+			}
+		}
+	}
+
+	secrets, copiedCerts, err := copySecretsForCerts(req, svc, toCopy)
+	if err != nil {
+		return nil, err
+	}
+	result = append(result, secrets...)
+
+	secretNames := map[string]string{}
+	for i, cert := range toCopy {
+		secretNames[cert.SecretName] = copiedCerts[i].SecretName
+	}
+
+	for _, host := range hosts {
+		if seen[host.Hostname] {
+			continue
+		}
+		seen[host.Hostname] = true
+
+		var certificate string
+		if cert, ok := certs[host.Hostname]; ok {
+			certificate = svc.Namespace + "/" + secretNames[cert.SecretName]
+		} else if host.Custom && z.Dereference(cfg.CertManagerIssuer) != "" {
+			certificate = system.Namespace + "/" + name.SafeConcatName(system.GatewayName, "cm-cert", hash(8, host.Hostname))
+		}
+
+		proto := v1.PublishProtocolHTTP
+		if certificate != "" {
+			proto = v1.PublishProtocolHTTPS
+		}
+
+		svc.Status.Endpoints = append(svc.Status.Endpoints, v1.Endpoint{
+			Address:         host.Hostname,
+			PublishProtocol: proto,
+			Path:            host.Port.Path,
+		})
+
+		targetJSON, err := json.Marshal(map[string]Target{
+			host.Hostname: {Port: host.Port.TargetPort, Path: host.Port.Path, Service: svc.Name},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		annotations := labels.Merge(svc.Spec.Annotations, map[string]string{
+			labels.AcornTargets: string(targetJSON),
+		})
+		if certificate != "" {
+			annotations[labels.AcornGatewayCertificate] = certificate
+		}
+
+		result = append(result, &gatewayv1beta1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name.SafeConcatName(svc.Name, hash(8, host.Hostname)),
+				Namespace:   svc.Namespace,
+				Labels:      svc.Spec.Labels,
+				Annotations: annotations,
+			},
+			Spec: gatewayv1beta1.HTTPRouteSpec{
+				CommonRouteSpec: gatewayv1beta1.CommonRouteSpec{
+					ParentRefs: []gatewayv1beta1.ParentReference{{
+						Namespace: z.Pointer(gatewayv1beta1.Namespace(system.Namespace)),
+						Name:      system.GatewayName,
+					}},
+				},
+				Hostnames: []gatewayv1beta1.Hostname{gatewayv1beta1.Hostname(host.Hostname)},
+				Rules:     getHTTPRouteRules(svc, host.Port.Port),
+			},
+		})
+	}
+
+	if len(copiedCerts) > 0 {
+		result = append(result, certificateGrant(svc, copiedCerts))
+	}
+
+	return result, nil
+}
+
+// certificateGrant allows the shared Gateway to use the certificates copied to the namespace of the service.
+func certificateGrant(svc *v1.ServiceInstance, certs []TLSCert) *gatewayv1beta1.ReferenceGrant {
+	grant := &gatewayv1beta1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name.SafeConcatName(svc.Name, system.GatewayName),
+			Namespace: svc.Namespace,
+			Labels:    svc.Spec.Labels,
+		},
+		Spec: gatewayv1beta1.ReferenceGrantSpec{
+			From: []gatewayv1beta1.ReferenceGrantFrom{{
+				Group:     gatewayv1beta1.GroupName,
+				Kind:      "Gateway",
+				Namespace: system.Namespace,
+			}},
+		},
+	}
+	for _, cert := range certs {
+		grant.Spec.To = append(grant.Spec.To, gatewayv1beta1.ReferenceGrantTo{
+			Kind: "Secret",
+			Name: z.Pointer(gatewayv1beta1.ObjectName(cert.SecretName)),
+		})
+	}
+	return grant
+}
+
+// SharedGateway returns the Gateway in the system namespace that the HTTPRoutes of all services are attached to. It
+// accepts plain HTTP for any hostname, and has an HTTPS listener for each hostname of the routes that has a
+// certificate.
+func SharedGateway(req router.Request, cfg *apiv1.Config) (*gatewayv1beta1.Gateway, error) {
+	var routes gatewayv1beta1.HTTPRouteList
+	if err := req.List(&routes, &kclient.ListOptions{
+		LabelSelector: klabels.SelectorFromSet(map[string]string{
+			labels.AcornManaged: "true",
+		}),
+	}); err != nil {
+		return nil, err
+	}
+	sort.Slice(routes.Items, func(i, j int) bool {
+		if routes.Items[i].Namespace != routes.Items[j].Namespace {
+			return routes.Items[i].Namespace < routes.Items[j].Namespace
+		}
+		return routes.Items[i].Name < routes.Items[j].Name
+	})
+
+	var (
+		annotations   = map[string]string{}
+		seen          = map[gatewayv1beta1.Hostname]bool{}
+		allowedRoutes = &gatewayv1beta1.AllowedRoutes{
+			Namespaces: &gatewayv1beta1.RouteNamespaces{
+				From: z.Pointer(gatewayv1beta1.NamespacesFromAll),
+			},
+		}
+		listeners = []gatewayv1beta1.Listener{{
+			Name:          "http",
+			Port:          80,
+			Protocol:      gatewayv1beta1.HTTPProtocolType,
+			AllowedRoutes: allowedRoutes,
+		}}
+	)
+
+	for _, route := range routes.Items {
+		namespace, secretName, ok := strings.Cut(route.Annotations[labels.AcornGatewayCertificate], "/")
+		if !ok {
+			continue
+		}
+
+		certificateRef := gatewayv1beta1.SecretObjectReference{
+			Name: gatewayv1beta1.ObjectName(secretName),
+		}
+		if namespace == system.Namespace {
+			// cert-manager only issues the certificates referenced from the namespace of the Gateway, which are the
+			// ones of custom domains without a certificate of their own
+			if z.Dereference(cfg.CertManagerIssuer) == "" {
+				continue
+			}
+			annotations["cert-manager.io/cluster-issuer"] = *cfg.CertManagerIssuer
+		} else {
+			certificateRef.Namespace = z.Pointer(gatewayv1beta1.Namespace(namespace))
+		}
+
+		for _, hostname := range route.Spec.Hostnames {
+			if seen[hostname] {
+				continue
+			}
+			seen[hostname] = true
+
+			listeners = append(listeners, gatewayv1beta1.Listener{
+				Name:     gatewayv1beta1.SectionName("https-" + hash(8, string(hostname))),
+				Hostname: z.Pointer(hostname),
+				Port:     443,
+				Protocol: gatewayv1beta1.HTTPSProtocolType,
+				TLS: &gatewayv1beta1.GatewayTLSConfig{
+					Mode:            z.Pointer(gatewayv1beta1.TLSModeTerminate),
+					CertificateRefs: []gatewayv1beta1.SecretObjectReference{certificateRef},
+				},
+				AllowedRoutes: allowedRoutes,
+			})
+		}
+	}
+
+	return &gatewayv1beta1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      system.GatewayName,
+			Namespace: system.Namespace,
+			Labels: map[string]string{
+				labels.AcornManaged: "true",
+			},
+			Annotations: annotations,
+		},
+		Spec: gatewayv1beta1.GatewaySpec{
+			GatewayClassName: gatewayv1beta1.ObjectName(*cfg.GatewayClassName),
+			Listeners:        listeners,
+		},
+	}, nil
+}
+
+func getHTTPRouteRules(svc *v1.ServiceInstance, port int32) []gatewayv1beta1.HTTPRouteRule {
+	if len(svc.Spec.Routes) > 0 {
+		return routerHTTPRouteRules(svc.Name, svc.Spec.Routes)
+	}
+
+	return []gatewayv1beta1.HTTPRouteRule{{
+		Matches: []gatewayv1beta1.HTTPRouteMatch{{
+			Path: &gatewayv1beta1.HTTPPathMatch{
+				Type:  z.Pointer(gatewayv1beta1.PathMatchPathPrefix),
+				Value: z.Pointer("/"),
+			},
+		}},
+		BackendRefs: []gatewayv1beta1.HTTPBackendRef{{
+			BackendRef: backendRef(svc.Name, port, nil),
+		}},
+	}}
+}
+
+func tcpGateways(cfg *apiv1.Config, svc *v1.ServiceInstance, bindings ports.BoundPorts) (result []kclient.Object, _ error) {
+	var (
+		gatewayName = name.SafeConcatName(svc.Name, tcpGateway)
+		listeners   []gatewayv1beta1.Listener
+		targets     = map[string]Target{}
+	)
+
+	for _, entry := range typed.Sorted(bindings.ByHostname()) {
+		hostname := entry.Key
+		for _, port := range entry.Value {
+			port = port.Complete()
+			target := Target{Port: port.TargetPort, Service: svc.Name, Protocol: v1.ProtocolTCP}
+			parentRef := gatewayv1beta1.ParentReference{Name: gatewayv1beta1.ObjectName(gatewayName)}
+			backendRefs := []gatewayv1alpha2.BackendRef{backendRef(svc.Name, port.Port, nil)}
+
+			if hostname == "" {
+				listenerName := gatewayv1beta1.SectionName(fmt.Sprintf("tcp-%d", port.Port))
+				parentRef.SectionName = &listenerName
+				listeners = append(listeners, gatewayv1beta1.Listener{
+					Name:     listenerName,
+					Port:     gatewayv1beta1.PortNumber(port.Port),
+					Protocol: gatewayv1beta1.TCPProtocolType,
+				})
+				targets[strconv.Itoa(int(port.Port))] = target
+				result = append(result, &gatewayv1alpha2.TCPRoute{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      name.SafeConcatName(svc.Name, tcpGateway, strconv.Itoa(int(port.Port))),
+						Namespace: svc.Namespace,
+						Labels:    svc.Spec.Labels,
+					},
+					Spec: gatewayv1alpha2.TCPRouteSpec{
+						CommonRouteSpec: gatewayv1beta1.CommonRouteSpec{ParentRefs: []gatewayv1beta1.ParentReference{parentRef}},
+						Rules:           []gatewayv1alpha2.TCPRouteRule{{BackendRefs: backendRefs}},
+					},
+				})
+				continue
+			}
+
+			// TCP ports bound to a hostname are routed by SNI and the TLS connection is passed through to the service
+			listenerName := gatewayv1beta1.SectionName(fmt.Sprintf("tls-%d-%s", port.Port, hash(8, hostname)))
+			parentRef.SectionName = &listenerName
+			listeners = append(listeners, gatewayv1beta1.Listener{
+				Name:     listenerName,
+				Hostname: z.Pointer(gatewayv1beta1.Hostname(hostname)),
+				Port:     gatewayv1beta1.PortNumber(port.Port),
+				Protocol: gatewayv1beta1.TLSProtocolType,
+				TLS: &gatewayv1beta1.GatewayTLSConfig{
+					Mode: z.Pointer(gatewayv1beta1.TLSModePassthrough),
+				},
+			})
+			targets[fmt.Sprintf("%s:%d", hostname, port.Port)] = target
+			result = append(result, &gatewayv1alpha2.TLSRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name.SafeConcatName(svc.Name, tcpGateway, strconv.Itoa(int(port.Port)), hash(8, hostname)),
+					Namespace: svc.Namespace,
+					Labels:    svc.Spec.Labels,
+				},
+				Spec: gatewayv1alpha2.TLSRouteSpec{
+					CommonRouteSpec: gatewayv1beta1.CommonRouteSpec{ParentRefs: []gatewayv1beta1.ParentReference{parentRef}},
+					Hostnames:       []gatewayv1beta1.Hostname{gatewayv1beta1.Hostname(hostname)},
+					Rules:           []gatewayv1alpha2.TLSRouteRule{{BackendRefs: backendRefs}},
+				},
+			})
+		}
+	}
+
+	gateway, err := toGateway(cfg, svc, gatewayName, maps.Clone(svc.Spec.Annotations), listeners, targets)
+	if err != nil {
+		return nil, err
+	}
+	return append(result, gateway), nil
+}
+
+func toGateway(cfg *apiv1.Config, svc *v1.ServiceInstance, gatewayName string, annotations map[string]string, listeners []gatewayv1beta1.Listener, targets map[string]Target) (*gatewayv1beta1.Gateway, error) {
+	targetJSON, err := json.Marshal(targets)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gatewayv1beta1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      gatewayName,
+			Namespace: svc.Namespace,
+			Labels:    svc.Spec.Labels,
+			Annotations: labels.Merge(annotations, map[string]string{
+				labels.AcornTargets: string(targetJSON),
+			}),
+		},
+		Spec: gatewayv1beta1.GatewaySpec{
+			GatewayClassName: gatewayv1beta1.ObjectName(*cfg.GatewayClassName),
+			Listeners:        listeners,
+		},
+	}, nil
+}
+
+func backendRef(serviceName string, port int32, weight *int32) gatewayv1beta1.BackendRef {
+	return gatewayv1beta1.BackendRef{
+		BackendObjectReference: gatewayv1beta1.BackendObjectReference{
+			Name: gatewayv1beta1.ObjectName(serviceName),
+			Port: z.Pointer(gatewayv1beta1.PortNumber(port)),
+		},
+		Weight: weight,
+	}
+}
@@ -0,0 +1,72 @@
+package publish
+
+import (
+	"context"
+	"testing"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/acorn-io/runtime/pkg/system"
+	"github.com/acorn-io/z"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+func TestValidateBackend(t *testing.T) {
+	assert.NoError(t, ValidateBackend(&apiv1.Config{}))
+	assert.NoError(t, ValidateBackend(&apiv1.Config{PublishBackend: z.Pointer(BackendIngress)}))
+	assert.NoError(t, ValidateBackend(&apiv1.Config{PublishBackend: z.Pointer(BackendGateway), GatewayClassName: z.Pointer("example")}))
+	assert.ErrorContains(t, ValidateBackend(&apiv1.Config{PublishBackend: z.Pointer(BackendGateway)}), "gateway-class-name must be set")
+	assert.ErrorContains(t, ValidateBackend(&apiv1.Config{PublishBackend: z.Pointer("traefik")}), "invalid publish backend [traefik]")
+}
+
+func TestSharedGateway(t *testing.T) {
+	route := func(namespace, name, hostname, certificate string) *gatewayv1beta1.HTTPRoute {
+		return &gatewayv1beta1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   namespace,
+				Labels:      map[string]string{labels.AcornManaged: "true"},
+				Annotations: map[string]string{labels.AcornGatewayCertificate: certificate},
+			},
+			Spec: gatewayv1beta1.HTTPRouteSpec{
+				Hostnames: []gatewayv1beta1.Hostname{gatewayv1beta1.Hostname(hostname)},
+			},
+		}
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+		route("app-one", "web", "web.example.com", "app-one/web-cert"),
+		route("app-one", "api", "api.example.com", ""),
+		route("app-two", "web", "web.custom.io", system.Namespace+"/web-cm-cert"),
+	).Build()
+
+	cfg := &apiv1.Config{GatewayClassName: z.Pointer("example"), CertManagerIssuer: z.Pointer("letsencrypt")}
+	gateway, err := SharedGateway(router.Request{Ctx: context.Background(), Client: c}, cfg)
+	require.NoError(t, err)
+
+	assert.Equal(t, system.GatewayName, gateway.Name)
+	assert.Equal(t, system.Namespace, gateway.Namespace)
+	assert.Equal(t, gatewayv1beta1.ObjectName("example"), gateway.Spec.GatewayClassName)
+	assert.Equal(t, "letsencrypt", gateway.Annotations["cert-manager.io/cluster-issuer"])
+
+	require.Len(t, gateway.Spec.Listeners, 3)
+	assert.Equal(t, gatewayv1beta1.HTTPProtocolType, gateway.Spec.Listeners[0].Protocol)
+	assert.Nil(t, gateway.Spec.Listeners[0].Hostname)
+
+	assert.Equal(t, gatewayv1beta1.Hostname("web.example.com"), *gateway.Spec.Listeners[1].Hostname)
+	assert.Equal(t, []gatewayv1beta1.SecretObjectReference{{
+		Name:      "web-cert",
+		Namespace: z.Pointer(gatewayv1beta1.Namespace("app-one")),
+	}}, gateway.Spec.Listeners[1].TLS.CertificateRefs)
+
+	assert.Equal(t, gatewayv1beta1.Hostname("web.custom.io"), *gateway.Spec.Listeners[2].Hostname)
+	assert.Equal(t, []gatewayv1beta1.SecretObjectReference{{
+		Name: "web-cm-cert",
+	}}, gateway.Spec.Listeners[2].TLS.CertificateRefs)
+}
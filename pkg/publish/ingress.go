@@ -16,6 +16,7 @@ import (
 	"github.com/acorn-io/baaah/pkg/name"
 	"github.com/acorn-io/baaah/pkg/router"
 	"github.com/acorn-io/baaah/pkg/typed"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/config"
 	"github.com/acorn-io/runtime/pkg/labels"
@@ -155,9 +156,10 @@ func hash(limit int, s string) string {
 }
 
 type Target struct {
-	Port    int32  `json:"port,omitempty"`
-	Path    string `json:"path,omitempty"`
-	Service string `json:"service,omitempty"`
+	Port     int32       `json:"port,omitempty"`
+	Path     string      `json:"path,omitempty"`
+	Service  string      `json:"service,omitempty"`
+	Protocol v1.Protocol `json:"protocol,omitempty"`
 }
 
 func Ingress(req router.Request, svc *v1.ServiceInstance) (result []kclient.Object, _ error) {
@@ -181,6 +183,10 @@ func Ingress(req router.Request, svc *v1.ServiceInstance) (result []kclient.Obje
 		return nil, err
 	}
 
+	if UsesGateway(cfg) {
+		return nil, nil
+	}
+
 	ingressClassName := cfg.IngressClassName
 	if ingressClassName == nil {
 		ingressClassName, err = IngressClassNameIfNoDefault(req.Ctx, req.Client)
@@ -189,80 +195,77 @@ func Ingress(req router.Request, svc *v1.ServiceInstance) (result []kclient.Obje
 		}
 	}
 
-	dnsSecret := &corev1.Secret{}
-	err = req.Client.Get(req.Ctx, router.Key(system.Namespace, system.DNSSecretName), dnsSecret)
-	if kclient.IgnoreNotFound(err) != nil {
+	acornDNSDomain, err := getAcornDNSDomain(req)
+	if err != nil {
+		return nil, err
+	}
+
+	hosts, err := publishedHosts(cfg, svc, appInstance, acornDNSDomain, bindings)
+	if err != nil {
 		return nil, err
 	}
-	acornDNSDomain := string(dnsSecret.Data["domain"])
 
+	// Separate rules for acorn domain and custom domain
+	// This is needed to have separate ingress resources so that for custom domain, we can apply cert-manager setting to request certificate,
+	// while keeping acorn domain certs as it is with acorn's built-in LE feature for wildcard DNS certificates.
+	// Ports with a policy get ingresses of their own so that the policy does not apply to the other ports.
 	var (
-		// Separate rules for acorn domain and custom domain
-		// This is needed to have separate ingress resources so that for custom domain, we can apply cert-manager setting to request certificate,
-		// while keeping acorn domain certs as it is with acorn's built-in LE feature for wildcard DNS certificates.
-		customDomainRules   []networkingv1.IngressRule
-		acornDomainRules    []networkingv1.IngressRule
-		customDomainTargets = map[string]Target{}
-		acornDomainTargets  = map[string]Target{}
+		groups            []*ingressGroup
+		groupIndex        = map[string]*ingressGroup{}
+		controller        string
+		policyAnnotations = map[int32]map[string]string{}
 	)
+	for _, custom := range []bool{false, true} {
+		for _, host := range hosts {
+			if host.Custom != custom {
+				continue
+			}
 
-	for _, entry := range typed.Sorted(bindings.ByHostname()) {
-		hostname := entry.Key
-		ports := typed.MapSlice(entry.Value, func(p v1.PortDef) v1.PortDef {
-			return p.Complete()
-		})
-		if hostname == "" {
-			for i, port := range ports {
-				targetName := svc.Name
-				if i > 0 {
-					targetName = name.SafeConcatName(targetName, fmt.Sprint(port.Port))
-				}
+			groupName := acornDomain
+			if custom {
+				groupName = customDomain
+			}
+			if host.Port.Policy != nil {
+				groupName = name.SafeConcatName(groupName, strconv.Itoa(int(host.Port.Port)))
+			}
 
-				for _, domain := range cfg.ClusterDomains {
-					hostname, err := toHTTPEndpointHostname(*cfg.HTTPEndpointPattern, domain, targetName, svc.Spec.AppName, svc.Spec.AppNamespace, appInstance)
-					if err != nil {
-						return nil, err
+			group, ok := groupIndex[groupName]
+			if !ok {
+				group = &ingressGroup{name: groupName, custom: custom, targets: map[string]Target{}}
+				if host.Port.Policy != nil {
+					if controller == "" {
+						if controller, err = ingressController(req.Ctx, req.Client, ingressClassName); err != nil {
+							return nil, err
+						}
 					}
-
-					target := Target{Port: port.TargetPort, Path: port.Path, Service: svc.Name}
-					rule := getIngressRule(svc, hostname, port.Port)
-
-					if domain == acornDNSDomain || strings.HasSuffix(domain, profiles.ClusterDomainDefault) {
-						acornDomainTargets[hostname] = target
-						acornDomainRules = append(acornDomainRules, rule)
-					} else {
-						customDomainTargets[hostname] = target
-						customDomainRules = append(customDomainRules, rule)
+					// The resources of a policy are shared by the acorn and custom domain ingresses of the port
+					annotations, ok := policyAnnotations[host.Port.Port]
+					if !ok {
+						annotations, group.objs, err = policyResources(req, svc, controller, host.Port)
+						if err != nil {
+							return nil, err
+						}
+						policyAnnotations[host.Port.Port] = annotations
 					}
+					group.annotations = annotations
 				}
+				groupIndex[groupName] = group
+				groups = append(groups, group)
 			}
-		} else {
-			if len(ports) > 1 {
-				return nil, fmt.Errorf("multiple ports bound to the same hostname [%s]", hostname)
-			}
-			customDomainTargets[hostname] = Target{Port: ports[0].TargetPort, Path: ports[0].Path, Service: svc.Name}
-			customDomainRules = append(customDomainRules, getIngressRule(svc, hostname, ports[0].Port))
+
+			group.targets[host.Hostname] = Target{Port: host.Port.TargetPort, Path: host.Port.Path, Service: svc.Name}
+			group.rules = append(group.rules, getIngressRule(svc, host.Hostname, host.Port.Port))
 		}
 	}
 
-	for _, rules := range []struct {
-		rules  []networkingv1.IngressRule
-		name   string
-		target map[string]Target
-	}{
-		{rules: acornDomainRules, name: acornDomain, target: acornDomainTargets},
-		{rules: customDomainRules, name: customDomain, target: customDomainTargets},
-	} {
-		if len(rules.rules) == 0 {
-			continue
-		}
+	for _, rules := range groups {
 		// For custom domain, always use cert-manager to provision certificate.
-		secrets, ingressTLS, ingressAnnotation, err := setupCertsForRules(req, svc, rules.rules, rules.name == customDomain, *cfg.CertManagerIssuer)
+		secrets, ingressTLS, ingressAnnotation, err := setupCertsForRules(req, svc, rules.rules, rules.custom, *cfg.CertManagerIssuer)
 		if err != nil {
 			return nil, err
 		}
 
-		targetJSON, err := json.Marshal(rules.target)
+		targetJSON, err := json.Marshal(rules.targets)
 		if err != nil {
 			return nil, err
 		}
@@ -281,7 +284,7 @@ func Ingress(req router.Request, svc *v1.ServiceInstance) (result []kclient.Obje
 			svc.Status.Endpoints = append(svc.Status.Endpoints, v1.Endpoint{
 				Address:         rule.Host,
 				PublishProtocol: proto,
-				Path:            rules.target[rule.Host].Path,
+				Path:            rules.targets[rule.Host].Path,
 			})
 		}
 
@@ -290,7 +293,7 @@ func Ingress(req router.Request, svc *v1.ServiceInstance) (result []kclient.Obje
 				Name:      name.SafeConcatName(svc.Name, rules.name),
 				Namespace: svc.Namespace,
 				Labels:    svc.Spec.Labels,
-				Annotations: labels.Merge(ingressAnnotation, map[string]string{
+				Annotations: labels.Merge(labels.Merge(ingressAnnotation, rules.annotations), map[string]string{
 					labels.AcornTargets: string(targetJSON),
 				}),
 			},
@@ -303,11 +306,80 @@ func Ingress(req router.Request, svc *v1.ServiceInstance) (result []kclient.Obje
 		result = append(result, ingress)
 
 		result = append(result, secrets...)
+		result = append(result, rules.objs...)
 	}
 
 	return
 }
 
+// ingressGroup is the rules, targets and policy resources of one ingress of a service.
+type ingressGroup struct {
+	name        string
+	custom      bool
+	rules       []networkingv1.IngressRule
+	targets     map[string]Target
+	annotations map[string]string
+	objs        []kclient.Object
+}
+
+// publishedHost is a hostname an HTTP port of a service is published on.
+type publishedHost struct {
+	Hostname string
+	Port     v1.PortDef
+	// Custom is true if the hostname is not in the acorn DNS domain or the default cluster domain
+	Custom bool
+}
+
+// publishedHosts returns the hostnames the bound HTTP ports of the service are published on. Ports bound without a
+// hostname are published on a hostname generated from the endpoint pattern for each cluster domain.
+func publishedHosts(cfg *apiv1.Config, svc *v1.ServiceInstance, appInstance *v1.AppInstance, acornDNSDomain string, bindings ports.BoundPorts) (result []publishedHost, _ error) {
+	for _, entry := range typed.Sorted(bindings.ByHostname()) {
+		hostname := entry.Key
+		ports := typed.MapSlice(entry.Value, func(p v1.PortDef) v1.PortDef {
+			return p.Complete()
+		})
+		if hostname == "" {
+			for i, port := range ports {
+				targetName := svc.Name
+				if i > 0 {
+					targetName = name.SafeConcatName(targetName, fmt.Sprint(port.Port))
+				}
+
+				for _, domain := range cfg.ClusterDomains {
+					hostname, err := toHTTPEndpointHostname(*cfg.HTTPEndpointPattern, domain, targetName, svc.Spec.AppName, svc.Spec.AppNamespace, appInstance)
+					if err != nil {
+						return nil, err
+					}
+					result = append(result, publishedHost{
+						Hostname: hostname,
+						Port:     port,
+						Custom:   domain != acornDNSDomain && !strings.HasSuffix(domain, profiles.ClusterDomainDefault),
+					})
+				}
+			}
+		} else {
+			if len(ports) > 1 {
+				return nil, fmt.Errorf("multiple ports bound to the same hostname [%s]", hostname)
+			}
+			result = append(result, publishedHost{
+				Hostname: hostname,
+				Port:     ports[0],
+				Custom:   true,
+			})
+		}
+	}
+	return result, nil
+}
+
+func getAcornDNSDomain(req router.Request) (string, error) {
+	dnsSecret := &corev1.Secret{}
+	err := req.Client.Get(req.Ctx, router.Key(system.Namespace, system.DNSSecretName), dnsSecret)
+	if kclient.IgnoreNotFound(err) != nil {
+		return "", err
+	}
+	return string(dnsSecret.Data["domain"]), nil
+}
+
 func setupCertManager(serviceName string, rules []networkingv1.IngressRule) []networkingv1.IngressTLS {
 	var result []networkingv1.IngressTLS
 	hostsSeen := map[string]bool{}
@@ -348,7 +420,7 @@ func getIngressRule(svc *v1.ServiceInstance, host string, port int32) networking
 	host, _, _ = strings.Cut(host, ":")
 
 	if len(svc.Spec.Routes) > 0 {
-		return routerRule(host, svc.Spec.Routes)
+		return routerRule(svc.Name, host, svc.Spec.Routes)
 	}
 
 	return networkingv1.IngressRule{
@@ -0,0 +1,281 @@
+package publish
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/acorn-io/baaah/pkg/name"
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/baaah/pkg/uncached"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"golang.org/x/crypto/bcrypt"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	IngressControllerTraefik = "traefik.io/ingress-controller"
+	IngressControllerNginx   = "k8s.io/ingress-nginx"
+
+	defaultIngressClassAnnotation = "ingressclass.kubernetes.io/is-default-class"
+	traefikMiddlewaresAnnotation  = "traefik.ingress.kubernetes.io/router.middlewares"
+	nginxAnnotationPrefix         = "nginx.ingress.kubernetes.io/"
+)
+
+var (
+	TraefikMiddlewareGVK     = schema.GroupVersionKind{Group: "traefik.io", Version: "v1alpha1", Kind: "Middleware"}
+	traefikMiddlewareListGVK = TraefikMiddlewareGVK.GroupVersion().WithKind("MiddlewareList")
+)
+
+// ValidatePolicy checks that the fields of a port policy are valid, it does not check that the policy can be enforced
+// in the cluster.
+func ValidatePolicy(policy v1.PortPolicy) error {
+	if policy.Auth != nil {
+		if policy.Auth.BasicSecret != "" && policy.Auth.ForwardURL != "" {
+			return fmt.Errorf("only one of basic or forward auth can be set")
+		}
+		if policy.Auth.BasicSecret == "" && policy.Auth.ForwardURL == "" {
+			return fmt.Errorf("auth must set a basic secret or a forward URL")
+		}
+		if policy.Auth.ForwardURL != "" {
+			u, err := url.Parse(policy.Auth.ForwardURL)
+			if err != nil {
+				return fmt.Errorf("invalid forward auth URL [%s]: %w", policy.Auth.ForwardURL, err)
+			}
+			if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return fmt.Errorf("invalid forward auth URL [%s], must be an absolute http or https URL", policy.Auth.ForwardURL)
+			}
+		}
+	}
+	for _, cidr := range policy.AllowedCIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil && net.ParseIP(cidr) == nil {
+			return fmt.Errorf("invalid allowed CIDR [%s]", cidr)
+		}
+	}
+	if policy.RateLimit != nil {
+		if policy.RateLimit.RequestsPerSecond <= 0 {
+			return fmt.Errorf("rate limit must be greater than 0 requests per second")
+		}
+		if policy.RateLimit.Burst < 0 {
+			return fmt.Errorf("rate limit burst must not be negative")
+		}
+	}
+	return nil
+}
+
+// CheckPolicySupport returns an error if port policies can not be enforced by the ingress controller ports are published
+// with. Policies are never published without being enforced, so an app with policies should not be run in that case.
+func CheckPolicySupport(ctx context.Context, c kclient.Client, cfg *apiv1.Config) error {
+	if UsesGateway(cfg) {
+		return fmt.Errorf("port policies are not supported with the %s publish backend", BackendGateway)
+	}
+
+	ingressClassName := cfg.IngressClassName
+	if ingressClassName == nil {
+		var err error
+		ingressClassName, err = IngressClassNameIfNoDefault(ctx, c)
+		if err != nil {
+			return err
+		}
+	}
+
+	controller, err := ingressController(ctx, c, ingressClassName)
+	if err != nil {
+		return err
+	}
+
+	switch controller {
+	case IngressControllerNginx:
+		return nil
+	case IngressControllerTraefik:
+		middlewares := &unstructured.UnstructuredList{}
+		middlewares.SetGroupVersionKind(traefikMiddlewareListGVK)
+		if err := c.List(ctx, uncached.List(middlewares), kclient.Limit(1)); meta.IsNoMatchError(err) || apierrors.IsNotFound(err) {
+			return fmt.Errorf("port policies require the traefik %s CRD to be installed", TraefikMiddlewareGVK.Kind)
+		} else if err != nil {
+			return err
+		}
+		return nil
+	case "":
+		return fmt.Errorf("port policies require an ingress class")
+	}
+	return fmt.Errorf("port policies are not supported by ingress controller [%s]", controller)
+}
+
+// ingressController returns the controller of the named ingress class, or of the default ingress class if name is nil.
+func ingressController(ctx context.Context, c kclient.Client, ingressClassName *string) (string, error) {
+	var ingressClasses networkingv1.IngressClassList
+	if err := c.List(ctx, &ingressClasses); err != nil {
+		return "", err
+	}
+	for _, ic := range ingressClasses.Items {
+		if ingressClassName != nil && ic.Name == *ingressClassName {
+			return ic.Spec.Controller, nil
+		}
+		if ingressClassName == nil && ic.Annotations[defaultIngressClassAnnotation] == "true" {
+			return ic.Spec.Controller, nil
+		}
+	}
+	return "", nil
+}
+
+// policyResources returns the annotations to add to the ingress of a port with a policy and the objects the ingress
+// controller needs to enforce the policy.
+func policyResources(req router.Request, svc *v1.ServiceInstance, controller string, port v1.PortDef) (map[string]string, []kclient.Object, error) {
+	var (
+		policy      = port.Policy
+		baseName    = name.SafeConcatName(svc.Name, strconv.Itoa(int(port.Port)))
+		annotations = map[string]string{}
+		objs        []kclient.Object
+	)
+
+	if err := ValidatePolicy(*policy); err != nil {
+		return nil, nil, err
+	}
+
+	var authSecret *corev1.Secret
+	if policy.Auth != nil && policy.Auth.BasicSecret != "" {
+		key := "users"
+		if controller == IngressControllerNginx {
+			key = "auth"
+		}
+		var err error
+		authSecret, err = basicAuthSecret(req, svc, policy.Auth.BasicSecret, name.SafeConcatName(baseName, "basic-auth"), key)
+		if err != nil {
+			return nil, nil, err
+		}
+		objs = append(objs, authSecret)
+	}
+
+	switch controller {
+	case IngressControllerNginx:
+		if len(policy.AllowedCIDRs) > 0 {
+			annotations[nginxAnnotationPrefix+"whitelist-source-range"] = strings.Join(policy.AllowedCIDRs, ",")
+		}
+		if policy.RateLimit != nil {
+			annotations[nginxAnnotationPrefix+"limit-rps"] = strconv.Itoa(int(policy.RateLimit.RequestsPerSecond))
+			if policy.RateLimit.Burst > 0 {
+				// nginx allows a burst of a multiple of the rate, so round the burst up to the next multiple
+				multiplier := (policy.RateLimit.Burst + policy.RateLimit.RequestsPerSecond - 1) / policy.RateLimit.RequestsPerSecond
+				annotations[nginxAnnotationPrefix+"limit-burst-multiplier"] = strconv.Itoa(int(multiplier))
+			}
+		}
+		if authSecret != nil {
+			annotations[nginxAnnotationPrefix+"auth-type"] = "basic"
+			annotations[nginxAnnotationPrefix+"auth-secret"] = authSecret.Name
+			annotations[nginxAnnotationPrefix+"auth-secret-type"] = "auth-file"
+		} else if policy.Auth != nil {
+			annotations[nginxAnnotationPrefix+"auth-url"] = policy.Auth.ForwardURL
+		}
+	case IngressControllerTraefik:
+		var middlewares []string
+		addMiddleware := func(suffix, kind string, spec map[string]any) {
+			middleware := &unstructured.Unstructured{
+				Object: map[string]any{
+					"spec": map[string]any{
+						kind: spec,
+					},
+				},
+			}
+			middleware.SetGroupVersionKind(TraefikMiddlewareGVK)
+			middleware.SetName(name.SafeConcatName(baseName, suffix))
+			middleware.SetNamespace(svc.Namespace)
+			middleware.SetLabels(svc.Spec.Labels)
+			objs = append(objs, middleware)
+			middlewares = append(middlewares, fmt.Sprintf("%s-%s@kubernetescrd", middleware.GetNamespace(), middleware.GetName()))
+		}
+
+		if len(policy.AllowedCIDRs) > 0 {
+			sourceRange := make([]any, 0, len(policy.AllowedCIDRs))
+			for _, cidr := range policy.AllowedCIDRs {
+				sourceRange = append(sourceRange, cidr)
+			}
+			addMiddleware("allow", "ipWhiteList", map[string]any{
+				"sourceRange": sourceRange,
+			})
+		}
+		if policy.RateLimit != nil {
+			rateLimit := map[string]any{
+				"average": int64(policy.RateLimit.RequestsPerSecond),
+			}
+			if policy.RateLimit.Burst > 0 {
+				rateLimit["burst"] = int64(policy.RateLimit.Burst)
+			}
+			addMiddleware("rate-limit", "rateLimit", rateLimit)
+		}
+		if authSecret != nil {
+			addMiddleware("auth", "basicAuth", map[string]any{
+				"secret": authSecret.Name,
+			})
+		} else if policy.Auth != nil {
+			addMiddleware("auth", "forwardAuth", map[string]any{
+				"address": policy.Auth.ForwardURL,
+			})
+		}
+
+		annotations[traefikMiddlewaresAnnotation] = strings.Join(middlewares, ",")
+	default:
+		return nil, nil, fmt.Errorf("port policies are not supported by ingress controller [%s]", controller)
+	}
+
+	return annotations, objs, nil
+}
+
+// basicAuthSecret returns a secret with an htpasswd file for the username and password of the basic secret of the app.
+// The password is hashed with bcrypt, which is salted, so the file is only regenerated when the basic secret changes and
+// is otherwise copied from the existing secret so that it is the same every time the service is reconciled.
+func basicAuthSecret(req router.Request, svc *v1.ServiceInstance, basicSecret, secretName, key string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	if err := req.Get(secret, svc.Namespace, basicSecret); err != nil {
+		return nil, fmt.Errorf("getting basic auth secret [%s]: %w", basicSecret, err)
+	}
+
+	username, password := string(secret.Data[corev1.BasicAuthUsernameKey]), secret.Data[corev1.BasicAuthPasswordKey]
+	if username == "" || len(password) == 0 {
+		return nil, fmt.Errorf("basic auth secret [%s] must have a username and password", basicSecret)
+	}
+
+	result := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretName,
+			Namespace: svc.Namespace,
+			Labels:    svc.Spec.Labels,
+			Annotations: map[string]string{
+				labels.AcornSecretRevPrefix + basicSecret: secret.ResourceVersion,
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+	}
+
+	existing := &corev1.Secret{}
+	if err := req.Get(existing, svc.Namespace, secretName); err == nil &&
+		existing.Annotations[labels.AcornSecretRevPrefix+basicSecret] == secret.ResourceVersion && len(existing.Data[key]) > 0 {
+		result.Data = map[string][]byte{
+			key: existing.Data[key],
+		}
+		return result, nil
+	} else if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	hash, err := bcrypt.GenerateFromPassword(password, bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+	result.Data = map[string][]byte{
+		key: []byte(username + ":" + string(hash) + "\n"),
+	}
+	return result, nil
+}
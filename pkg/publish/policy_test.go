@@ -0,0 +1,95 @@
+package publish
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/acorn-io/z"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestValidatePolicy(t *testing.T) {
+	assert.NoError(t, ValidatePolicy(v1.PortPolicy{
+		Auth:         &v1.PortAuth{ForwardURL: "https://auth.example.com/oauth2/auth"},
+		AllowedCIDRs: []string{"10.0.0.0/8", "192.168.1.1", "2001:db8::/32"},
+		RateLimit:    &v1.RateLimit{RequestsPerSecond: 10, Burst: 20},
+	}))
+	assert.ErrorContains(t, ValidatePolicy(v1.PortPolicy{Auth: &v1.PortAuth{BasicSecret: "creds", ForwardURL: "https://auth.example.com"}}), "only one of basic or forward auth")
+	assert.ErrorContains(t, ValidatePolicy(v1.PortPolicy{Auth: &v1.PortAuth{}}), "must set a basic secret or a forward URL")
+	assert.ErrorContains(t, ValidatePolicy(v1.PortPolicy{Auth: &v1.PortAuth{ForwardURL: "/oauth2/auth"}}), "must be an absolute http or https URL")
+	assert.ErrorContains(t, ValidatePolicy(v1.PortPolicy{AllowedCIDRs: []string{"10.0.0.0/8", "internal"}}), "invalid allowed CIDR [internal]")
+	assert.ErrorContains(t, ValidatePolicy(v1.PortPolicy{RateLimit: &v1.RateLimit{Burst: 10}}), "greater than 0 requests per second")
+}
+
+func TestCheckPolicySupport(t *testing.T) {
+	ingressClass := func(name, controller string, isDefault bool) *networkingv1.IngressClass {
+		ic := &networkingv1.IngressClass{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       networkingv1.IngressClassSpec{Controller: controller},
+		}
+		if isDefault {
+			ic.Annotations = map[string]string{defaultIngressClassAnnotation: "true"}
+		}
+		return ic
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+		ingressClass("nginx", IngressControllerNginx, true),
+		ingressClass("haproxy", "haproxy.org/ingress-controller/haproxy", false),
+	).Build()
+
+	assert.NoError(t, CheckPolicySupport(context.Background(), c, &apiv1.Config{}))
+	assert.NoError(t, CheckPolicySupport(context.Background(), c, &apiv1.Config{IngressClassName: z.Pointer("nginx")}))
+	assert.ErrorContains(t, CheckPolicySupport(context.Background(), c, &apiv1.Config{IngressClassName: z.Pointer("haproxy")}),
+		"not supported by ingress controller [haproxy.org/ingress-controller/haproxy]")
+	assert.ErrorContains(t, CheckPolicySupport(context.Background(), c, &apiv1.Config{IngressClassName: z.Pointer("missing")}),
+		"require an ingress class")
+	assert.ErrorContains(t, CheckPolicySupport(context.Background(), c, &apiv1.Config{PublishBackend: z.Pointer(BackendGateway)}),
+		"not supported with the gateway publish backend")
+}
+
+func TestBasicAuthSecret(t *testing.T) {
+	svc := &v1.ServiceInstance{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "app"}}
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "app"},
+		Data: map[string][]byte{
+			corev1.BasicAuthUsernameKey: []byte("admin"),
+			corev1.BasicAuthPasswordKey: []byte("secret"),
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(source).Build()
+	req := router.Request{Client: c, Ctx: context.Background()}
+
+	secret, err := basicAuthSecret(req, svc, "creds", "web-80-basic-auth", "users")
+	if !assert.NoError(t, err) {
+		return
+	}
+	username, hash, _ := strings.Cut(strings.TrimSpace(string(secret.Data["users"])), ":")
+	assert.Equal(t, "admin", username)
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(hash), []byte("secret")))
+
+	// The file is copied from the existing secret until the basic secret changes
+	assert.NoError(t, c.Create(context.Background(), secret))
+	again, err := basicAuthSecret(req, svc, "creds", "web-80-basic-auth", "users")
+	if assert.NoError(t, err) {
+		assert.Equal(t, secret.Data, again.Data)
+	}
+
+	source.Data[corev1.BasicAuthPasswordKey] = []byte("changed")
+	assert.NoError(t, c.Update(context.Background(), source))
+	changed, err := basicAuthSecret(req, svc, "creds", "web-80-basic-auth", "users")
+	if assert.NoError(t, err) {
+		_, hash, _ := strings.Cut(strings.TrimSpace(string(changed.Data["users"])), ":")
+		assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(hash), []byte("changed")))
+	}
+}
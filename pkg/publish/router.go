@@ -1,41 +1,189 @@
 package publish
 
 import (
+	"strings"
+
+	"github.com/acorn-io/baaah/pkg/typed"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/ports"
+	"github.com/acorn-io/z"
 	networkingv1 "k8s.io/api/networking/v1"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
-func routerRule(host string, routes []v1.Route) networkingv1.IngressRule {
+// routerRule sends the requests for each route straight to the target of the route. Routes that split the requests
+// across targets, match on more than the path or rewrite the requests are sent to the router itself, which serves them
+// from its nginx config.
+func routerRule(routerName, host string, routes []v1.Route) networkingv1.IngressRule {
 	rule := networkingv1.IngressRule{
 		Host: host,
 		IngressRuleValue: networkingv1.IngressRuleValue{
 			HTTP: &networkingv1.HTTPIngressRuleValue{},
 		},
 	}
+
+	advanced := map[string]bool{}
+	for _, route := range routes {
+		if route.IsAdvanced() {
+			advanced[string(route.PathType)+route.Path] = true
+		}
+	}
+
+	seen := map[string]bool{}
 	for _, route := range routes {
-		if route.Path == "" || route.TargetServiceName == "" {
+		targets := route.GetTargets()
+		if route.Path == "" || len(targets) == 0 {
 			continue
 		}
+		key := string(route.PathType) + route.Path
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
 		pathType := networkingv1.PathTypePrefix
 		if route.PathType == v1.PathTypeExact {
 			pathType = networkingv1.PathTypeExact
 		}
-		port := route.TargetPort
-		if port == 0 {
-			port = 80
+
+		backend := networkingv1.IngressServiceBackend{
+			Name: targets[0].TargetServiceName,
+			Port: networkingv1.ServiceBackendPort{
+				Number: int32(targets[0].TargetPort),
+			},
+		}
+		if advanced[key] {
+			backend = networkingv1.IngressServiceBackend{
+				Name: routerName,
+				Port: networkingv1.ServiceBackendPort{
+					Number: ports.RouterPortDef.Port,
+				},
+			}
 		}
 		rule.IngressRuleValue.HTTP.Paths = append(rule.IngressRuleValue.HTTP.Paths, networkingv1.HTTPIngressPath{
 			Path:     route.Path,
 			PathType: &pathType,
 			Backend: networkingv1.IngressBackend{
-				Service: &networkingv1.IngressServiceBackend{
-					Name: route.TargetServiceName,
-					Port: networkingv1.ServiceBackendPort{
-						Number: int32(port),
-					},
-				},
+				Service: &backend,
 			},
 		})
 	}
 	return rule
 }
+
+// routerHTTPRouteRules translates the routes into HTTPRoute rules that match, rewrite and split the requests natively.
+// HTTPRoutes can't match on cookies, so the requests for the path of a route that matches on cookies are sent to the
+// router itself, which serves them from its nginx config.
+func routerHTTPRouteRules(routerName string, routes []v1.Route) (result []gatewayv1beta1.HTTPRouteRule) {
+	viaRouter := map[string]bool{}
+	for _, route := range routes {
+		if route.Match != nil && len(route.Match.Cookies) > 0 {
+			viaRouter[string(route.PathType)+route.Path] = true
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, route := range routes {
+		targets := route.GetTargets()
+		if route.Path == "" || len(targets) == 0 {
+			continue
+		}
+
+		pathType := gatewayv1beta1.PathMatchPathPrefix
+		if route.PathType == v1.PathTypeExact {
+			pathType = gatewayv1beta1.PathMatchExact
+		}
+		pathMatch := gatewayv1beta1.HTTPRouteMatch{
+			Path: &gatewayv1beta1.HTTPPathMatch{
+				Type:  &pathType,
+				Value: z.Pointer(route.Path),
+			},
+		}
+
+		key := string(route.PathType) + route.Path
+		if viaRouter[key] {
+			if !seen[key] {
+				result = append(result, gatewayv1beta1.HTTPRouteRule{
+					Matches: []gatewayv1beta1.HTTPRouteMatch{pathMatch},
+					BackendRefs: []gatewayv1beta1.HTTPBackendRef{{
+						BackendRef: backendRef(routerName, ports.RouterPortDef.Port, nil),
+					}},
+				})
+			}
+			seen[key] = true
+			continue
+		}
+
+		rule := gatewayv1beta1.HTTPRouteRule{
+			Matches: []gatewayv1beta1.HTTPRouteMatch{pathMatch},
+		}
+
+		if route.Match != nil {
+			for _, header := range typed.SortedKeys(route.Match.Headers) {
+				pathMatch.Headers = append(pathMatch.Headers, gatewayv1beta1.HTTPHeaderMatch{
+					Name:  gatewayv1beta1.HTTPHeaderName(header),
+					Value: route.Match.Headers[header],
+				})
+			}
+			rule.Matches = []gatewayv1beta1.HTTPRouteMatch{pathMatch}
+			if len(route.Match.Methods) > 0 {
+				rule.Matches = nil
+				for _, method := range route.Match.Methods {
+					match := *pathMatch.DeepCopy()
+					match.Method = z.Pointer(gatewayv1beta1.HTTPMethod(strings.ToUpper(method)))
+					rule.Matches = append(rule.Matches, match)
+				}
+			}
+		}
+
+		if route.RequestHeaders != nil {
+			rule.Filters = append(rule.Filters, gatewayv1beta1.HTTPRouteFilter{
+				Type:                  gatewayv1beta1.HTTPRouteFilterRequestHeaderModifier,
+				RequestHeaderModifier: toHTTPHeaderFilter(route.RequestHeaders),
+			})
+		}
+		if route.ResponseHeaders != nil {
+			rule.Filters = append(rule.Filters, gatewayv1beta1.HTTPRouteFilter{
+				Type:                   gatewayv1beta1.HTTPRouteFilterResponseHeaderModifier,
+				ResponseHeaderModifier: toHTTPHeaderFilter(route.ResponseHeaders),
+			})
+		}
+		if route.StripPrefix && route.Path != "/" {
+			pathModifier := &gatewayv1beta1.HTTPPathModifier{
+				Type:               gatewayv1beta1.PrefixMatchHTTPPathModifier,
+				ReplacePrefixMatch: z.Pointer("/"),
+			}
+			if route.PathType == v1.PathTypeExact {
+				pathModifier = &gatewayv1beta1.HTTPPathModifier{
+					Type:            gatewayv1beta1.FullPathHTTPPathModifier,
+					ReplaceFullPath: z.Pointer("/"),
+				}
+			}
+			rule.Filters = append(rule.Filters, gatewayv1beta1.HTTPRouteFilter{
+				Type:       gatewayv1beta1.HTTPRouteFilterURLRewrite,
+				URLRewrite: &gatewayv1beta1.HTTPURLRewriteFilter{Path: pathModifier},
+			})
+		}
+
+		for _, target := range targets {
+			rule.BackendRefs = append(rule.BackendRefs, gatewayv1beta1.HTTPBackendRef{
+				BackendRef: backendRef(target.TargetServiceName, int32(target.TargetPort), z.Pointer(int32(target.Weight))),
+			})
+		}
+		result = append(result, rule)
+	}
+	return result
+}
+
+func toHTTPHeaderFilter(modifier *v1.HeaderModifier) *gatewayv1beta1.HTTPHeaderFilter {
+	filter := &gatewayv1beta1.HTTPHeaderFilter{
+		Remove: modifier.Remove,
+	}
+	for _, header := range typed.SortedKeys(modifier.Set) {
+		filter.Set = append(filter.Set, gatewayv1beta1.HTTPHeader{
+			Name:  gatewayv1beta1.HTTPHeaderName(header),
+			Value: modifier.Set[header],
+		})
+	}
+	return filter
+}
@@ -21,8 +21,19 @@ func ServiceLoadBalancer(req router.Request, svc *v1.ServiceInstance) (result []
 		return nil, nil
 	}
 
+	cfg, err := config.Get(req.Ctx, req.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	protocols := []v1.Protocol{v1.ProtocolTCP, v1.ProtocolUDP}
+	if UsesGateway(cfg) {
+		// TCP ports are published by a Gateway
+		protocols = []v1.Protocol{v1.ProtocolUDP}
+	}
+
 	bindings := ports.ApplyBindings(svc.Spec.PublishMode, svc.Spec.Publish,
-		ports.ByProtocol(svc.Spec.Ports, true, v1.ProtocolTCP, v1.ProtocolUDP))
+		ports.ByProtocol(svc.Spec.Ports, true, protocols...))
 
 	if len(bindings) == 0 {
 		return nil, nil
@@ -45,11 +56,6 @@ func ServiceLoadBalancer(req router.Request, svc *v1.ServiceInstance) (result []
 		return nil, nil
 	}
 
-	cfg, err := config.Get(req.Ctx, req.Client)
-	if err != nil {
-		return nil, err
-	}
-
 	if svc.Spec.Annotations == nil {
 		svc.Spec.Annotations = map[string]string{}
 	}
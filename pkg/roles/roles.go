@@ -45,17 +45,21 @@ var (
 					"apps",
 					"apps/info",
 					"apps/icon",
+					"apprevisions",
 					"acornimagebuilds",
 					"builders",
 					"devsessions",
 					"images",
 					"volumes",
+					"volumesnapshots",
 					"containerreplicas",
 					"credentials",
 					"secrets",
+					"secretkeys",
 					"services",
 					"events",
 					"jobs",
+					"eventsubscriptions",
 				},
 			},
 			{
@@ -74,6 +78,12 @@ var (
 					"images/details",
 				},
 			},
+			{
+				Verbs: []string{"create"},
+				Resources: []string{
+					"apps/estimate",
+				},
+			},
 			{
 				Verbs: []string{"list"},
 				Resources: []string{
@@ -97,6 +107,14 @@ var (
 					"devsessions",
 					"credentials",
 					"secrets",
+					"eventsubscriptions",
+				},
+			},
+			{
+				Verbs: []string{"create", "delete"},
+				Resources: []string{
+					"secretkeys",
+					"volumesnapshots",
 				},
 			},
 			{
@@ -110,6 +128,7 @@ var (
 				Resources: []string{
 					"images/tag",
 					"apps/confirmupgrade",
+					"apps/rollout",
 					"apps/pullimage",
 					"apps/ignorecleanup",
 					"events",
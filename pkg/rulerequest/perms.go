@@ -14,6 +14,22 @@ type RuleRequest struct {
 	ResourceName string
 }
 
+// String returns the request in one line, like "service web: get,list secrets in project".
+func (r RuleRequest) String() string {
+	var sb strings.Builder
+	if r.Service != "" {
+		sb.WriteString("service " + r.Service + ": ")
+	}
+	sb.WriteString(r.Verbs + " " + r.Resource)
+	if r.ResourceName != "" {
+		sb.WriteString("/" + r.ResourceName)
+	}
+	if r.Scope != "" {
+		sb.WriteString(" in " + r.Scope)
+	}
+	return sb.String()
+}
+
 func ToRuleRequests(perms []v1.Permissions) (result []RuleRequest) {
 	for _, perm := range perms {
 		result = append(result, rulesToRequests(perm.ServiceName, perm.GetRules())...)
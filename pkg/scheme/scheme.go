@@ -8,6 +8,7 @@ import (
 	acornadminv1 "github.com/acorn-io/runtime/pkg/apis/internal.admin.acorn.io/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	authv1 "k8s.io/api/authorization/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	batchv1 "k8s.io/api/batch/v1"
 	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -25,6 +26,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
 var (
@@ -50,10 +53,13 @@ func AddToScheme(scheme *runtime.Scheme) error {
 	errs = append(errs, apiregistrationv1.AddToScheme(scheme))
 	errs = append(errs, rbacv1.AddToScheme(scheme))
 	errs = append(errs, authv1.AddToScheme(scheme))
+	errs = append(errs, autoscalingv2.AddToScheme(scheme))
 	errs = append(errs, apiextensionv1.AddToScheme(scheme))
 	errs = append(errs, discoveryv1.AddToScheme(scheme))
 	errs = append(errs, schedulingv1.AddToScheme(scheme))
 	errs = append(errs, coordinationv1.AddToScheme(scheme))
+	errs = append(errs, gatewayv1beta1.AddToScheme(scheme))
+	errs = append(errs, gatewayv1alpha2.AddToScheme(scheme))
 	return merr.NewErrors(errs...)
 }
 
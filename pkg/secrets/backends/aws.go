@@ -0,0 +1,109 @@
+package backends
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// awsSecretsManager reads secrets from AWS Secrets Manager using credentials stored in the project, so that a project
+// can only read the secrets its own credentials allow.
+type awsSecretsManager struct {
+	region      string
+	credentials aws.Credentials
+	client      *http.Client
+}
+
+func newAWSSecretsManager(ctx context.Context, c kclient.Client, namespace string, backend v1.SecretBackend) (Backend, error) {
+	creds, err := credentials(ctx, c, namespace, backend.AWSSecretsManager.CredentialsSecret, "accessKeyID", "secretAccessKey", "sessionToken")
+	if err != nil {
+		return nil, err
+	}
+	if creds["accessKeyID"] == "" || creds["secretAccessKey"] == "" {
+		return nil, fmt.Errorf("secret %s must have the accessKeyID and secretAccessKey keys", backend.AWSSecretsManager.CredentialsSecret)
+	}
+
+	return &awsSecretsManager{
+		region: backend.AWSSecretsManager.Region,
+		credentials: aws.Credentials{
+			AccessKeyID:     creds["accessKeyID"],
+			SecretAccessKey: creds["secretAccessKey"],
+			SessionToken:    creds["sessionToken"],
+		},
+		client: httpClient,
+	}, nil
+}
+
+func (a *awsSecretsManager) Get(ctx context.Context, path string) (map[string][]byte, error) {
+	body, err := json.Marshal(map[string]string{
+		"SecretId": path,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		fmt.Sprintf("https://secretsmanager.%s.amazonaws.com/", a.region), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+
+	payloadHash := sha256.Sum256(body)
+	if err := v4.NewSigner().SignHTTP(ctx, a.credentials, req, hex.EncodeToString(payloadHash[:]), "secretsmanager", a.region, time.Now()); err != nil {
+		return nil, err
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var awsErr struct {
+			Type    string `json:"__type"`
+			Message string `json:"message"`
+		}
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		_ = json.Unmarshal(data, &awsErr)
+		if strings.HasSuffix(awsErr.Type, "ResourceNotFoundException") {
+			return nil, notFound(path)
+		}
+		return nil, fmt.Errorf("reading %s from AWS Secrets Manager: %s: %s", path, resp.Status, strings.TrimSpace(string(data)))
+	}
+
+	var secret struct {
+		SecretString string `json:"SecretString"`
+		SecretBinary string `json:"SecretBinary"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&secret); err != nil {
+		return nil, fmt.Errorf("reading %s from AWS Secrets Manager: %w", path, err)
+	}
+
+	if secret.SecretBinary != "" {
+		value, err := base64.StdEncoding.DecodeString(secret.SecretBinary)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s from AWS Secrets Manager: %w", path, err)
+		}
+		return map[string][]byte{
+			"value": value,
+		}, nil
+	}
+
+	return toData([]byte(secret.SecretString)), nil
+}
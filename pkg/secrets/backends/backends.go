@@ -0,0 +1,372 @@
+package backends
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/egress"
+	"github.com/acorn-io/runtime/pkg/encryption/nacl"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultRefreshInterval is how often secrets are refreshed from a backend that doesn't set a refresh interval
+const DefaultRefreshInterval = 5 * time.Minute
+
+// httpTimeout bounds the requests of the backends that are called over HTTP. Secrets are resolved inside reconciles, so
+// a backend that doesn't respond must not block the controller.
+const httpTimeout = 10 * time.Second
+
+// httpClient is used by the backends that are called over HTTP. Backends are configured by project members, so the
+// client refuses to connect to internal addresses.
+var httpClient = egress.NewClient(httpTimeout, nil)
+
+// allowedClients are the clients that may also connect to the internal addresses allowed by the Acorn config, by the
+// allowed addresses
+var allowedClients = struct {
+	lock    sync.Mutex
+	clients map[string]*http.Client
+}{
+	clients: map[string]*http.Client{},
+}
+
+// allowedClient returns the client for a backend that may connect to the internal addresses allowed by the Acorn config,
+// like an in-cluster Vault.
+func allowedClient(ctx context.Context, c kclient.Client) (*http.Client, error) {
+	cfg, err := config.Get(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	if len(cfg.SecretBackendAllowedAddresses) == 0 {
+		return httpClient, nil
+	}
+
+	key := strings.Join(cfg.SecretBackendAllowedAddresses, ",")
+	allowedClients.lock.Lock()
+	defer allowedClients.lock.Unlock()
+	if client, ok := allowedClients.clients[key]; ok {
+		return client, nil
+	}
+
+	allowed, err := egress.ParsePrefixes(cfg.SecretBackendAllowedAddresses)
+	if err != nil {
+		return nil, fmt.Errorf("invalid secret backend allowed addresses: %w", err)
+	}
+	// Only the current allowed addresses are kept, so that changing them doesn't leak clients
+	clear(allowedClients.clients)
+	allowedClients.clients[key] = egress.NewClient(httpTimeout, allowed)
+	return allowedClients.clients[key], nil
+}
+
+// cache holds the secrets read from the backends until their refresh interval passes, so that they are not read again
+// on every reconcile
+var cache = &secretCache{
+	entries: map[cacheKey]cacheEntry{},
+}
+
+type cacheKey struct {
+	project string
+	// backend is the configuration of the backend, so that changing the configuration reads the secrets again
+	backend string
+	path    string
+}
+
+type cacheEntry struct {
+	data    map[string][]byte
+	expires time.Time
+}
+
+type secretCache struct {
+	lock    sync.Mutex
+	entries map[cacheKey]cacheEntry
+}
+
+func (s *secretCache) get(key cacheKey, now time.Time) (map[string][]byte, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	entry, ok := s.entries[key]
+	if !ok || !now.Before(entry.expires) {
+		return nil, false
+	}
+	return maps.Clone(entry.data), true
+}
+
+func (s *secretCache) set(key cacheKey, data map[string][]byte, expires, now time.Time) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for k, entry := range s.entries {
+		if !now.Before(entry.expires) {
+			delete(s.entries, k)
+		}
+	}
+	s.entries[key] = cacheEntry{
+		data:    maps.Clone(data),
+		expires: expires,
+	}
+}
+
+// Backend resolves secrets stored outside of Kubernetes
+type Backend interface {
+	// Get returns the data of the secret at path. If the secret does not exist a NotFound error is returned.
+	Get(ctx context.Context, path string) (map[string][]byte, error)
+}
+
+// Factory creates a Backend from the configuration of a project. The client and namespace are used to read any
+// credentials the backend needs from the project.
+type Factory func(ctx context.Context, c kclient.Client, namespace string, backend v1.SecretBackend) (Backend, error)
+
+var factories = map[v1.SecretBackendType]Factory{
+	v1.SecretBackendTypeVault:             newVault,
+	v1.SecretBackendTypeAWSSecretsManager: newAWSSecretsManager,
+	v1.SecretBackendTypeFile:              newFile,
+}
+
+// Ref is a parsed reference to a secret in a backend, in the form "backend://path#key"
+type Ref struct {
+	Backend string
+	Path    string
+	// Key selects a single key of the secret. If empty, all keys are used.
+	Key string
+}
+
+func (r Ref) String() string {
+	if r.Key == "" {
+		return r.Backend + "://" + r.Path
+	}
+	return r.Backend + "://" + r.Path + "#" + r.Key
+}
+
+// IsRef returns true if ref refers to a secret in a backend rather than a Kubernetes secret. The "context://"
+// scheme is reserved for context secrets.
+func IsRef(ref string) bool {
+	scheme, _, ok := strings.Cut(ref, "://")
+	return ok && scheme != "" && scheme != "context"
+}
+
+func ParseRef(ref string) (Ref, error) {
+	if !IsRef(ref) {
+		return Ref{}, fmt.Errorf("invalid secret backend reference [%s], must be in the form backend://path#key", ref)
+	}
+	scheme, rest, _ := strings.Cut(ref, "://")
+	path, key, _ := strings.Cut(rest, "#")
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return Ref{}, fmt.Errorf("invalid secret backend reference [%s], path is required", ref)
+	}
+	return Ref{
+		Backend: scheme,
+		Path:    path,
+		Key:     key,
+	}, nil
+}
+
+// Validate returns an error if the backend is not configured correctly.
+func Validate(backend v1.SecretBackend) error {
+	if _, ok := factories[backend.Type]; !ok {
+		return fmt.Errorf("unknown secret backend type [%s]", backend.Type)
+	}
+	if backend.GetName() == "context" {
+		return fmt.Errorf("secret backend name [context] is reserved")
+	}
+	if backend.RefreshInterval != "" {
+		if d, err := time.ParseDuration(backend.RefreshInterval); err != nil {
+			return fmt.Errorf("invalid refresh interval [%s]: %w", backend.RefreshInterval, err)
+		} else if d < time.Second {
+			return fmt.Errorf("refresh interval [%s] must be at least 1s", backend.RefreshInterval)
+		}
+	}
+
+	switch backend.Type {
+	case v1.SecretBackendTypeVault:
+		if backend.Vault == nil || backend.Vault.Address == "" {
+			return fmt.Errorf("vault secret backend %s requires an address", backend.GetName())
+		}
+	case v1.SecretBackendTypeAWSSecretsManager:
+		if backend.AWSSecretsManager == nil || backend.AWSSecretsManager.Region == "" || backend.AWSSecretsManager.CredentialsSecret == "" {
+			return fmt.Errorf("awsSecretsManager secret backend %s requires a region and credentialsSecret", backend.GetName())
+		}
+	case v1.SecretBackendTypeFile:
+		if backend.File == nil {
+			return fmt.Errorf("file secret backend %s requires a directory", backend.GetName())
+		}
+		// The directory is checked against any project, it must not escape the directory of the project it is used in
+		if _, err := filePath("project", backend.File.Directory, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RefreshInterval returns how often secrets resolved from the backend should be refreshed.
+func RefreshInterval(backend v1.SecretBackend) time.Duration {
+	if d, err := time.ParseDuration(backend.RefreshInterval); err == nil && d > 0 {
+		return d
+	}
+	return DefaultRefreshInterval
+}
+
+// RefreshIntervalFor returns how often the secret that ref refers to should be refreshed. If the backend can't be
+// found the default refresh interval is returned.
+func RefreshIntervalFor(ctx context.Context, c kclient.Client, projectName, ref string) time.Duration {
+	parsed, err := ParseRef(ref)
+	if err != nil {
+		return DefaultRefreshInterval
+	}
+	backend, err := Lookup(ctx, c, projectName, parsed)
+	if err != nil {
+		return DefaultRefreshInterval
+	}
+	return RefreshInterval(backend)
+}
+
+// Lookup returns the configuration of the backend the reference refers to from the project.
+func Lookup(ctx context.Context, c kclient.Client, projectName string, ref Ref) (v1.SecretBackend, error) {
+	project := &v1.ProjectInstance{}
+	if err := c.Get(ctx, router.Key("", projectName), project); err != nil {
+		return v1.SecretBackend{}, err
+	}
+
+	for _, backend := range project.Spec.SecretBackends {
+		if backend.GetName() == ref.Backend {
+			return backend, nil
+		}
+	}
+
+	return v1.SecretBackend{}, fmt.Errorf("secret backend [%s] is not configured for project [%s]", ref.Backend, projectName)
+}
+
+// Resolve returns the data of the secret that ref refers to, using the backends configured for the project. The
+// project namespace is also where the backends read their credentials from. The secret is only read from the backend
+// again once the refresh interval of the backend has passed.
+func Resolve(ctx context.Context, c kclient.Client, projectName, ref string) (map[string][]byte, error) {
+	parsed, err := ParseRef(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := Lookup(ctx, c, projectName, parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := Validate(config); err != nil {
+		return nil, err
+	}
+
+	data, err := get(ctx, c, projectName, config, parsed.Path)
+	if apierrors.IsNotFound(err) {
+		return nil, notFound(parsed.String())
+	} else if err != nil {
+		return nil, err
+	}
+
+	if parsed.Key == "" {
+		return data, nil
+	}
+
+	value, ok := data[parsed.Key]
+	if !ok {
+		return nil, notFound(parsed.String())
+	}
+	return map[string][]byte{
+		parsed.Key: value,
+	}, nil
+}
+
+// get reads the secret at path from the backend, or from the cache if it was read within the refresh interval of the
+// backend.
+func get(ctx context.Context, c kclient.Client, projectName string, config v1.SecretBackend, path string) (map[string][]byte, error) {
+	configData, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	key := cacheKey{
+		project: projectName,
+		backend: string(configData),
+		path:    path,
+	}
+
+	now := time.Now()
+	if data, ok := cache.get(key, now); ok {
+		return data, nil
+	}
+
+	backend, err := factories[config.Type](ctx, c, projectName, config)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := backend.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	cache.set(key, data, now.Add(RefreshInterval(config)), now)
+	return data, nil
+}
+
+func notFound(ref string) error {
+	return apierrors.NewNotFound(schema.GroupResource{
+		Group:    "v1",
+		Resource: "secrets",
+	}, ref)
+}
+
+// toData converts the content of a secret into secret data. JSON objects are split into one key per field, anything
+// else is stored in the "value" key.
+func toData(content []byte) map[string][]byte {
+	fields := map[string]any{}
+	if err := json.Unmarshal(content, &fields); err != nil {
+		return map[string][]byte{
+			"value": content,
+		}
+	}
+	return fieldsToData(fields)
+}
+
+func fieldsToData(fields map[string]any) map[string][]byte {
+	result := make(map[string][]byte, len(fields))
+	for k, v := range fields {
+		if s, ok := v.(string); ok {
+			result[k] = []byte(s)
+			continue
+		}
+		data, err := json.Marshal(v)
+		if err != nil {
+			continue
+		}
+		result[k] = data
+	}
+	return result
+}
+
+// credentials reads a secret holding the credentials of a backend from the project namespace.
+func credentials(ctx context.Context, c kclient.Client, namespace, name string, keys ...string) (map[string]string, error) {
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, router.Key(namespace, name), secret); err != nil {
+		return nil, err
+	}
+
+	data, err := nacl.DecryptNamespacedDataMap(ctx, c, secret.Data, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("decrypting %s/%s: %w", namespace, name, err)
+	}
+
+	result := map[string]string{}
+	for _, key := range keys {
+		result[key] = string(data[key])
+	}
+	return result, nil
+}
@@ -0,0 +1,166 @@
+package backends
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/acorn-io/runtime/pkg/system"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestParseRef(t *testing.T) {
+	ref, err := ParseRef("vault://app/db#password")
+	require.NoError(t, err)
+	assert.Equal(t, Ref{Backend: "vault", Path: "app/db", Key: "password"}, ref)
+	assert.Equal(t, "vault://app/db#password", ref.String())
+
+	ref, err = ParseRef("file:///app/db/")
+	require.NoError(t, err)
+	assert.Equal(t, Ref{Backend: "file", Path: "app/db"}, ref)
+
+	_, err = ParseRef("vault://#password")
+	assert.Error(t, err)
+	_, err = ParseRef("context://name")
+	assert.Error(t, err)
+
+	assert.False(t, IsRef("secret-name"))
+	assert.False(t, IsRef("context://name"))
+	assert.True(t, IsRef("awsSecretsManager://prod/db"))
+}
+
+func TestFilePath(t *testing.T) {
+	FileRoot = "/etc/acorn/secret-backends"
+
+	p, err := filePath("acorn", "project", "app/db")
+	require.NoError(t, err)
+	assert.Equal(t, "/etc/acorn/secret-backends/acorn/project/app/db", p)
+
+	_, err = filePath("acorn", "project", "../../../passwd")
+	assert.Error(t, err)
+	_, err = filePath("acorn", "../other", "db")
+	assert.Error(t, err)
+	_, err = filePath("..", "other", "db")
+	assert.Error(t, err)
+}
+
+func TestResolveFile(t *testing.T) {
+	FileRoot = t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(FileRoot, "acorn", "local", "app"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(FileRoot, "acorn", "local", "app", "db"), []byte(`{"username":"admin","password":"secret"}`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(FileRoot, "acorn", "local", "app", "token"), []byte("abc"), 0o600))
+
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(&v1.ProjectInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "acorn"},
+		Spec: v1.ProjectInstanceSpec{
+			SecretBackends: []v1.SecretBackend{
+				{Type: v1.SecretBackendTypeFile, File: &v1.FileSecretBackend{Directory: "local"}, RefreshInterval: "30s"},
+			},
+		},
+	}, &v1.ProjectInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "other"},
+		Spec: v1.ProjectInstanceSpec{
+			SecretBackends: []v1.SecretBackend{
+				{Type: v1.SecretBackendTypeFile, File: &v1.FileSecretBackend{Directory: "local"}},
+			},
+		},
+	}).Build()
+	ctx := context.Background()
+
+	data, err := Resolve(ctx, c, "acorn", "file://app/db")
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"username": []byte("admin"), "password": []byte("secret")}, data)
+
+	data, err = Resolve(ctx, c, "acorn", "file://app/db#password")
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"password": []byte("secret")}, data)
+
+	data, err = Resolve(ctx, c, "acorn", "file://app/token")
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"value": []byte("abc")}, data)
+
+	_, err = Resolve(ctx, c, "acorn", "file://app/db#missing")
+	assert.True(t, apierrors.IsNotFound(err))
+	_, err = Resolve(ctx, c, "acorn", "file://app/missing")
+	assert.True(t, apierrors.IsNotFound(err))
+	_, err = Resolve(ctx, c, "acorn", "vault://app/db")
+	assert.Error(t, err)
+
+	// The same directory of another project is a different directory
+	_, err = Resolve(ctx, c, "other", "file://app/db")
+	assert.True(t, apierrors.IsNotFound(err))
+
+	// The secret is read again only after the refresh interval
+	require.NoError(t, os.WriteFile(filepath.Join(FileRoot, "acorn", "local", "app", "token"), []byte("def"), 0o600))
+	data, err = Resolve(ctx, c, "acorn", "file://app/token")
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"value": []byte("abc")}, data)
+
+	assert.Equal(t, 30*time.Second, RefreshIntervalFor(ctx, c, "acorn", "file://app/db"))
+	assert.Equal(t, DefaultRefreshInterval, RefreshIntervalFor(ctx, c, "acorn", "vault://app/db"))
+}
+
+func TestResolveVault(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/secret/data/app/db":
+			_, _ = w.Write([]byte(`{"data":{"data":{"password":"secret"}}}`))
+		case "/v1/secret/data/app/denied":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"],"token":"internal"}`))
+		default:
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = w.Write([]byte(`<html>internal service</html>`))
+		}
+	}))
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	project := func(name string) *v1.ProjectInstance {
+		return &v1.ProjectInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: v1.ProjectInstanceSpec{
+				SecretBackends: []v1.SecretBackend{
+					{Type: v1.SecretBackendTypeVault, Vault: &v1.VaultSecretBackend{Address: server.URL}},
+				},
+			},
+		}
+	}
+	ctx := context.Background()
+
+	// The test server listens on a loopback address, which is refused unless the config allows it
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(project("blocked")).Build()
+	_, err = Resolve(ctx, c, "blocked", "vault://app/db")
+	assert.ErrorContains(t, err, "is not allowed")
+
+	c = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(project("allowed"), &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: system.ConfigName, Namespace: system.Namespace},
+		Data: map[string]string{
+			"config": `{"secretBackendAllowedAddresses":["` + serverURL.Hostname() + `"]}`,
+		},
+	}).Build()
+
+	data, err := Resolve(ctx, c, "allowed", "vault://app/db")
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"password": []byte("secret")}, data)
+
+	// Only the errors of a Vault response end up in the error
+	_, err = Resolve(ctx, c, "allowed", "vault://app/denied")
+	assert.EqualError(t, err, "reading app/denied from vault: 403 Forbidden: permission denied")
+	_, err = Resolve(ctx, c, "allowed", "vault://app/other")
+	assert.EqualError(t, err, "reading app/other from vault: 502 Bad Gateway")
+}
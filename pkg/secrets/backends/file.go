@@ -0,0 +1,61 @@
+package backends
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// FileRoot holds a directory for each project, named after the project namespace, that the directories of the file
+// backends of the project are relative to. The file backend is meant as a local stand-in for the other backends, so a
+// project can never read files outside of its own directory.
+var FileRoot = "/etc/acorn/secret-backends"
+
+// file reads secrets from files in a directory. Files containing a JSON object are split into one key per field,
+// anything else is stored in the "value" key.
+type file struct {
+	namespace string
+	directory string
+}
+
+func newFile(_ context.Context, _ kclient.Client, namespace string, backend v1.SecretBackend) (Backend, error) {
+	return &file{
+		namespace: namespace,
+		directory: backend.File.Directory,
+	}, nil
+}
+
+func (f *file) Get(_ context.Context, path string) (map[string][]byte, error) {
+	fullPath, err := filePath(f.namespace, f.directory, path)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := os.ReadFile(fullPath)
+	if os.IsNotExist(err) {
+		return nil, notFound(path)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return toData(content), nil
+}
+
+// filePath joins the directory and path to the directory of the project namespace in FileRoot, ensuring the result
+// does not escape the directory of the project.
+func filePath(namespace, directory, path string) (string, error) {
+	if namespace == "" || strings.ContainsAny(namespace, `/\`) || namespace == "." || namespace == ".." {
+		return "", fmt.Errorf("invalid project namespace [%s] for the file secret backend", namespace)
+	}
+	root := filepath.Join(FileRoot, namespace)
+	result := filepath.Join(root, directory, path)
+	if result != root && !strings.HasPrefix(result, root+string(filepath.Separator)) {
+		return "", fmt.Errorf("path [%s] is outside of the secret backend directory", filepath.Join(directory, path))
+	}
+	return result, nil
+}
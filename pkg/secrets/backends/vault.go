@@ -0,0 +1,103 @@
+package backends
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// vault reads secrets from a Vault KV version 2 secrets engine
+type vault struct {
+	address   string
+	mount     string
+	namespace string
+	token     string
+	client    *http.Client
+}
+
+func newVault(ctx context.Context, c kclient.Client, namespace string, backend v1.SecretBackend) (Backend, error) {
+	client, err := allowedClient(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &vault{
+		address:   strings.TrimSuffix(backend.Vault.Address, "/"),
+		mount:     strings.Trim(backend.Vault.Mount, "/"),
+		namespace: backend.Vault.Namespace,
+		client:    client,
+	}
+	if result.mount == "" {
+		result.mount = "secret"
+	}
+
+	if backend.Vault.TokenSecret != "" {
+		creds, err := credentials(ctx, c, namespace, backend.Vault.TokenSecret, "token")
+		if err != nil {
+			return nil, err
+		}
+		result.token = creds["token"]
+	}
+
+	return result, nil
+}
+
+func (v *vault) Get(ctx context.Context, path string) (map[string][]byte, error) {
+	u, err := url.JoinPath(v.address, "v1", v.mount, "data", path)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	if v.token != "" {
+		req.Header.Set("X-Vault-Token", v.token)
+	}
+	if v.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.namespace)
+	}
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, notFound(path)
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("reading %s from vault: %s%s", path, resp.Status, vaultErrors(resp.Body))
+	}
+
+	var secret struct {
+		Data struct {
+			Data map[string]any `json:"data"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&secret); err != nil {
+		return nil, fmt.Errorf("reading %s from vault: %w", path, err)
+	}
+
+	return fieldsToData(secret.Data.Data), nil
+}
+
+// vaultErrors returns the errors of an error response from Vault. Only the errors field of a Vault response is kept, the
+// address may not be Vault and the rest of the response must not end up in the status of the secret.
+func vaultErrors(body io.Reader) string {
+	var response struct {
+		Errors []string `json:"errors"`
+	}
+	if err := json.NewDecoder(io.LimitReader(body, 4096)).Decode(&response); err != nil || len(response.Errors) == 0 {
+		return ""
+	}
+	return ": " + strings.Join(response.Errors, ", ")
+}
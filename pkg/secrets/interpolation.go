@@ -20,6 +20,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/publicname"
 	"github.com/acorn-io/runtime/pkg/ref"
 	"github.com/acorn-io/runtime/pkg/replace"
+	"github.com/acorn-io/runtime/pkg/secrets/backends"
 	"github.com/acorn-io/runtime/pkg/tags"
 	"github.com/acorn-io/runtime/pkg/volume"
 	"github.com/google/go-containerregistry/pkg/name"
@@ -292,6 +293,10 @@ func (i *Interpolator) resolveApp(keyName string) (string, bool, error) {
 func (i *Interpolator) resolveSecrets(secretName []string, keyName string) (string, bool, error) {
 	secret := &corev1.Secret{}
 	err := ref.Lookup(i.ctx, i.client, secret, i.namespace, secretName...)
+	if external := i.externalRef(secretName); apierrors.IsNotFound(err) && external != "" {
+		// The secret has not been copied to the app namespace yet, so read it from its backend directly
+		secret.Data, err = backends.Resolve(i.ctx, i.client, i.app.Namespace, external)
+	}
 	if apierrors.IsNotFound(err) {
 		return "", false, &ErrInterpolation{
 			ExpressionError: v1.ExpressionError{
@@ -319,6 +324,17 @@ func (i *Interpolator) resolveSecrets(secretName []string, keyName string) (stri
 	return string(value), true, nil
 }
 
+// externalRef returns the backend reference of an external secret of the app, if the secret is one.
+func (i *Interpolator) externalRef(secretName []string) string {
+	if len(secretName) != 1 {
+		return ""
+	}
+	if external := i.app.Status.AppSpec.Secrets[secretName[0]].External; backends.IsRef(external) {
+		return external
+	}
+	return ""
+}
+
 func splitServiceProperty(parts []string) (head []string, tail []string, err error) {
 	for i, part := range parts {
 		if serviceTokens.Has(part) {
@@ -483,6 +499,11 @@ func (i *Interpolator) resolve(token string) (_ string, _ bool, err error) {
 			return "", false, fmt.Errorf("invalid expression [%s], must have at least three parts separated by \".\"", token)
 		}
 		return i.resolveSecrets(parts[1:len(parts)-1], parts[len(parts)-1])
+	case "job", "jobs":
+		if len(parts) != 4 || parts[2] != "outputs" {
+			return "", false, fmt.Errorf("invalid expression [%s], must be of the form jobs.<job>.outputs.<key>", token)
+		}
+		return i.resolveJobOutputs(parts[1], parts[3])
 	case "acorn", "app":
 		if len(parts) != 2 {
 			return "", false, fmt.Errorf("invalid expression [%s], must have two parts separated by \".\"", token)
@@ -498,6 +519,40 @@ func (i *Interpolator) resolve(token string) (_ string, _ bool, err error) {
 	}
 }
 
+// resolveJobOutputs returns a named output of a job of the app, which is missing until the job has succeeded and its
+// outputs are recorded.
+func (i *Interpolator) resolveJobOutputs(jobName, key string) (string, bool, error) {
+	if _, ok := i.app.Status.AppSpec.Jobs[jobName]; !ok {
+		return "", false, fmt.Errorf("job [%s] is not defined", jobName)
+	}
+
+	status := i.app.Status.AppStatus.Jobs[jobName]
+	if status.Outputs == nil {
+		return "", false, &ErrInterpolation{
+			ExpressionError: v1.ExpressionError{
+				DependencyNotFound: &v1.DependencyNotFound{
+					DependencyType: v1.DependencyJob,
+					Name:           jobName,
+				},
+			},
+		}
+	}
+
+	value, ok := status.Outputs[key]
+	if !ok {
+		return "", false, &ErrInterpolation{
+			ExpressionError: v1.ExpressionError{
+				DependencyNotFound: &v1.DependencyNotFound{
+					DependencyType: v1.DependencyJob,
+					Name:           jobName,
+					SubKey:         key,
+				},
+			},
+		}
+	}
+	return value, true, nil
+}
+
 func (i *Interpolator) resolveImages(imageName string) (string, bool, error) {
 	img, ok := i.app.Status.AppSpec.Images[imageName]
 	if !ok {
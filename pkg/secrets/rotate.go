@@ -0,0 +1,139 @@
+package secrets
+
+import (
+	"fmt"
+	"time"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/schemer/data/convert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// PreviousKey is the key a rotated secret keeps its value from before the last rotation in
+	PreviousKey = "previous"
+
+	defaultRotateGracePeriod = time.Hour
+)
+
+type rotation struct {
+	interval    time.Duration
+	gracePeriod time.Duration
+}
+
+// rotationFor returns the rotation configured in the params of the secret, or nil if the secret is not rotated.
+func rotationFor(secretRef v1.Secret) (*rotation, error) {
+	interval := convert.ToString(secretRef.Params.GetData()["rotate"])
+	if interval == "" {
+		return nil, nil
+	}
+
+	result := &rotation{
+		gracePeriod: defaultRotateGracePeriod,
+	}
+
+	var err error
+	if result.interval, err = time.ParseDuration(interval); err != nil {
+		return nil, fmt.Errorf("invalid rotate param [%s]: %w", interval, err)
+	} else if result.interval <= 0 {
+		return nil, fmt.Errorf("invalid rotate param [%s]: must be greater than 0", interval)
+	}
+
+	if gracePeriod := convert.ToString(secretRef.Params.GetData()["rotateGracePeriod"]); gracePeriod != "" {
+		if result.gracePeriod, err = time.ParseDuration(gracePeriod); err != nil {
+			return nil, fmt.Errorf("invalid rotateGracePeriod param [%s]: %w", gracePeriod, err)
+		}
+	}
+
+	return result, nil
+}
+
+// ValidateRotation returns an error if the rotation params of the secret are invalid. Only the generated values of token
+// and basic secrets are rotated, so the params are rejected on other secrets. The generated and template secrets that
+// use a rotated value are not regenerated when it rotates.
+func ValidateRotation(secretRef v1.Secret) error {
+	params := secretRef.Params.GetData()
+	if secretRef.Type != "token" && secretRef.Type != "basic" {
+		for _, param := range []string{"rotate", "rotateGracePeriod"} {
+			if _, ok := params[param]; ok {
+				return fmt.Errorf("%s param is only supported on token and basic secrets, not %s secrets", param, secretRef.Type)
+			}
+		}
+		return nil
+	}
+	_, err := rotationFor(secretRef)
+	return err
+}
+
+// rotatedAt returns when the existing secret was last rotated. Secrets created before rotation was enabled are
+// considered rotated when they were created.
+func rotatedAt(existing *corev1.Secret) (time.Time, bool) {
+	if existing == nil {
+		return time.Time{}, false
+	}
+	if t, err := time.Parse(time.RFC3339, existing.Annotations[labels.AcornSecretRotatedAt]); err == nil {
+		return t, true
+	}
+	return existing.CreationTimestamp.Time, !existing.CreationTimestamp.IsZero()
+}
+
+// rotate prepares secret for rotating the generated value in key. If the existing secret is due for rotation, the
+// current value is moved to the previous key and key is cleared so the caller generates a new value. The previous
+// value is kept until the grace period after the rotation is over. Values set explicitly in the Acornfile are
+// never rotated.
+func rotate(secret, existing *corev1.Secret, secretRef v1.Secret, key string, now time.Time) error {
+	r, err := rotationFor(secretRef)
+	if err != nil || r == nil {
+		return err
+	}
+	if len(secretRef.Data[key]) > 0 {
+		return nil
+	}
+
+	last, ok := rotatedAt(existing)
+	switch {
+	case !ok:
+		last = now
+	case now.Sub(last) >= r.interval && len(secret.Data[key]) > 0:
+		secret.Data[PreviousKey] = secret.Data[key]
+		delete(secret.Data, key)
+		last = now
+	case len(existing.Data[PreviousKey]) > 0 && now.Sub(last) < r.gracePeriod:
+		secret.Data[PreviousKey] = existing.Data[PreviousKey]
+	}
+
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[labels.AcornSecretRotatedAt] = last.UTC().Format(time.RFC3339)
+	return nil
+}
+
+// NextRotation returns how long until the generated secret needs to be reconciled again, either to rotate its value
+// or to drop the previous value after the grace period. Zero is returned if the secret is not rotated.
+func NextRotation(secretRef v1.Secret, secret *corev1.Secret, now time.Time) time.Duration {
+	if secret.Type != v1.SecretTypeToken && secret.Type != v1.SecretTypeBasic {
+		return 0
+	}
+
+	r, err := rotationFor(secretRef)
+	if err != nil || r == nil {
+		return 0
+	}
+
+	last, ok := rotatedAt(secret)
+	if !ok {
+		return 0
+	}
+
+	next := last.Add(r.interval)
+	if _, ok := secret.Data[PreviousKey]; ok && last.Add(r.gracePeriod).Before(next) {
+		next = last.Add(r.gracePeriod)
+	}
+
+	if d := next.Sub(now); d > time.Second {
+		return d
+	}
+	return time.Second
+}
@@ -0,0 +1,128 @@
+package secrets
+
+import (
+	"testing"
+	"time"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRotate(t *testing.T) {
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	secretRef := v1.Secret{
+		Type:   "token",
+		Params: v1.NewGenericMap(map[string]any{"rotate": "24h", "rotateGracePeriod": "1h"}),
+	}
+	newSecret := func(existing *corev1.Secret) *corev1.Secret {
+		return &corev1.Secret{
+			Data: seedData(existing, nil, "token"),
+			Type: v1.SecretTypeToken,
+		}
+	}
+
+	// A new secret records when it was generated
+	secret := newSecret(nil)
+	assert.NoError(t, rotate(secret, nil, secretRef, "token", now))
+	assert.Equal(t, now.Format(time.RFC3339), secret.Annotations[labels.AcornSecretRotatedAt])
+
+	existing := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{labels.AcornSecretRotatedAt: now.Format(time.RFC3339)},
+		},
+		Data: map[string][]byte{"token": []byte("first")},
+		Type: v1.SecretTypeToken,
+	}
+
+	// Not due yet
+	secret = newSecret(existing)
+	assert.NoError(t, rotate(secret, existing, secretRef, "token", now.Add(time.Hour)))
+	assert.Equal(t, "first", string(secret.Data["token"]))
+	assert.NotContains(t, secret.Data, PreviousKey)
+	assert.Equal(t, 23*time.Hour, NextRotation(secretRef, existing, now.Add(time.Hour)))
+
+	// Due, the current value becomes the previous value and the key is cleared to be regenerated
+	rotated := now.Add(25 * time.Hour)
+	secret = newSecret(existing)
+	assert.NoError(t, rotate(secret, existing, secretRef, "token", rotated))
+	assert.Equal(t, "first", string(secret.Data[PreviousKey]))
+	assert.Empty(t, secret.Data["token"])
+	assert.Equal(t, rotated.Format(time.RFC3339), secret.Annotations[labels.AcornSecretRotatedAt])
+
+	existing = &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Annotations: secret.Annotations},
+		Data:       map[string][]byte{"token": []byte("second"), PreviousKey: []byte("first")},
+		Type:       v1.SecretTypeToken,
+	}
+
+	// The previous value is kept during the grace period
+	secret = newSecret(existing)
+	assert.NoError(t, rotate(secret, existing, secretRef, "token", rotated.Add(30*time.Minute)))
+	assert.Equal(t, "first", string(secret.Data[PreviousKey]))
+	assert.Equal(t, 30*time.Minute, NextRotation(secretRef, existing, rotated.Add(30*time.Minute)))
+
+	// and dropped after it
+	secret = newSecret(existing)
+	assert.NoError(t, rotate(secret, existing, secretRef, "token", rotated.Add(2*time.Hour)))
+	assert.NotContains(t, secret.Data, PreviousKey)
+	assert.Equal(t, "second", string(secret.Data["token"]))
+
+	// Explicitly set values are never rotated
+	secretRef.Data = map[string]string{"token": "mine"}
+	secret = &corev1.Secret{Data: seedData(existing, secretRef.Data, "token")}
+	assert.NoError(t, rotate(secret, existing, secretRef, "token", rotated.Add(48*time.Hour)))
+	assert.Equal(t, "mine", string(secret.Data["token"]))
+	assert.NotContains(t, secret.Data, PreviousKey)
+
+	secretRef = v1.Secret{Params: v1.NewGenericMap(map[string]any{"rotate": "often"})}
+	assert.Error(t, rotate(newSecret(nil), nil, secretRef, "token", now))
+}
+
+func TestValidateRotation(t *testing.T) {
+	tests := []struct {
+		name    string
+		secret  v1.Secret
+		wantErr string
+	}{
+		{
+			name:   "token",
+			secret: v1.Secret{Type: "token", Params: v1.NewGenericMap(map[string]any{"rotate": "24h"})},
+		},
+		{
+			name:   "basic",
+			secret: v1.Secret{Type: "basic", Params: v1.NewGenericMap(map[string]any{"rotate": "24h", "rotateGracePeriod": "1h"})},
+		},
+		{
+			name:   "not rotated",
+			secret: v1.Secret{Type: "generated", Params: v1.NewGenericMap(map[string]any{"job": "gen"})},
+		},
+		{
+			name:    "invalid interval",
+			secret:  v1.Secret{Type: "token", Params: v1.NewGenericMap(map[string]any{"rotate": "monthly"})},
+			wantErr: "invalid rotate param [monthly]",
+		},
+		{
+			name:    "opaque",
+			secret:  v1.Secret{Type: "opaque", Params: v1.NewGenericMap(map[string]any{"rotate": "24h"})},
+			wantErr: "rotate param is only supported on token and basic secrets, not opaque secrets",
+		},
+		{
+			name:    "generated",
+			secret:  v1.Secret{Type: "generated", Params: v1.NewGenericMap(map[string]any{"job": "gen", "rotateGracePeriod": "1h"})},
+			wantErr: "rotateGracePeriod param is only supported on token and basic secrets, not generated secrets",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRotation(tt.secret)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
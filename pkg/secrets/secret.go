@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/acorn-io/baaah/pkg/merr"
 	"github.com/acorn-io/baaah/pkg/router"
@@ -18,6 +19,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/publicname"
 	"github.com/acorn-io/runtime/pkg/ref"
+	"github.com/acorn-io/runtime/pkg/secrets/backends"
 	"github.com/acorn-io/runtime/pkg/system"
 	"github.com/acorn-io/schemer/data/convert"
 	"golang.org/x/exp/maps"
@@ -204,6 +206,10 @@ func generateToken(req router.Request, appInstance *v1.AppInstance, secretName s
 		Type: v1.SecretTypeToken,
 	}
 
+	if err := rotate(secret, existing, secretRef, "token", time.Now()); err != nil {
+		return nil, err
+	}
+
 	if len(secret.Data["token"]) == 0 {
 		length, err := convert.ToNumber(secretRef.Params.GetData()["length"])
 		if err != nil {
@@ -247,6 +253,11 @@ func generateBasic(req router.Request, appInstance *v1.AppInstance, secretName s
 		Type: v1.SecretTypeBasic,
 	}
 
+	// Only the password is rotated so that consumers can keep using the same user
+	if err := rotate(secret, existing, secretRef, corev1.BasicAuthPasswordKey, time.Now()); err != nil {
+		return nil, err
+	}
+
 	for _, keys := range []struct {
 		dataKey, lengthKey, charactersKey string
 	}{
@@ -428,6 +439,22 @@ func GetOrCreateSecret(secrets map[string]*corev1.Secret, req router.Request, ap
 	}
 
 	if secretRef != "" {
+		if backends.IsRef(secretRef) {
+			data, err := backends.Resolve(req.Ctx, req.Client, appInstance.Namespace, secretRef)
+			if err != nil {
+				return nil, fmt.Errorf("resolving %s: %w", secretRef, err)
+			}
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      secretName,
+					Namespace: appInstance.Namespace,
+				},
+				Data: data,
+				Type: corev1.SecretTypeOpaque,
+			}
+			secrets[secretName] = secret
+			return secret, nil
+		}
 		if strings.HasPrefix(secretRef, "context://") {
 			existingSecret := &corev1.Secret{}
 			name := "context-" + strings.TrimPrefix(secretRef, "context://")
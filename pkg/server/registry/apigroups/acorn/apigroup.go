@@ -8,6 +8,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/event"
 	"github.com/acorn-io/runtime/pkg/imagesystem"
 	"github.com/acorn-io/runtime/pkg/scheme"
+	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/apprevisions"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/apps"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/builders"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/builds"
@@ -15,16 +16,20 @@ import (
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/credentials"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/devsessions"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/events"
+	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/eventsubscriptions"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/imageallowrules"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/images"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/info"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/jobs"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/projects"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/regions"
+	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/secretkeys"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/secrets"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/volumes"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/volumes/class"
+	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/acorn/volumesnapshots"
 	"github.com/acorn-io/runtime/pkg/server/registry/apigroups/admin/computeclass"
+	"github.com/acorn-io/runtime/pkg/server/registry/middleware"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apiserver/pkg/registry/rest"
@@ -65,7 +70,7 @@ func Stores(c kclient.WithWatch, cfg, localCfg *clientgo.Config) (map[string]res
 		return nil, err
 	}
 
-	appsStorage := apps.NewStorage(c, clientFactory, event.NewRecorder(c), transport)
+	appsStorage := apps.NewStorage(c, clientFactory, event.NewRecorder(c), transport, middleware.Tracing("apps"))
 
 	logsStorage, err := apps.NewLogs(c, cfg)
 	if err != nil {
@@ -86,8 +91,11 @@ func Stores(c kclient.WithWatch, cfg, localCfg *clientgo.Config) (map[string]res
 		"apps/info":                     apps.NewInfo(c),
 		"apps/icon":                     apps.NewIcon(c, transport),
 		"apps/confirmupgrade":           apps.NewConfirmUpgrade(c),
+		"apps/rollout":                  apps.NewRollout(c),
+		"apps/estimate":                 apps.NewEstimate(c),
 		"apps/pullimage":                apps.NewPullAppImage(c),
 		"apps/ignorecleanup":            apps.NewIgnoreCleanup(c),
+		"apprevisions":                  apprevisions.NewStorage(c),
 		"devsessions":                   devsessions.NewStorage(c, clientFactory),
 		"builders":                      buildersStorage,
 		"builders/port":                 buildersPort,
@@ -101,17 +109,20 @@ func Stores(c kclient.WithWatch, cfg, localCfg *clientgo.Config) (map[string]res
 		"projects":                      projectStorage,
 		"volumes":                       volumesStorage,
 		"volumeclasses":                 class.NewClassStorage(c),
+		"volumesnapshots":               volumesnapshots.NewStorage(c),
 		"containerreplicas":             containersStorage,
 		"containerreplicas/exec":        containerExec,
 		"containerreplicas/portforward": portForward,
 		"credentials":                   credentials.NewStore(c),
-		"secrets":                       secrets.NewStorage(c),
+		"secrets":                       secrets.NewStorage(c, middleware.Tracing("secrets")),
 		"secrets/reveal":                secrets.NewReveal(c),
+		"secretkeys":                    secretkeys.NewStorage(c),
 		"infos":                         info.NewStorage(c),
 		"computeclasses":                computeclass.NewAggregateStorage(c),
 		"regions":                       regions.NewStorage(c),
 		"imageallowrules":               imageallowrules.NewStorage(c),
 		"events":                        events.NewStorage(c),
+		"eventsubscriptions":            eventsubscriptions.NewStorage(c),
 		"jobs":                          jobs.NewStorage(c),
 		"jobs/restart":                  jobs.NewRestart(c),
 	}
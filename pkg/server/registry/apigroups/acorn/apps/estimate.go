@@ -0,0 +1,109 @@
+package apps
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/mink/pkg/stores"
+	"github.com/acorn-io/mink/pkg/types"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/controller/resolvedofferings"
+	"github.com/acorn-io/runtime/pkg/estimate"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewEstimate(c client.WithWatch) rest.Storage {
+	return stores.NewBuilder(c.Scheme(), &apiv1.AppEstimate{}).
+		WithCreate(&EstimateStrategy{
+			client: c,
+		}).WithValidateName(nestedValidator{}).Build()
+}
+
+type EstimateStrategy struct {
+	client client.WithWatch
+}
+
+func (s *EstimateStrategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
+	ri, _ := request.RequestInfoFrom(ctx)
+
+	if ri.Name == "" || ri.Namespace == "" {
+		return obj, nil
+	}
+
+	input := obj.(*apiv1.AppEstimate)
+
+	// The app doesn't have to exist if the spec to estimate is given, in which case the estimate is of a new app.
+	appInstance, err := GetAppInstanceFromPublicName(ctx, s.client, ri.Namespace, ri.Name)
+	if apierrors.IsNotFound(err) && input.Spec != nil {
+		appInstance = &v1.AppInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ri.Name,
+				Namespace: ri.Namespace,
+			},
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	if input.Spec != nil {
+		appInstance.Spec = *input.Spec
+
+		details, err := s.getImageDetails(ctx, appInstance)
+		if err != nil {
+			return nil, err
+		}
+		appInstance.Status.AppSpec = *details.AppSpec
+
+		if err := resolvedofferings.Resolve(router.Request{
+			Client: s.client,
+			Object: appInstance,
+			Ctx:    ctx,
+		}, appInstance); err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := estimate.App(ctx, s.client, appInstance)
+	if err != nil {
+		return nil, err
+	}
+	result.ObjectMeta = input.ObjectMeta
+	result.Spec = input.Spec
+	return result, nil
+}
+
+// getImageDetails renders the app spec of the image of the app with its deploy args and profiles.
+func (s *EstimateStrategy) getImageDetails(ctx context.Context, appInstance *v1.AppInstance) (*apiv1.ImageDetails, error) {
+	details := &apiv1.ImageDetails{
+		DeployArgs: appInstance.Spec.DeployArgs,
+		Profiles:   appInstance.Spec.Profiles,
+	}
+	if err := s.client.SubResource("details").Create(ctx, &apiv1.Image{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      strings.ReplaceAll(appInstance.Spec.Image, "/", "+"),
+			Namespace: appInstance.Namespace,
+		},
+	}, details); err != nil {
+		return nil, err
+	}
+
+	if details.GetParseError() != "" {
+		return nil, errors.New(details.GetParseError())
+	}
+	if details.AppSpec == nil {
+		return nil, apierrors.NewBadRequest("image " + appInstance.Spec.Image + " has no app spec")
+	}
+
+	return details, nil
+}
+
+func (s *EstimateStrategy) New() types.Object {
+	return &apiv1.AppEstimate{}
+}
@@ -0,0 +1,104 @@
+package apps
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/acorn-io/mink/pkg/stores"
+	"github.com/acorn-io/mink/pkg/types"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	kclient "github.com/acorn-io/runtime/pkg/k8sclient"
+	"github.com/acorn-io/runtime/pkg/labels"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewRollout(c client.WithWatch) rest.Storage {
+	return stores.NewBuilder(c.Scheme(), &apiv1.AppRollout{}).
+		WithCreate(&RolloutStrategy{
+			client: c,
+		}).WithValidateName(nestedValidator{}).Build()
+}
+
+type RolloutStrategy struct {
+	client client.WithWatch
+}
+
+func (s *RolloutStrategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
+	ri, _ := request.RequestInfoFrom(ctx)
+
+	if ri.Name == "" || ri.Namespace == "" {
+		return obj, nil
+	}
+
+	rollout := obj.(*apiv1.AppRollout)
+	switch rollout.Action {
+	case v1.RolloutActionPromote, v1.RolloutActionAbort:
+	default:
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid rollout action %q, must be %s or %s",
+			rollout.Action, v1.RolloutActionPromote, v1.RolloutActionAbort))
+	}
+
+	return obj, retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		// Use app instance here because in Manager this request is forwarded to the workload cluster.
+		// The app validation logic should not run there.
+		app := &v1.AppInstance{}
+		err := s.client.Get(ctx, kclient.ObjectKey{Namespace: ri.Namespace, Name: ri.Name}, app)
+		if apierrors.IsNotFound(err) {
+			// See if this is a public name
+			appList := &v1.AppInstanceList{}
+			listErr := s.client.List(ctx, appList, client.MatchingLabels{labels.AcornPublicName: ri.Name}, client.InNamespace(ri.Namespace))
+			if listErr != nil {
+				return listErr
+			}
+			if len(appList.Items) != 1 {
+				//return the NotFound error we got originally
+				return err
+			}
+			app = &appList.Items[0]
+		} else if err != nil {
+			return err
+		}
+
+		if err := setRequestedAction(app, rollout.Action, rollout.Containers); err != nil {
+			return err
+		}
+
+		return s.client.Status().Update(ctx, app)
+	})
+}
+
+// setRequestedAction records the action on the status of the containers so that the controller applies it on the next
+// reconcile.
+func setRequestedAction(app *v1.AppInstance, action v1.RolloutAction, containers []string) error {
+	if len(containers) == 0 {
+		for name, cs := range app.Status.AppStatus.Containers {
+			if cs.Rollout.InProgress() {
+				containers = append(containers, name)
+			}
+		}
+		if len(containers) == 0 {
+			return apierrors.NewBadRequest(fmt.Sprintf("app %s does not have a rollout in progress", app.Name))
+		}
+	}
+
+	for _, name := range containers {
+		cs, ok := app.Status.AppStatus.Containers[name]
+		if !ok || !cs.Rollout.InProgress() {
+			return apierrors.NewBadRequest(fmt.Sprintf("container %s of app %s does not have a rollout in progress", name, app.Name))
+		}
+		cs.Rollout = cs.Rollout.DeepCopy()
+		cs.Rollout.RequestedAction = action
+		app.Status.AppStatus.Containers[name] = cs
+	}
+
+	return nil
+}
+
+func (s *RolloutStrategy) New() types.Object {
+	return &apiv1.AppRollout{}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/acorn-io/mink/pkg/strategy/remote"
 	mtypes "github.com/acorn-io/mink/pkg/types"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/tracing"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -22,7 +23,15 @@ type appInstanceStrategy struct {
 	c kclient.WithWatch
 }
 
+func (s *appInstanceStrategy) Create(ctx context.Context, obj mtypes.Object) (mtypes.Object, error) {
+	// Record the trace of the request so the controller deploying the app continues it
+	tracing.Inject(ctx, obj)
+	return s.CompleteStrategy.Create(ctx, obj)
+}
+
 func (s *appInstanceStrategy) Update(ctx context.Context, obj mtypes.Object) (mtypes.Object, error) {
+	tracing.Inject(ctx, obj)
+
 	// Get the existing object
 	var existing v1.AppInstance
 	if err := s.c.Get(ctx, kclient.ObjectKeyFromObject(obj), &existing); err != nil {
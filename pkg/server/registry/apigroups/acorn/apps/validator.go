@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"regexp"
 	"sort"
 	"strings"
 	"sync"
@@ -17,15 +18,20 @@ import (
 	"github.com/acorn-io/namegenerator"
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/appschedule"
 	"github.com/acorn-io/runtime/pkg/autoupgrade"
 	"github.com/acorn-io/runtime/pkg/client"
 	"github.com/acorn-io/runtime/pkg/computeclasses"
 	apiv1config "github.com/acorn-io/runtime/pkg/config"
+	"github.com/acorn-io/runtime/pkg/controller/appdefinition"
 	"github.com/acorn-io/runtime/pkg/imagerules"
 	"github.com/acorn-io/runtime/pkg/images"
 	"github.com/acorn-io/runtime/pkg/imagesystem"
+	"github.com/acorn-io/runtime/pkg/jobs"
 	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/runtime/pkg/publish"
 	"github.com/acorn-io/runtime/pkg/pullsecret"
+	"github.com/acorn-io/runtime/pkg/secrets"
 	"github.com/acorn-io/runtime/pkg/tags"
 	"github.com/acorn-io/runtime/pkg/volume"
 	"github.com/acorn-io/z"
@@ -45,7 +51,20 @@ import (
 )
 
 var (
-	nameGenerator = namegenerator.NewNameGenerator(time.Now().UnixNano())
+	nameGenerator   = namegenerator.NewNameGenerator(time.Now().UnixNano())
+	routeHeaderName = regexp.MustCompile(`^[a-zA-Z0-9-_]+$`)
+	routeCookieName = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+	routeMethods    = map[string]bool{
+		http.MethodGet:     true,
+		http.MethodHead:    true,
+		http.MethodPost:    true,
+		http.MethodPut:     true,
+		http.MethodPatch:   true,
+		http.MethodDelete:  true,
+		http.MethodConnect: true,
+		http.MethodOptions: true,
+		http.MethodTrace:   true,
+	}
 )
 
 type Validator struct {
@@ -123,6 +142,13 @@ func (s *Validator) Validate(ctx context.Context, obj runtime.Object) (result fi
 		return
 	}
 
+	if app.Spec.Schedule != nil {
+		if _, err := appschedule.Parse(*app.Spec.Schedule); err != nil {
+			result = append(result, field.Invalid(field.NewPath("spec", "schedule"), app.Spec.Schedule, err.Error()))
+			return
+		}
+	}
+
 	if err := imagesystem.IsNotInternalRepo(ctx, s.client, app.Namespace, app.Spec.Image); err != nil {
 		result = append(result, field.Invalid(field.NewPath("spec", "image"), app.Spec.Image, err.Error()))
 		return
@@ -217,6 +243,46 @@ func (s *Validator) Validate(ctx context.Context, obj runtime.Object) (result fi
 			}
 		}
 
+		if errs := validateJobs(imageDetails.AppSpec); len(errs) != 0 {
+			result = append(result, errs...)
+			return
+		}
+
+		if errs := validateAutoscale(app.Spec, imageDetails.AppSpec); len(errs) != 0 {
+			result = append(result, errs...)
+			return
+		}
+
+		if errs := validateRollouts(imageDetails.AppSpec); len(errs) != 0 {
+			result = append(result, errs...)
+			return
+		}
+
+		if errs := validateSecrets(imageDetails.AppSpec); len(errs) != 0 {
+			result = append(result, errs...)
+			return
+		}
+
+		if errs := validateRouters(imageDetails.AppSpec); len(errs) != 0 {
+			result = append(result, errs...)
+			return
+		}
+
+		if errs, hasPolicies := validatePortPolicies(app.Spec, imageDetails.AppSpec); len(errs) != 0 {
+			result = append(result, errs...)
+			return
+		} else if hasPolicies {
+			cfg, err := apiv1config.Get(ctx, s.client)
+			if err != nil {
+				result = append(result, field.Invalid(field.NewPath("config"), app.Spec.Image, err.Error()))
+				return
+			}
+			if err := publish.CheckPolicySupport(ctx, s.client, cfg); err != nil {
+				result = append(result, field.Invalid(field.NewPath("spec", "ports"), app.Spec.Publish, err.Error()))
+				return
+			}
+		}
+
 		if err := validateVolumeClasses(ctx, s.client, app.Namespace, app.Spec, imageDetails.AppSpec, project); err != nil {
 			result = append(result, err)
 			return
@@ -682,6 +748,186 @@ func validateMemoryRunFlags(memory v1.MemoryMap, workloads map[string]v1.Contain
 	return validationErrors
 }
 
+// validateJobs checks that the dependencies of the jobs on each other do not form a cycle and that only scheduled jobs
+// set a concurrency policy.
+func validateJobs(appSpec *v1.AppSpec) (result field.ErrorList) {
+	if _, _, err := jobs.Steps(appSpec.Jobs); err != nil {
+		result = append(result, field.Invalid(field.NewPath("spec", "image"), "jobs", err.Error()))
+	}
+	for _, jobName := range typed.SortedKeys(appSpec.Jobs) {
+		job := appSpec.Jobs[jobName]
+		if job.ConcurrencyPolicy != "" && job.Schedule == "" {
+			result = append(result, field.Invalid(field.NewPath("spec", "image"), jobName,
+				fmt.Sprintf("job [%s] sets a concurrency policy but has no schedule", jobName)))
+		}
+	}
+	return result
+}
+
+// validateAutoscale checks that autoscaled containers are not stateful. Stateful containers run as StatefulSets, which
+// are not autoscaled.
+func validateAutoscale(appInstanceSpec v1.AppInstanceSpec, appSpec *v1.AppSpec) (result field.ErrorList) {
+	volumes := make(map[string]v1.VolumeRequest, len(appSpec.Volumes))
+	for name, vol := range appSpec.Volumes {
+		for _, binding := range appInstanceSpec.Volumes {
+			if binding.Target != name {
+				continue
+			}
+			if binding.Class != "" {
+				vol.Class = binding.Class
+			}
+			if len(binding.AccessModes) != 0 {
+				vol.AccessModes = binding.AccessModes
+			}
+		}
+		volumes[name] = vol
+	}
+
+	for _, containerName := range typed.SortedKeys(appSpec.Containers) {
+		container := appSpec.Containers[containerName]
+		if container.Autoscale != nil && volume.IsStateful(volumes, container) {
+			result = append(result, field.Invalid(field.NewPath("spec", "image"), containerName,
+				fmt.Sprintf("container [%s] cannot be autoscaled because it mounts a ReadWriteOnce volume", containerName)))
+		}
+	}
+	return result
+}
+
+// validateRollouts checks that the replicas of each container with a canary rollout can run the weights of its steps.
+func validateRollouts(appSpec *v1.AppSpec) (result field.ErrorList) {
+	for _, containerName := range typed.SortedKeys(appSpec.Containers) {
+		if err := appdefinition.ValidateRolloutSteps(appSpec.Containers[containerName]); err != nil {
+			result = append(result, field.Invalid(field.NewPath("spec", "image"), containerName,
+				fmt.Sprintf("container [%s]: %v", containerName, err)))
+		}
+	}
+	return result
+}
+
+// validateSecrets checks that only the secrets that are rotated set rotation params, and that those params are valid.
+func validateSecrets(appSpec *v1.AppSpec) (result field.ErrorList) {
+	for _, secretName := range typed.SortedKeys(appSpec.Secrets) {
+		if err := secrets.ValidateRotation(appSpec.Secrets[secretName]); err != nil {
+			result = append(result, field.Invalid(field.NewPath("spec", "image"), secretName,
+				fmt.Sprintf("secret [%s]: %v", secretName, err)))
+		}
+	}
+	return result
+}
+
+// validateRouters checks that each route of the routers has a target to send requests to, that the weights of the
+// targets are valid and that the headers, cookies and methods the routes match on and the headers they modify are
+// valid names, because they are written to the config of the router as they are.
+func validateRouters(appSpec *v1.AppSpec) (result field.ErrorList) {
+	for _, routerName := range typed.SortedKeys(appSpec.Routers) {
+		for _, route := range appSpec.Routers[routerName].Routes {
+			invalid := func(format string, args ...any) {
+				result = append(result, field.Invalid(field.NewPath("spec", "image"), routerName,
+					fmt.Sprintf("route [%s] of router [%s] %s", route.Path, routerName, fmt.Sprintf(format, args...))))
+			}
+
+			for _, target := range route.Targets {
+				if target.TargetServiceName == "" {
+					invalid("has a target without a service name")
+				}
+				if target.Weight < 0 {
+					invalid("has a negative weight for target [%s]", target.TargetServiceName)
+				}
+			}
+			if len(route.GetTargets()) == 0 {
+				invalid("has no target")
+			}
+
+			if route.Match != nil {
+				for header := range route.Match.Headers {
+					if !routeHeaderName.MatchString(header) {
+						invalid("matches on invalid header name [%s]", header)
+					}
+				}
+				for cookie := range route.Match.Cookies {
+					if !routeCookieName.MatchString(cookie) {
+						invalid("matches on invalid cookie name [%s]", cookie)
+					}
+				}
+				for _, method := range route.Match.Methods {
+					if !routeMethods[strings.ToUpper(method)] {
+						invalid("matches on invalid method [%s]", method)
+					}
+				}
+			}
+
+			validateHeaders := func(direction string, modifier *v1.HeaderModifier) {
+				if modifier == nil {
+					return
+				}
+				for _, header := range typed.SortedKeys(modifier.Set) {
+					if !routeHeaderName.MatchString(header) {
+						invalid("sets invalid %s header name [%s]", direction, header)
+					}
+				}
+				for _, header := range modifier.Remove {
+					if !routeHeaderName.MatchString(header) {
+						invalid("removes invalid %s header name [%s]", direction, header)
+					}
+				}
+			}
+			validateHeaders("request", route.RequestHeaders)
+			validateHeaders("response", route.ResponseHeaders)
+		}
+	}
+	return result
+}
+
+// validatePortPolicies checks the policies of the ports of the app and of the ports it is published with. It also
+// returns whether there are any policies, because the ingress controller must then be able to enforce them.
+func validatePortPolicies(appInstanceSpec v1.AppInstanceSpec, appSpec *v1.AppSpec) (result field.ErrorList, hasPolicies bool) {
+	validate := func(path *field.Path, value any, protocol v1.Protocol, policy *v1.PortPolicy) {
+		if policy == nil {
+			return
+		}
+		hasPolicies = true
+		if protocol != "" && protocol != v1.ProtocolHTTP && protocol != v1.ProtocolHTTP2 {
+			result = append(result, field.Invalid(path, value, fmt.Sprintf("policies can only be set on http ports, not %s ports", protocol)))
+		}
+		if err := publish.ValidatePolicy(*policy); err != nil {
+			result = append(result, field.Invalid(path, value, err.Error()))
+		}
+		if policy.Auth != nil && policy.Auth.BasicSecret != "" {
+			if secret, ok := appSpec.Secrets[policy.Auth.BasicSecret]; !ok {
+				result = append(result, field.Invalid(path, value, fmt.Sprintf("basic auth secret [%s] is not a secret of the app", policy.Auth.BasicSecret)))
+			} else if secret.Type != "" && secret.Type != "basic" {
+				result = append(result, field.Invalid(path, value, fmt.Sprintf("basic auth secret [%s] must be of type basic, not %s", policy.Auth.BasicSecret, secret.Type)))
+			}
+		}
+	}
+
+	validatePorts := func(kind, name string, ports []v1.PortDef) {
+		for _, port := range ports {
+			validate(field.NewPath("spec", "image"), fmt.Sprintf("%s [%s] port [%d]", kind, name, port.TargetPort), port.Complete().Protocol, port.Policy)
+		}
+	}
+
+	for _, containerName := range typed.SortedKeys(appSpec.Containers) {
+		container := appSpec.Containers[containerName]
+		validatePorts("container", containerName, container.Ports)
+		for _, sidecarName := range typed.SortedKeys(container.Sidecars) {
+			validatePorts("sidecar", sidecarName, container.Sidecars[sidecarName].Ports)
+		}
+	}
+	for _, functionName := range typed.SortedKeys(appSpec.Functions) {
+		validatePorts("function", functionName, appSpec.Functions[functionName].Ports)
+	}
+	for _, serviceName := range typed.SortedKeys(appSpec.Services) {
+		validatePorts("service", serviceName, appSpec.Services[serviceName].Ports)
+	}
+
+	for i, binding := range appInstanceSpec.Publish {
+		validate(field.NewPath("spec", "publish").Index(i), binding.TargetPort, binding.Protocol, binding.Policy)
+	}
+
+	return result, hasPolicies
+}
+
 func validateVolumeClasses(ctx context.Context, c kclient.Client, namespace string, appInstanceSpec v1.AppInstanceSpec, appSpec *v1.AppSpec, project *v1.ProjectInstance) *field.Error {
 	if len(appInstanceSpec.Volumes) == 0 && len(appSpec.Volumes) == 0 {
 		return nil
@@ -8,6 +8,7 @@ import (
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	internalv1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/acorn-io/z"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -144,3 +145,295 @@ func TestCannotUpdateNestedAcorn(t *testing.T) {
 		assert.True(t, strings.Contains(err[0].Error(), "update the parent Acorn"))
 	}
 }
+
+func TestValidateJobs(t *testing.T) {
+	tests := []struct {
+		name        string
+		jobs        map[string]internalv1.Container
+		expectError string
+	}{
+		{
+			name: "Valid: Chain",
+			jobs: map[string]internalv1.Container{
+				"migrate":    {},
+				"seed":       {Dependencies: internalv1.Dependencies{{TargetName: "migrate"}}},
+				"smoke-test": {Dependencies: internalv1.Dependencies{{TargetName: "seed"}, {TargetName: "web"}}},
+			},
+		},
+		{
+			name: "Invalid: Cycle",
+			jobs: map[string]internalv1.Container{
+				"migrate":    {Dependencies: internalv1.Dependencies{{TargetName: "smoke-test"}}},
+				"seed":       {Dependencies: internalv1.Dependencies{{TargetName: "migrate"}}},
+				"smoke-test": {Dependencies: internalv1.Dependencies{{TargetName: "seed"}}},
+			},
+			expectError: "job dependency cycle: migrate -> smoke-test -> seed -> migrate",
+		},
+		{
+			name: "Invalid: Self",
+			jobs: map[string]internalv1.Container{
+				"migrate": {Dependencies: internalv1.Dependencies{{TargetName: "migrate"}}},
+			},
+			expectError: "job dependency cycle: migrate -> migrate",
+		},
+		{
+			name: "Invalid: Concurrency policy without schedule",
+			jobs: map[string]internalv1.Container{
+				"migrate": {ConcurrencyPolicy: internalv1.JobConcurrencyPolicyForbid},
+			},
+			expectError: "job [migrate] sets a concurrency policy but has no schedule",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateJobs(&internalv1.AppSpec{Jobs: tt.jobs})
+			if tt.expectError == "" {
+				assert.Empty(t, errs)
+				return
+			}
+			if assert.Len(t, errs, 1) {
+				assert.Contains(t, errs[0].Error(), tt.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateAutoscale(t *testing.T) {
+	tests := []struct {
+		name        string
+		volumes     internalv1.VolumeBindings
+		expectError string
+	}{
+		{
+			name:        "Invalid: ReadWriteOnce volume",
+			expectError: "container [web] cannot be autoscaled because it mounts a ReadWriteOnce volume",
+		},
+		{
+			name:    "Valid: ReadWriteMany volume binding",
+			volumes: internalv1.VolumeBindings{{Target: "data", AccessModes: internalv1.AccessModes{internalv1.AccessModeReadWriteMany}}},
+		},
+		{
+			name:    "Valid: Ephemeral volume binding",
+			volumes: internalv1.VolumeBindings{{Target: "data", Class: "ephemeral"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateAutoscale(internalv1.AppInstanceSpec{Volumes: tt.volumes}, &internalv1.AppSpec{
+				Containers: map[string]internalv1.Container{
+					"web": {
+						Autoscale: &internalv1.Autoscale{MaxReplicas: 3},
+						Dirs:      map[string]internalv1.VolumeMount{"/data": {Volume: "data"}},
+					},
+				},
+				Volumes: map[string]internalv1.VolumeRequest{"data": {}},
+			})
+			if tt.expectError == "" {
+				assert.Empty(t, errs)
+				return
+			}
+			if assert.Len(t, errs, 1) {
+				assert.Contains(t, errs[0].Error(), tt.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateRouters(t *testing.T) {
+	tests := []struct {
+		name        string
+		routes      []internalv1.Route
+		expectError string
+	}{
+		{
+			name: "Valid: Weighted and matched",
+			routes: []internalv1.Route{
+				{Path: "/api", TargetServiceName: "canary", Match: &internalv1.RouteMatch{
+					Headers: map[string]string{"X-Canary": "true"},
+					Cookies: map[string]string{"session_id": "beta"},
+				}},
+				{Path: "/api", Targets: []internalv1.RouteTarget{{TargetServiceName: "blue", Weight: 90}, {TargetServiceName: "green", Weight: 10}}},
+			},
+		},
+		{
+			name:        "Invalid: No target",
+			routes:      []internalv1.Route{{Path: "/api", StripPrefix: true}},
+			expectError: "route [/api] of router [router] has no target",
+		},
+		{
+			name:        "Invalid: Negative weight",
+			routes:      []internalv1.Route{{Path: "/api", Targets: []internalv1.RouteTarget{{TargetServiceName: "blue", Weight: -1}}}},
+			expectError: "has a negative weight for target [blue]",
+		},
+		{
+			name: "Invalid: Cookie name",
+			routes: []internalv1.Route{{Path: "/api", TargetServiceName: "canary", Match: &internalv1.RouteMatch{
+				Cookies: map[string]string{"session-id": "beta"},
+			}}},
+			expectError: "matches on invalid cookie name [session-id]",
+		},
+		{
+			name: "Invalid: Match header name",
+			routes: []internalv1.Route{{Path: "/api", TargetServiceName: "canary", Match: &internalv1.RouteMatch{
+				Headers: map[string]string{"X-Canary) { return 302 http://evil; } if ($x": "true"},
+			}}},
+			expectError: "matches on invalid header name",
+		},
+		{
+			name: "Valid: Methods",
+			routes: []internalv1.Route{{Path: "/api", TargetServiceName: "canary", Match: &internalv1.RouteMatch{
+				Methods: []string{"get", "POST"},
+			}}},
+		},
+		{
+			name: "Invalid: Method",
+			routes: []internalv1.Route{{Path: "/api", TargetServiceName: "canary", Match: &internalv1.RouteMatch{
+				Methods: []string{"GET|.*"},
+			}}},
+			expectError: "matches on invalid method [GET|.*]",
+		},
+		{
+			name: "Invalid: Set request header name",
+			routes: []internalv1.Route{{Path: "/api", TargetServiceName: "canary", RequestHeaders: &internalv1.HeaderModifier{
+				Set: map[string]string{"X; return 302 http://evil;": "true"},
+			}}},
+			expectError: "sets invalid request header name [X; return 302 http://evil;]",
+		},
+		{
+			name: "Invalid: Remove request header name",
+			routes: []internalv1.Route{{Path: "/api", TargetServiceName: "canary", RequestHeaders: &internalv1.HeaderModifier{
+				Remove: []string{"X; return 302 http://evil;"},
+			}}},
+			expectError: "removes invalid request header name [X; return 302 http://evil;]",
+		},
+		{
+			name: "Invalid: Set response header name",
+			routes: []internalv1.Route{{Path: "/api", TargetServiceName: "canary", ResponseHeaders: &internalv1.HeaderModifier{
+				Set: map[string]string{"X; return 302 http://evil;": "true"},
+			}}},
+			expectError: "sets invalid response header name [X; return 302 http://evil;]",
+		},
+		{
+			name: "Invalid: Remove response header name",
+			routes: []internalv1.Route{{Path: "/api", TargetServiceName: "canary", ResponseHeaders: &internalv1.HeaderModifier{
+				Remove: []string{"X; return 302 http://evil;"},
+			}}},
+			expectError: "removes invalid response header name [X; return 302 http://evil;]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateRouters(&internalv1.AppSpec{Routers: map[string]internalv1.Router{"router": {Routes: tt.routes}}})
+			if tt.expectError == "" {
+				assert.Empty(t, errs)
+				return
+			}
+			if assert.Len(t, errs, 1) {
+				assert.Contains(t, errs[0].Error(), tt.expectError)
+			}
+		})
+	}
+}
+
+func TestValidatePortPolicies(t *testing.T) {
+	basicAuth := &internalv1.PortPolicy{Auth: &internalv1.PortAuth{BasicSecret: "creds"}}
+	tests := []struct {
+		name        string
+		ports       []internalv1.PortDef
+		publish     []internalv1.PortBinding
+		secrets     map[string]internalv1.Secret
+		hasPolicies bool
+		expectError string
+	}{
+		{
+			name:  "Valid: No policies",
+			ports: []internalv1.PortDef{{TargetPort: 80, Protocol: internalv1.ProtocolHTTP}},
+		},
+		{
+			name:        "Valid: Basic auth",
+			ports:       []internalv1.PortDef{{TargetPort: 80, Protocol: internalv1.ProtocolHTTP, Policy: basicAuth}},
+			secrets:     map[string]internalv1.Secret{"creds": {Type: "basic"}},
+			hasPolicies: true,
+		},
+		{
+			name:        "Valid: Published with a policy",
+			ports:       []internalv1.PortDef{{TargetPort: 80, Protocol: internalv1.ProtocolHTTP}},
+			publish:     []internalv1.PortBinding{{TargetPort: 80, Policy: &internalv1.PortPolicy{AllowedCIDRs: []string{"10.0.0.0/8"}}}},
+			hasPolicies: true,
+		},
+		{
+			name:        "Invalid: TCP port",
+			ports:       []internalv1.PortDef{{TargetPort: 5432, Policy: &internalv1.PortPolicy{AllowedCIDRs: []string{"10.0.0.0/8"}}}},
+			hasPolicies: true,
+			expectError: "policies can only be set on http ports, not tcp ports",
+		},
+		{
+			name:        "Invalid: Missing secret",
+			ports:       []internalv1.PortDef{{TargetPort: 80, Protocol: internalv1.ProtocolHTTP, Policy: basicAuth}},
+			hasPolicies: true,
+			expectError: "basic auth secret [creds] is not a secret of the app",
+		},
+		{
+			name:        "Invalid: Secret type",
+			ports:       []internalv1.PortDef{{TargetPort: 80, Protocol: internalv1.ProtocolHTTP, Policy: basicAuth}},
+			secrets:     map[string]internalv1.Secret{"creds": {Type: "token"}},
+			hasPolicies: true,
+			expectError: "basic auth secret [creds] must be of type basic, not token",
+		},
+		{
+			name:        "Invalid: Published with an invalid CIDR",
+			publish:     []internalv1.PortBinding{{TargetPort: 80, Policy: &internalv1.PortPolicy{AllowedCIDRs: []string{"10.0.0.0/33"}}}},
+			hasPolicies: true,
+			expectError: "invalid allowed CIDR [10.0.0.0/33]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs, hasPolicies := validatePortPolicies(internalv1.AppInstanceSpec{Publish: tt.publish}, &internalv1.AppSpec{
+				Containers: map[string]internalv1.Container{"web": {Ports: tt.ports}},
+				Secrets:    tt.secrets,
+			})
+			assert.Equal(t, tt.hasPolicies, hasPolicies)
+			if tt.expectError == "" {
+				assert.Empty(t, errs)
+				return
+			}
+			if assert.Len(t, errs, 1) {
+				assert.Contains(t, errs[0].Error(), tt.expectError)
+			}
+		})
+	}
+}
+
+func TestValidateRollouts(t *testing.T) {
+	errs := validateRollouts(&internalv1.AppSpec{
+		Containers: map[string]internalv1.Container{
+			"web": {
+				Scale:   z.Pointer[int32](10),
+				Rollout: &internalv1.Rollout{Steps: []internalv1.RolloutStep{{Weight: 10}, {Weight: 50}}},
+			},
+			"api": {
+				Rollout: &internalv1.Rollout{Steps: []internalv1.RolloutStep{{Weight: 10}}},
+			},
+		},
+	})
+	if assert.Len(t, errs, 1) {
+		assert.Contains(t, errs[0].Error(), "container [api]: rollout step 0 has a weight of 10%, but with 1 replicas the new version would receive 50% of the traffic")
+	}
+}
+
+func TestValidateSecrets(t *testing.T) {
+	errs := validateSecrets(&internalv1.AppSpec{
+		Secrets: map[string]internalv1.Secret{
+			"token":  {Type: "token", Params: internalv1.NewGenericMap(map[string]any{"rotate": "24h"})},
+			"config": {Type: "template", Params: internalv1.NewGenericMap(map[string]any{"rotate": "24h"})},
+		},
+	})
+	if assert.Len(t, errs, 1) {
+		assert.Contains(t, errs[0].Error(), "secret [config]: rotate param is only supported on token and basic secrets, not template secrets")
+	}
+}
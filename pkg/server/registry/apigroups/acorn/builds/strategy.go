@@ -10,6 +10,7 @@ import (
 	"github.com/acorn-io/runtime/pkg/buildserver"
 	"github.com/acorn-io/runtime/pkg/config"
 	"github.com/acorn-io/runtime/pkg/imagesystem"
+	"github.com/acorn-io/runtime/pkg/tracing"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -51,6 +52,9 @@ func (s *Strategy) Create(ctx context.Context, object types.Object) (types.Objec
 		return nil, err
 	}
 
+	// Record the trace of the request in the build given to the build server so the build continues it
+	tracing.Inject(ctx, acornBuild)
+
 	token, err := buildserver.CreateToken(builder, acornBuild, pushRepo.String())
 	if err != nil {
 		return nil, err
@@ -13,6 +13,7 @@ import (
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/labels"
 	"github.com/acorn-io/runtime/pkg/namespace"
+	"github.com/acorn-io/runtime/pkg/usage"
 	"github.com/acorn-io/schemer/data/convert"
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
@@ -63,16 +64,50 @@ func (t *Translator) ListOpts(_ context.Context, namespace string, opts storage.
 	return "", opts, nil
 }
 
-func (t *Translator) ToPublic(_ context.Context, objs ...runtime.Object) (result []mtypes.Object, _ error) {
+func (t *Translator) ToPublic(ctx context.Context, objs ...runtime.Object) (result []mtypes.Object, _ error) {
+	metrics := t.podMetrics(ctx, objs)
 	for _, obj := range objs {
-		for _, con := range podToContainers(obj.(*corev1.Pod)) {
+		pod := obj.(*corev1.Pod)
+		for _, con := range podToContainers(pod) {
 			con := con
+			if name := con.Status.ContainerSpec.Name; name != "" {
+				containerUsage := usage.ForContainer(pod, name, metrics)
+				con.Status.Usage = &containerUsage
+			}
 			result = append(result, &con)
 		}
 	}
 	return
 }
 
+// podMetrics returns the usage of the containers of the pods. Usage is informational, so nothing is returned for pods
+// whose metrics can not be read.
+func (t *Translator) podMetrics(ctx context.Context, objs []runtime.Object) usage.Containers {
+	result := usage.Containers{}
+	namespaces := map[string]bool{}
+	for _, obj := range objs {
+		pod := obj.(*corev1.Pod)
+		if pod.Status.Phase != corev1.PodRunning || namespaces[pod.Namespace] {
+			continue
+		}
+		namespaces[pod.Namespace] = true
+
+		podMetrics := usage.NewPodMetricsList()
+		if err := t.client.List(ctx, podMetrics, kclient.InNamespace(pod.Namespace), kclient.MatchingLabels{
+			labels.AcornManaged: "true",
+		}); err != nil {
+			if !usage.IsUnavailable(err) {
+				logrus.Debugf("failed to read pod metrics in namespace %s: %v", pod.Namespace, err)
+			}
+			continue
+		}
+		for podName, containers := range usage.FromPodMetrics(podMetrics) {
+			result[podName] = containers
+		}
+	}
+	return result
+}
+
 func (t *Translator) FromPublic(_ context.Context, obj runtime.Object) (mtypes.Object, error) {
 	con := obj.(*apiv1.ContainerReplica)
 	return &corev1.Pod{
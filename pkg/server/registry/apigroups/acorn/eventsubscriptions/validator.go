@@ -0,0 +1,61 @@
+package eventsubscriptions
+
+import (
+	"context"
+	"net/netip"
+	"net/url"
+	"strings"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/egress"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+type Validator struct{}
+
+func (s *Validator) Validate(_ context.Context, obj runtime.Object) (result field.ErrorList) {
+	sub := obj.(*apiv1.EventSubscription)
+	spec := field.NewPath("spec")
+
+	if sub.Spec.URL == "" {
+		result = append(result, field.Required(spec.Child("url"), "the url to deliver events to is required"))
+	} else if u, err := url.Parse(sub.Spec.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		result = append(result, field.Invalid(spec.Child("url"), sub.Spec.URL, "must be an absolute http or https url"))
+	} else if blockedHost(u.Hostname()) {
+		// Host names are checked again when events are delivered, once they are resolved
+		result = append(result, field.Invalid(spec.Child("url"), sub.Spec.URL, "must not be a loopback, link-local or private address"))
+	}
+
+	switch sub.Spec.Format {
+	case "", v1.EventSubscriptionFormatAcorn, v1.EventSubscriptionFormatCloudEvents:
+	default:
+		result = append(result, field.NotSupported(spec.Child("format"), sub.Spec.Format,
+			[]string{string(v1.EventSubscriptionFormatAcorn), string(v1.EventSubscriptionFormatCloudEvents)}))
+	}
+
+	for i, severity := range sub.Spec.Severities {
+		if severity != v1.EventSeverityInfo && severity != v1.EventSeverityError {
+			result = append(result, field.NotSupported(spec.Child("severities").Index(i), severity,
+				[]string{string(v1.EventSeverityInfo), string(v1.EventSeverityError)}))
+		}
+	}
+
+	if sub.Spec.MaxRetries != nil && *sub.Spec.MaxRetries < 0 {
+		result = append(result, field.Invalid(spec.Child("maxRetries"), *sub.Spec.MaxRetries, "must not be negative"))
+	}
+	return
+}
+
+func blockedHost(host string) bool {
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return true
+	}
+	addr, err := netip.ParseAddr(host)
+	return err == nil && egress.BlockedAddress(addr)
+}
+
+func (s *Validator) ValidateUpdate(ctx context.Context, obj, _ runtime.Object) field.ErrorList {
+	return s.Validate(ctx, obj)
+}
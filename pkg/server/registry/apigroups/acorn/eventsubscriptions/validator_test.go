@@ -0,0 +1,84 @@
+package eventsubscriptions
+
+import (
+	"context"
+	"testing"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/z"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      v1.EventSubscriptionInstanceSpec
+		wantError bool
+	}{
+		{
+			name: "Valid",
+			spec: v1.EventSubscriptionInstanceSpec{
+				URL:        "https://example.com/hooks/acorn",
+				Severities: []v1.EventSeverity{v1.EventSeverityError},
+				Format:     v1.EventSubscriptionFormatCloudEvents,
+			},
+		},
+		{
+			name:      "Missing url",
+			wantError: true,
+		},
+		{
+			name:      "Relative url",
+			spec:      v1.EventSubscriptionInstanceSpec{URL: "/hooks/acorn"},
+			wantError: true,
+		},
+		{
+			name:      "Unsupported scheme",
+			spec:      v1.EventSubscriptionInstanceSpec{URL: "ftp://example.com"},
+			wantError: true,
+		},
+		{
+			name:      "Metadata address",
+			spec:      v1.EventSubscriptionInstanceSpec{URL: "http://169.254.169.254/latest/meta-data"},
+			wantError: true,
+		},
+		{
+			name:      "Loopback address",
+			spec:      v1.EventSubscriptionInstanceSpec{URL: "http://[::1]:8080/hooks"},
+			wantError: true,
+		},
+		{
+			name:      "Localhost",
+			spec:      v1.EventSubscriptionInstanceSpec{URL: "http://localhost:8080/hooks"},
+			wantError: true,
+		},
+		{
+			name:      "Private address",
+			spec:      v1.EventSubscriptionInstanceSpec{URL: "http://10.43.0.10/hooks"},
+			wantError: true,
+		},
+		{
+			name:      "Unsupported format",
+			spec:      v1.EventSubscriptionInstanceSpec{URL: "https://example.com", Format: "xml"},
+			wantError: true,
+		},
+		{
+			name:      "Unsupported severity",
+			spec:      v1.EventSubscriptionInstanceSpec{URL: "https://example.com", Severities: []v1.EventSeverity{"warning"}},
+			wantError: true,
+		},
+		{
+			name:      "Negative max retries",
+			spec:      v1.EventSubscriptionInstanceSpec{URL: "https://example.com", MaxRetries: z.Pointer[int32](-1)},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := (&Validator{}).Validate(context.Background(), &apiv1.EventSubscription{Spec: tt.spec})
+			assert.Equal(t, tt.wantError, len(errs) > 0, errs)
+		})
+	}
+}
@@ -6,6 +6,9 @@ import (
 	"strings"
 
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/appschedule"
+	"github.com/acorn-io/runtime/pkg/secrets/backends"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -30,7 +33,31 @@ func (v *Validator) Validate(_ context.Context, obj runtime.Object) field.ErrorL
 		return append(result, field.Invalid(field.NewPath("spec", "defaultRegion"), project.Spec.DefaultRegion, "default region is not in the supported regions list"))
 	}
 
-	return nil
+	if project.Spec.DefaultAppSchedule != nil {
+		if _, err := appschedule.Parse(*project.Spec.DefaultAppSchedule); err != nil {
+			return append(result, field.Invalid(field.NewPath("spec", "defaultAppSchedule"), project.Spec.DefaultAppSchedule, err.Error()))
+		}
+	}
+
+	return validateSecretBackends(project.Spec.SecretBackends)
+}
+
+func validateSecretBackends(secretBackends []v1.SecretBackend) field.ErrorList {
+	var (
+		result field.ErrorList
+		names  = map[string]struct{}{}
+	)
+	for i, backend := range secretBackends {
+		path := field.NewPath("spec", "secretBackends").Index(i)
+		if err := backends.Validate(backend); err != nil {
+			result = append(result, field.Invalid(path, backend.GetName(), err.Error()))
+		}
+		if _, ok := names[backend.GetName()]; ok {
+			result = append(result, field.Duplicate(path.Child("name"), backend.GetName()))
+		}
+		names[backend.GetName()] = struct{}{}
+	}
+	return result
 }
 
 func (v *Validator) ValidateUpdate(ctx context.Context, newObj, _ runtime.Object) field.ErrorList {
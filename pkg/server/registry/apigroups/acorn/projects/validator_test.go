@@ -93,6 +93,57 @@ func TestProjectCreateValidation(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Create project with secret backends",
+			project: apiv1.Project{
+				Spec: v1.ProjectInstanceSpec{
+					SecretBackends: []v1.SecretBackend{
+						{Type: v1.SecretBackendTypeVault, Vault: &v1.VaultSecretBackend{Address: "https://vault.example.com"}},
+						{Name: "local", Type: v1.SecretBackendTypeFile, File: &v1.FileSecretBackend{Directory: "local"}, RefreshInterval: "30s"},
+					},
+				},
+			},
+		},
+		{
+			name:      "Create project with invalid secret backend",
+			wantError: true,
+			project: apiv1.Project{
+				Spec: v1.ProjectInstanceSpec{
+					SecretBackends: []v1.SecretBackend{
+						{Type: v1.SecretBackendTypeVault},
+					},
+				},
+			},
+		},
+		{
+			name:      "Create project with duplicate secret backends",
+			wantError: true,
+			project: apiv1.Project{
+				Spec: v1.ProjectInstanceSpec{
+					SecretBackends: []v1.SecretBackend{
+						{Type: v1.SecretBackendTypeFile, File: &v1.FileSecretBackend{Directory: "a"}},
+						{Type: v1.SecretBackendTypeFile, File: &v1.FileSecretBackend{Directory: "b"}},
+					},
+				},
+			},
+		},
+		{
+			name: "Create project with default app schedule",
+			project: apiv1.Project{
+				Spec: v1.ProjectInstanceSpec{
+					DefaultAppSchedule: &v1.AppSchedule{Stop: "0 20 * * 1-5", Start: "0 7 * * 1-5", TimeZone: "America/Chicago"},
+				},
+			},
+		},
+		{
+			name:      "Create project with invalid default app schedule",
+			wantError: true,
+			project: apiv1.Project{
+				Spec: v1.ProjectInstanceSpec{
+					DefaultAppSchedule: &v1.AppSchedule{Stop: "after work"},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
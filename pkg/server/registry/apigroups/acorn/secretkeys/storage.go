@@ -0,0 +1,21 @@
+package secretkeys
+
+import (
+	"github.com/acorn-io/mink/pkg/stores"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/tables"
+	"k8s.io/apiserver/pkg/registry/rest"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewStorage(c kclient.WithWatch) rest.Storage {
+	strategy := &Strategy{client: c}
+
+	return stores.NewBuilder(c.Scheme(), &apiv1.SecretKey{}).
+		WithCreate(strategy).
+		WithGet(strategy).
+		WithList(strategy).
+		WithDelete(strategy).
+		WithTableConverter(tables.SecretKeyConverter).
+		Build()
+}
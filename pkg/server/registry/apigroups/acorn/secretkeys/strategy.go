@@ -0,0 +1,138 @@
+package secretkeys
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/acorn-io/mink/pkg/types"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/encryption"
+	"github.com/acorn-io/runtime/pkg/encryption/nacl"
+	"github.com/acorn-io/z"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/client-go/util/retry"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type Strategy struct {
+	client kclient.WithWatch
+}
+
+// Create rotates the primary key of the project. The object created is the new primary key.
+func (s *Strategy) Create(ctx context.Context, obj types.Object) (types.Object, error) {
+	namespace := obj.GetNamespace()
+
+	var key *nacl.Key
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() (err error) {
+		key, err = nacl.RotatePrimaryNaclKey(ctx, s.client, namespace)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return s.Get(ctx, namespace, nacl.KeyBytesToB64String(key.PublicKey))
+}
+
+func (s *Strategy) Get(ctx context.Context, namespace, name string) (types.Object, error) {
+	keys, err := s.list(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys.Items {
+		if key.Name == name {
+			return &key, nil
+		}
+	}
+	return nil, notFound(name)
+}
+
+func (s *Strategy) List(ctx context.Context, namespace string, _ storage.ListOptions) (types.ObjectList, error) {
+	return s.list(ctx, namespace)
+}
+
+func (s *Strategy) list(ctx context.Context, namespace string) (*apiv1.SecretKeyList, error) {
+	result := &apiv1.SecretKeyList{
+		Items: []apiv1.SecretKey{},
+	}
+
+	keys, err := nacl.GetAllNaclKeys(ctx, s.client, namespace)
+	if keyNotFound := (*nacl.ErrKeyNotFound)(nil); errors.As(err, &keyNotFound) {
+		return result, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	references, err := encryption.KeyReferences(ctx, s.client, namespace, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	for keyID, key := range keys {
+		if keyID == "primary" {
+			continue
+		}
+		result.Items = append(result.Items, apiv1.SecretKey{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              keyID,
+				Namespace:         namespace,
+				CreationTimestamp: z.Dereference(key.Created),
+			},
+			Primary:      z.Dereference(key.Primary),
+			ReferencedBy: references[keyID],
+		})
+	}
+
+	sort.Slice(result.Items, func(i, j int) bool {
+		if result.Items[i].Primary != result.Items[j].Primary {
+			return result.Items[i].Primary
+		}
+		return result.Items[i].CreationTimestamp.After(result.Items[j].CreationTimestamp.Time)
+	})
+
+	return result, nil
+}
+
+// Delete retires the key. Keys that apps still have data encrypted with only that key are not retired, because
+// those apps would no longer be able to decrypt their data.
+func (s *Strategy) Delete(ctx context.Context, obj types.Object) (types.Object, error) {
+	key := obj.(*apiv1.SecretKey)
+	if key.Primary {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("key %s is the primary key and cannot be retired, rotate the key first", key.Name))
+	}
+	if len(key.ReferencedBy) > 0 {
+		return nil, apierrors.NewConflict(schema.GroupResource{
+			Group:    apiv1.SchemeGroupVersion.Group,
+			Resource: "secretkeys",
+		}, key.Name, fmt.Errorf("data of apps [%s] can only be decrypted with this key, re-encrypt it with the primary key before retiring this key",
+			strings.Join(key.ReferencedBy, ", ")))
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		return nacl.RetireNaclKey(ctx, s.client, key.Namespace, key.Name)
+	})
+	if keyNotFound := (*nacl.ErrKeyNotFound)(nil); errors.As(err, &keyNotFound) {
+		return nil, notFound(key.Name)
+	}
+	return key, err
+}
+
+func (s *Strategy) New() types.Object {
+	return &apiv1.SecretKey{}
+}
+
+func (s *Strategy) NewList() types.ObjectList {
+	return &apiv1.SecretKeyList{}
+}
+
+func notFound(name string) error {
+	return apierrors.NewNotFound(schema.GroupResource{
+		Group:    apiv1.SchemeGroupVersion.Group,
+		Resource: "secretkeys",
+	}, name)
+}
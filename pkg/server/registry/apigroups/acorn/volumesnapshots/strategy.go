@@ -0,0 +1,85 @@
+package volumesnapshots
+
+import (
+	"context"
+	"strings"
+
+	"github.com/acorn-io/baaah/pkg/name"
+	"github.com/acorn-io/mink/pkg/strategy"
+	"github.com/acorn-io/mink/pkg/types"
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	klabels "k8s.io/apimachinery/pkg/labels"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// resolveVolumeStrategy resolves the volume of a new snapshot, given by its public name or the name of the
+// PersistentVolume, and records the app and volume name the snapshot is taken of.
+type resolveVolumeStrategy struct {
+	client   kclient.Client
+	strategy strategy.Creater
+}
+
+func (r *resolveVolumeStrategy) Create(ctx context.Context, object types.Object) (types.Object, error) {
+	snapshot := object.(*apiv1.VolumeSnapshot)
+
+	pv, err := r.getVolume(ctx, snapshot.Namespace, snapshot.Spec.Volume)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot.Spec.Volume = pv.Name
+	snapshot.Spec.AppName = pv.Labels[labels.AcornAppName]
+	snapshot.Spec.VolumeName = pv.Labels[labels.AcornVolumeName]
+	snapshot.Labels = labels.Merge(snapshot.Labels, map[string]string{
+		labels.AcornAppName:    snapshot.Spec.AppName,
+		labels.AcornVolumeName: snapshot.Spec.VolumeName,
+	})
+	if snapshot.Name == "" && snapshot.GenerateName == "" {
+		snapshot.GenerateName = name.SafeConcatName(snapshot.Spec.AppName, snapshot.Spec.VolumeName) + "-"
+	}
+
+	return r.strategy.Create(ctx, snapshot)
+}
+
+func (r *resolveVolumeStrategy) getVolume(ctx context.Context, namespace, volumeName string) (*corev1.PersistentVolume, error) {
+	notFound := apierrors.NewNotFound(corev1.Resource("volumes"), volumeName)
+
+	// The volume is either the name of the PersistentVolume or of the form <appName>.<volumeName>
+	pv := &corev1.PersistentVolume{}
+	if err := r.client.Get(ctx, kclient.ObjectKey{Name: volumeName}, pv); apierrors.IsNotFound(err) {
+		i := strings.LastIndex(volumeName, ".")
+		if i <= 0 || i+1 >= len(volumeName) {
+			return nil, notFound
+		}
+
+		pvs := &corev1.PersistentVolumeList{}
+		if err := r.client.List(ctx, pvs, &kclient.ListOptions{
+			LabelSelector: klabels.SelectorFromSet(map[string]string{
+				labels.AcornAppName:      volumeName[:i],
+				labels.AcornAppNamespace: namespace,
+				labels.AcornVolumeName:   volumeName[i+1:],
+			}),
+		}); err != nil {
+			return nil, err
+		}
+		if len(pvs.Items) != 1 {
+			return nil, notFound
+		}
+		pv = &pvs.Items[0]
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Only volumes of the project can be snapshotted
+	if pv.Labels[labels.AcornManaged] != "true" || pv.Labels[labels.AcornAppNamespace] != namespace {
+		return nil, notFound
+	}
+	return pv, nil
+}
+
+func (r *resolveVolumeStrategy) New() types.Object {
+	return &apiv1.VolumeSnapshot{}
+}
@@ -0,0 +1,20 @@
+package volumesnapshots
+
+import (
+	"context"
+
+	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+type Validator struct {
+}
+
+func (v *Validator) Validate(_ context.Context, obj runtime.Object) (result field.ErrorList) {
+	snapshot := obj.(*apiv1.VolumeSnapshot)
+	if snapshot.Spec.Volume == "" {
+		result = append(result, field.Required(field.NewPath("spec", "volume"), "the volume to snapshot is required"))
+	}
+	return
+}
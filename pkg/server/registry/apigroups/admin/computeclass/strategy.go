@@ -59,6 +59,7 @@ func (s *Strategy) List(ctx context.Context, namespace string, _ storage.ListOpt
 			Default:          pcc.Default,
 			Description:      pcc.Description,
 			SupportedRegions: pcc.SupportedRegions,
+			Price:            pcc.Price,
 		})
 		projectComputeClassesSeen[pcc.Name] = struct{}{}
 	}
@@ -77,6 +78,7 @@ func (s *Strategy) List(ctx context.Context, namespace string, _ storage.ListOpt
 			Default:          ccc.Default,
 			Description:      ccc.Description,
 			SupportedRegions: ccc.SupportedRegions,
+			Price:            ccc.Price,
 		})
 	}
 
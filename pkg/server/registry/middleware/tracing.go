@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/acorn-io/mink/pkg/strategy"
+	"github.com/acorn-io/mink/pkg/types"
+	"github.com/acorn-io/runtime/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/storage"
+)
+
+// Tracing starts a span for each call to the strategy, named by the verb and the resource. The spans are children of
+// the trace sent by the client in the request headers.
+func Tracing(resource string) CompleteStrategy {
+	return func(s strategy.CompleteStrategy) strategy.CompleteStrategy {
+		return &tracingStrategy{
+			CompleteStrategy: s,
+			resource:         resource,
+		}
+	}
+}
+
+type tracingStrategy struct {
+	strategy.CompleteStrategy
+	resource string
+}
+
+func (t *tracingStrategy) start(ctx context.Context, verb, namespace, name string) (context.Context, func(error)) {
+	ctx, span := tracing.Start(ctx, verb+" "+t.resource,
+		attribute.String("acorn.namespace", namespace),
+		attribute.String("acorn.name", name),
+	)
+	return ctx, func(err error) {
+		tracing.End(span, err)
+	}
+}
+
+func (t *tracingStrategy) Create(ctx context.Context, obj types.Object) (_ types.Object, err error) {
+	ctx, end := t.start(ctx, "create", obj.GetNamespace(), obj.GetName())
+	defer func() { end(err) }()
+	return t.CompleteStrategy.Create(ctx, obj)
+}
+
+func (t *tracingStrategy) Update(ctx context.Context, obj types.Object) (_ types.Object, err error) {
+	ctx, end := t.start(ctx, "update", obj.GetNamespace(), obj.GetName())
+	defer func() { end(err) }()
+	return t.CompleteStrategy.Update(ctx, obj)
+}
+
+func (t *tracingStrategy) UpdateStatus(ctx context.Context, obj types.Object) (_ types.Object, err error) {
+	ctx, end := t.start(ctx, "update status", obj.GetNamespace(), obj.GetName())
+	defer func() { end(err) }()
+	return t.CompleteStrategy.UpdateStatus(ctx, obj)
+}
+
+func (t *tracingStrategy) Get(ctx context.Context, namespace, name string) (_ types.Object, err error) {
+	ctx, end := t.start(ctx, "get", namespace, name)
+	defer func() { end(err) }()
+	return t.CompleteStrategy.Get(ctx, namespace, name)
+}
+
+func (t *tracingStrategy) List(ctx context.Context, namespace string, opts storage.ListOptions) (_ types.ObjectList, err error) {
+	ctx, end := t.start(ctx, "list", namespace, "")
+	defer func() { end(err) }()
+	return t.CompleteStrategy.List(ctx, namespace, opts)
+}
+
+func (t *tracingStrategy) Delete(ctx context.Context, obj types.Object) (_ types.Object, err error) {
+	ctx, end := t.start(ctx, "delete", obj.GetNamespace(), obj.GetName())
+	defer func() { end(err) }()
+	return t.CompleteStrategy.Delete(ctx, obj)
+}
+
+// Watch only traces starting the watch, not the events sent on it.
+func (t *tracingStrategy) Watch(ctx context.Context, namespace string, opts storage.ListOptions) (_ <-chan watch.Event, err error) {
+	_, end := t.start(ctx, "watch", namespace, "")
+	defer func() { end(err) }()
+	return t.CompleteStrategy.Watch(ctx, namespace, opts)
+}
@@ -10,6 +10,7 @@ import (
 	openapi "github.com/acorn-io/runtime/pkg/openapi/generated"
 	"github.com/acorn-io/runtime/pkg/scheme"
 	"github.com/acorn-io/runtime/pkg/server/registry"
+	"github.com/acorn-io/runtime/pkg/tracing"
 	apiserver "k8s.io/apiserver/pkg/server"
 	"k8s.io/apiserver/pkg/server/options"
 	"k8s.io/client-go/rest"
@@ -29,6 +30,8 @@ func apiGroups(serverConfig Config) ([]*apiserver.APIGroupInfo, error) {
 		return nil, err
 	}
 
+	restConfig = tracing.Config(restConfig)
+
 	c, err := k8sclient.New(restConfig)
 	if err != nil {
 		return nil, err
@@ -8,6 +8,7 @@ import (
 
 	"github.com/acorn-io/baaah/pkg/apply"
 	"github.com/acorn-io/baaah/pkg/typed"
+	"github.com/acorn-io/runtime/pkg/activator"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/acorn-io/runtime/pkg/jobs"
 	"github.com/acorn-io/runtime/pkg/labels"
@@ -151,15 +152,17 @@ func forRouters(appInstance *v1.AppInstance) (result []kclient.Object, err error
 		annotations := map[string]string{}
 
 		for _, router := range router.Routes {
-			if router.TargetServiceName != "" && !serviceNames.Has(router.TargetServiceName) {
-				if appInstance.Status.AppStatus.Routers == nil {
-					appInstance.Status.AppStatus.Routers = map[string]v1.RouterStatus{}
+			for _, target := range router.GetTargets() {
+				if !serviceNames.Has(target.TargetServiceName) {
+					if appInstance.Status.AppStatus.Routers == nil {
+						appInstance.Status.AppStatus.Routers = map[string]v1.RouterStatus{}
+					}
+					status := appInstance.Status.AppStatus.Routers[routerName]
+					status.MissingTargets = append(status.MissingTargets, target.TargetServiceName)
+					appInstance.Status.AppStatus.Routers[routerName] = status
+					annotations[apply.AnnotationCreate] = "false"
+					annotations[apply.AnnotationUpdate] = "false"
 				}
-				status := appInstance.Status.AppStatus.Routers[routerName]
-				status.MissingTargets = append(status.MissingTargets, router.TargetServiceName)
-				appInstance.Status.AppStatus.Routers[routerName] = status
-				annotations[apply.AnnotationCreate] = "false"
-				annotations[apply.AnnotationUpdate] = "false"
 			}
 		}
 
@@ -295,8 +298,9 @@ func forContainers(interpolator *secrets.Interpolator, appInstance *v1.AppInstan
 						appInstance.Status.AppSpec.Labels, container.Labels, appInstance.Spec.Labels)),
 				Annotations: labels.GatherScoped(containerName, v1.LabelTypeContainer,
 					appInstance.Status.AppSpec.Annotations, container.Annotations, appInstance.Spec.Annotations),
-				Ports:     ports,
-				Container: containerName,
+				Ports:        ports,
+				Container:    containerName,
+				RolloutTrack: appInstance.Status.AppStatus.Containers[containerName].Rollout.ServiceTrack(),
 			},
 		})
 	}
@@ -313,6 +317,10 @@ func forFunctions(appInstance *v1.AppInstance) (result []kclient.Object) {
 		}
 
 		ports := ports2.FunctionPortDefs(false)
+		activated := activator.Enabled(appInstance, function)
+		if activated {
+			ports[0].TargetPort = activator.Ports(appInstance)[functionName]
+		}
 
 		result = append(result, &v1.ServiceInstance{
 			ObjectMeta: metav1.ObjectMeta{
@@ -336,8 +344,9 @@ func forFunctions(appInstance *v1.AppInstance) (result []kclient.Object) {
 						appInstance.Status.AppSpec.Labels, function.Labels, appInstance.Spec.Labels)),
 				Annotations: labels.GatherScoped(functionName, v1.LabelTypeFunction,
 					appInstance.Status.AppSpec.Annotations, function.Annotations, appInstance.Spec.Annotations),
-				Ports:    ports,
-				Function: functionName,
+				Ports:     ports,
+				Function:  functionName,
+				Activated: activated,
 			},
 		})
 	}
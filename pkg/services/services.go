@@ -60,6 +60,9 @@ func toFunctionService(service *v1.ServiceInstance) (result []kclient.Object) {
 				service.Spec.AppName, labels.AcornFunctionName, service.Spec.Function),
 		},
 	}
+	if service.Spec.Activated {
+		newService.Spec.Selector = labels.ManagedByApp(service.Spec.AppNamespace, service.Spec.AppName, labels.AcornActivator, "true")
+	}
 	result = append(result, newService)
 	return
 }
@@ -78,7 +81,8 @@ func toContainerService(service *v1.ServiceInstance) (result []kclient.Object) {
 			Ports: ports.ToServicePorts(service.Spec.Ports),
 			Type:  corev1.ServiceTypeClusterIP,
 			Selector: labels.ManagedByApp(service.Spec.AppNamespace,
-				service.Spec.AppName, labels.AcornContainerName, service.Spec.Container),
+				service.Spec.AppName, labels.AcornContainerName, service.Spec.Container,
+				labels.AcornRolloutTrack, service.Spec.RolloutTrack),
 		},
 	}
 	result = append(result, newService)
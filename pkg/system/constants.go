@@ -11,6 +11,7 @@ const (
 	DNSSecretName        = "acorn-dns"
 	DNSIngressName       = "acorn-dns-ingress"
 	DNSServiceName       = "acorn-dns-service"
+	GatewayName          = "acorn-gateway"
 
 	CustomCABundleSecretName = "cabundle"
 	CustomCABundleSecretVolumeName
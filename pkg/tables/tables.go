@@ -13,10 +13,31 @@ var (
 		{"Commit", "{{ . | imageCommit | trunc }}"},
 		{"Created", "{{ago .CreationTimestamp}}"},
 		{"Endpoints", "Status.Columns.Endpoints"},
-		{"Message", "{{ appGeneration . .Status.Columns.Message }}"},
+		{"Message", "{{ appSchedule . (appGeneration . .Status.Columns.Message) }}"},
 	}
 	AppConverter = MustConverter(App)
 
+	AppWide = [][]string{
+		{"Name", "{{ . | name }}"},
+		{"Image", "{{ . | imageName | trunc }}"},
+		{"Commit", "{{ . | imageCommit | trunc }}"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+		{"Endpoints", "Status.Columns.Endpoints"},
+		{"CPU", "{{ usageCPU .Status.AppStatus.Usage }}"},
+		{"Memory", "{{ usageMemory .Status.AppStatus.Usage }}"},
+		{"Message", "{{ appSchedule . (appGeneration . .Status.Columns.Message) }}"},
+	}
+
+	AppRevision = [][]string{
+		{"Name", "{{ . | name }}"},
+		{"App", "Spec.AppName"},
+		{"Revision", "Spec.Revision"},
+		{"Image", "{{ .Spec.AppSpec.Image | trunc }}"},
+		{"Digest", "{{ .Spec.ImageDigest | trunc }}"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+	AppRevisionConverter = MustConverter(AppRevision)
+
 	Volume = [][]string{
 		{"Name", "{{ . | name }}"},
 		{"Bound-Volume", "Status.VolumeName"},
@@ -28,6 +49,27 @@ var (
 	}
 	VolumeConverter = MustConverter(Volume)
 
+	VolumeSnapshot = [][]string{
+		{"Name", "{{ . | name }}"},
+		{"App", "Spec.AppName"},
+		{"Volume", "Spec.VolumeName"},
+		{"Method", "Status.Method"},
+		{"Size", "Status.Size"},
+		{"Status", "{{if .Status.Error}}error: {{.Status.Error}}{{else if .Status.Ready}}ready{{else}}pending{{end}}"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+	VolumeSnapshotConverter = MustConverter(VolumeSnapshot)
+
+	EventSubscription = [][]string{
+		{"Name", "{{ . | name }}"},
+		{"URL", "Spec.URL"},
+		{"Format", "{{if .Spec.Format}}{{.Spec.Format}}{{else}}acorn{{end}}"},
+		{"Delivered", "Status.Delivered"},
+		{"Dead-Letters", "{{len .Status.DeadLetters}}"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+	EventSubscriptionConverter = MustConverter(EventSubscription)
+
 	VolumeClass = [][]string{
 		{"Name", "{{ . | name }}"},
 		{"Default", "{{ boolToStar .Default }}"},
@@ -41,6 +83,37 @@ var (
 	}
 	VolumeClassConverter = MustConverter(VolumeClass)
 
+	MetricValue = [][]string{
+		{"Container", "Container"},
+		{"Metric", "Metric"},
+		{"Value", "Value"},
+	}
+
+	MetricSummary = [][]string{
+		{"Container", "Container"},
+		{"Metric", "Metric"},
+		{"Min", "Min"},
+		{"Max", "Max"},
+		{"Last", "Last"},
+		{"Trend", "Trend"},
+	}
+
+	Estimate = [][]string{
+		{"Name", "Name"},
+		{"Kind", "Kind"},
+		{"Class", "Class"},
+		{"Replicas", "Replicas"},
+		{"CPU", "CPU"},
+		{"Memory", "Memory"},
+		{"Storage", "Storage"},
+		{"Monthly-Cost", "MonthlyCost"},
+	}
+
+	EstimateQuota = [][]string{
+		{"Quota", "Quota"},
+		{"Resources", "Resources"},
+	}
+
 	Service = [][]string{
 		{"Name", "{{ . | name }}"},
 		{"Created", "{{ago .CreationTimestamp}}"},
@@ -81,6 +154,18 @@ var (
 	}
 	ContainerConverter = MustConverter(Container)
 
+	ContainerWide = [][]string{
+		{"Name", "{{ . | name }}"},
+		{"Acorn", "Status.Columns.App"},
+		{"Image", "{{trunc .Spec.Image}}"},
+		{"State", "Status.Columns.State"},
+		{"RestartCount", "Status.RestartCount"},
+		{"CPU", "{{ usageCPU .Status.Usage }}"},
+		{"Memory", "{{ usageMemory .Status.Usage }}"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+		{"Message", "Status.PodMessage"},
+	}
+
 	Job = [][]string{
 		{"Name", "{{ . | name }}"},
 		{"State", "Status.State"},
@@ -111,6 +196,14 @@ var (
 	}
 	SecretConverter = MustConverter(Secret)
 
+	SecretKey = [][]string{
+		{"Name", "{{ . | name }}"},
+		{"Primary", "Primary"},
+		{"Referenced-By", "ReferencedBy"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+	SecretKeyConverter = MustConverter(SecretKey)
+
 	Info = [][]string{
 		{"Version", "Client.Version"},
 		{"Current Project", "Client.CLI.CurrentProject"},
@@ -0,0 +1,176 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/client-go/rest"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// EndpointEnv is the environment variable with the OTLP gRPC endpoint traces are exported to. A http:// endpoint
+	// is dialed without TLS. Tracing is disabled if it is not set.
+	EndpointEnv = "ACORN_OTLP_ENDPOINT"
+
+	tracerName = "github.com/acorn-io/runtime"
+)
+
+// Init exports the spans of this process to the OTLP endpoint as the given service. The returned function flushes and
+// stops the exporter. If the endpoint is empty no spans are exported, but the trace context is still propagated.
+func Init(ctx context.Context, serviceName, endpoint string) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	if endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	var opts []otlptracegrpc.Option
+	if u, err := url.Parse(endpoint); err == nil && u.Host != "" {
+		switch u.Scheme {
+		case "http":
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		case "https":
+		default:
+			return nil, fmt.Errorf("invalid scheme %q of OTLP endpoint %s, must be http or https", u.Scheme, endpoint)
+		}
+		endpoint = u.Host
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, append(opts, otlptracegrpc.WithEndpoint(endpoint))...)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// Start starts a span that is a child of the span in ctx, if there is one.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records the error, if any, on the span and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Config returns a copy of the config that starts a span for each request and sends the trace context in the request
+// headers.
+func Config(cfg *rest.Config) *rest.Config {
+	cfg = rest.CopyConfig(cfg)
+	cfg.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return otelhttp.NewTransport(rt, otelhttp.WithSpanNameFormatter(func(_ string, req *http.Request) string {
+			return req.Method + " " + req.URL.Path
+		}))
+	})
+	return cfg
+}
+
+// Inject records the trace context of ctx in the annotations of the object, so the work done for the object later,
+// like reconciling it in the controller, can be related to the trace.
+func Inject(ctx context.Context, obj kclient.Object) {
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+
+	traceParent := carrier.Get("traceparent")
+	if traceParent == "" {
+		return
+	}
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[labels.AcornTraceParent] = traceParent
+	obj.SetAnnotations(annotations)
+}
+
+// Extract returns ctx with the trace context recorded in the annotations of the object by Inject.
+func Extract(ctx context.Context, obj kclient.Object) context.Context {
+	traceParent := obj.GetAnnotations()[labels.AcornTraceParent]
+	if traceParent == "" {
+		return ctx
+	}
+	return propagation.TraceContext{}.Extract(ctx, propagation.MapCarrier{"traceparent": traceParent})
+}
+
+// Links returns a link to the trace recorded in the annotations of the object by Inject, if there is one.
+func Links(ctx context.Context, obj kclient.Object) []trace.Link {
+	spanContext := trace.SpanContextFromContext(Extract(ctx, obj))
+	if !spanContext.IsValid() {
+		return nil
+	}
+	return []trace.Link{{SpanContext: spanContext}}
+}
+
+// Middleware starts a span for each call to the handler. The annotation recorded by Inject stays on the object, and the
+// object is reconciled again long after the request that recorded it, so each span starts a trace of its own with a
+// link to the recorded trace instead of joining it. Spans are named by the kind of the object, and the handler is
+// identified by where it is registered, the same way the router names routes in errors.
+func Middleware(h router.Handler) router.Handler {
+	handler := registeredAt()
+	return router.HandlerFunc(func(req router.Request, resp router.Response) error {
+		if req.Object == nil {
+			return h.Handle(req, resp)
+		}
+
+		ctx, span := otel.Tracer(tracerName).Start(req.Ctx, "reconcile "+req.GVK.Kind,
+			trace.WithNewRoot(),
+			trace.WithLinks(Links(req.Ctx, req.Object)...),
+			trace.WithAttributes(
+				attribute.String("acorn.handler", handler),
+				attribute.String("acorn.namespace", req.Namespace),
+				attribute.String("acorn.name", req.Name),
+			),
+		)
+		req.Ctx = ctx
+
+		err := h.Handle(req, resp)
+		End(span, err)
+		return err
+	})
+}
+
+// registeredAt returns the file and line that registered the handler Middleware is wrapping, skipping the frames of
+// the router.
+func registeredAt() string {
+	pcs := make([]uintptr, 10)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, "github.com/acorn-io/baaah/") {
+			return fmt.Sprintf("%s:%d", filepath.Base(frame.File), frame.Line)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package tracing
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/acorn-io/baaah/pkg/router"
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/acorn-io/runtime/pkg/labels"
+	"github.com/stretchr/testify/assert"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracev1 "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// collector is an in-process OTLP collector that keeps the spans exported to it.
+type collector struct {
+	collectortrace.UnimplementedTraceServiceServer
+
+	lock  sync.Mutex
+	spans map[string]*tracev1.Span
+}
+
+func (c *collector) Export(_ context.Context, req *collectortrace.ExportTraceServiceRequest) (*collectortrace.ExportTraceServiceResponse, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, resourceSpans := range req.ResourceSpans {
+		for _, scopeSpans := range resourceSpans.ScopeSpans {
+			for _, span := range scopeSpans.Spans {
+				c.spans[span.Name] = span
+			}
+		}
+	}
+	return &collectortrace.ExportTraceServiceResponse{}, nil
+}
+
+func startCollector(t *testing.T) (*collector, string) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := &collector{spans: map[string]*tracev1.Span{}}
+	server := grpc.NewServer()
+	collectortrace.RegisterTraceServiceServer(server, c)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	t.Cleanup(server.Stop)
+
+	return c, "http://" + lis.Addr().String()
+}
+
+func TestReconcileLinksToAnnotatedTrace(t *testing.T) {
+	ctx := context.Background()
+	c, endpoint := startCollector(t)
+
+	shutdown, err := Init(ctx, "acorn-test", endpoint)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	spanCtx, span := Start(ctx, "create app")
+	app := &v1.AppInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app",
+			Namespace: "acorn",
+		},
+	}
+	Inject(spanCtx, app)
+	End(span, nil)
+
+	assert.NotEmpty(t, app.Annotations[labels.AcornTraceParent])
+
+	// The handler runs with a context that knows nothing of the trace, like the controller's.
+	err = Middleware(router.HandlerFunc(func(req router.Request, _ router.Response) error {
+		return nil
+	})).Handle(router.Request{
+		Ctx:       ctx,
+		Object:    app,
+		GVK:       schema.GroupVersionKind{Kind: "AppInstance"},
+		Namespace: app.Namespace,
+		Name:      app.Name,
+	}, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	if !assert.NoError(t, shutdown(ctx)) {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	create, reconcile := c.spans["create app"], c.spans["reconcile AppInstance"]
+	if !assert.NotNil(t, create) || !assert.NotNil(t, reconcile) {
+		return
+	}
+	// The reconcile is a trace of its own that links to the trace of the request that created the app
+	assert.NotEqual(t, create.TraceId, reconcile.TraceId)
+	assert.Empty(t, reconcile.ParentSpanId)
+	if assert.Len(t, reconcile.Links, 1) {
+		assert.Equal(t, create.TraceId, reconcile.Links[0].TraceId)
+		assert.Equal(t, create.SpanId, reconcile.Links[0].SpanId)
+	}
+
+	attrs := map[string]string{}
+	for _, attr := range reconcile.Attributes {
+		attrs[attr.Key] = attr.Value.GetStringValue()
+	}
+	assert.Equal(t, "app", attrs["acorn.name"])
+	assert.Regexp(t, `^tracing_test\.go:\d+$`, attrs["acorn.handler"])
+}
+
+func TestExtractWithoutAnnotation(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, ctx, Extract(ctx, &v1.AppInstance{}))
+}
@@ -0,0 +1,125 @@
+package usage
+
+import (
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// nearLimitPercent is the percentage of its memory limit a container has to use to be reported as near the limit
+const nearLimitPercent = 90
+
+var PodMetricsListGVK = schema.GroupVersionKind{Group: "metrics.k8s.io", Version: "v1beta1", Kind: "PodMetricsList"}
+
+// Containers is the CPU and memory used by each container of each pod, by pod name and then container name.
+type Containers map[string]map[string]corev1.ResourceList
+
+// NewPodMetricsList returns a list to read PodMetrics into. The metrics.k8s.io types are not in the scheme, so they are
+// read as unstructured objects.
+func NewPodMetricsList() *unstructured.UnstructuredList {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(PodMetricsListGVK)
+	return list
+}
+
+// IsUnavailable returns true if the error is because the metrics API is not installed or not serving.
+func IsUnavailable(err error) bool {
+	return meta.IsNoMatchError(err) || apierrors.IsNotFound(err) || apierrors.IsServiceUnavailable(err)
+}
+
+// FromPodMetrics returns the usage of the containers in a list of PodMetrics.
+func FromPodMetrics(list *unstructured.UnstructuredList) Containers {
+	result := Containers{}
+	for _, podMetrics := range list.Items {
+		containers, _, _ := unstructured.NestedSlice(podMetrics.Object, "containers")
+		for _, container := range containers {
+			container, ok := container.(map[string]any)
+			if !ok {
+				continue
+			}
+			name, _, _ := unstructured.NestedString(container, "name")
+			usage, _, _ := unstructured.NestedStringMap(container, "usage")
+
+			resources := corev1.ResourceList{}
+			for _, resourceName := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+				if q, err := resource.ParseQuantity(usage[string(resourceName)]); err == nil {
+					resources[resourceName] = q
+				}
+			}
+
+			if result[podMetrics.GetName()] == nil {
+				result[podMetrics.GetName()] = map[string]corev1.ResourceList{}
+			}
+			result[podMetrics.GetName()][name] = resources
+		}
+	}
+	return result
+}
+
+// ForContainer returns the usage of the named container of the pod. CPU and memory are only set if the container is in
+// the metrics, the memory limit and OOMKilled restarts come from the pod.
+func ForContainer(pod *corev1.Pod, containerName string, metrics Containers) v1.Usage {
+	var result v1.Usage
+
+	if resources, ok := metrics[pod.Name][containerName]; ok {
+		result.CPU = resources.Cpu().MilliValue()
+		result.Memory = resources.Memory().Value()
+	}
+
+	for _, container := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+		if container.Name == containerName {
+			if limit, ok := container.Resources.Limits[corev1.ResourceMemory]; ok {
+				result.MemoryLimit = limit.Value()
+			}
+			break
+		}
+	}
+	if result.MemoryLimit > 0 && result.Memory*100 >= result.MemoryLimit*nearLimitPercent {
+		result.NearMemoryLimit = true
+	}
+
+	for _, status := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+		if status.Name == containerName {
+			result.OOMKilledRestarts = oomKilledRestarts(status)
+			break
+		}
+	}
+
+	return result
+}
+
+// ForPod returns the combined usage of all the containers of the pod. The memory limits of the init containers are left
+// out, since they don't run alongside the containers.
+func ForPod(pod *corev1.Pod, metrics Containers) v1.Usage {
+	var result v1.Usage
+	for _, container := range pod.Spec.InitContainers {
+		containerUsage := ForContainer(pod, container.Name, metrics)
+		containerUsage.MemoryLimit = 0
+		result.Add(containerUsage)
+	}
+	for _, container := range pod.Spec.Containers {
+		result.Add(ForContainer(pod, container.Name, metrics))
+	}
+	return result
+}
+
+// oomKilledRestarts returns the restarts of the container if its last restart was OOMKilled, counting a container that
+// is currently terminated as one more restart. Kubernetes only keeps the reason of the last termination, so the earlier
+// restarts are not known to be OOMKilled.
+func oomKilledRestarts(status corev1.ContainerStatus) int32 {
+	if isOOMKilled(status.State) {
+		return status.RestartCount + 1
+	}
+	if isOOMKilled(status.LastTerminationState) {
+		return status.RestartCount
+	}
+	return 0
+}
+
+func isOOMKilled(state corev1.ContainerState) bool {
+	return state.Terminated != nil && state.Terminated.Reason == "OOMKilled"
+}
@@ -0,0 +1,118 @@
+package usage
+
+import (
+	"testing"
+
+	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestFromPodMetrics(t *testing.T) {
+	list := NewPodMetricsList()
+	list.Items = []unstructured.Unstructured{{
+		Object: map[string]any{
+			"metadata": map[string]any{
+				"name": "web-1234",
+			},
+			"containers": []any{
+				map[string]any{
+					"name": "web",
+					"usage": map[string]any{
+						"cpu":    "125000000n",
+						"memory": "100Mi",
+					},
+				},
+			},
+		},
+	}}
+
+	resources := FromPodMetrics(list)["web-1234"]["web"]
+	assert.Equal(t, int64(125), resources.Cpu().MilliValue())
+	assert.Equal(t, int64(100<<20), resources.Memory().Value())
+}
+
+func TestForPod(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-1234"},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{
+				{
+					Name: "migrate",
+					Resources: corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+					},
+				},
+			},
+			Containers: []corev1.Container{
+				{
+					Name: "web",
+					Resources: corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("100Mi")},
+					},
+				},
+				{
+					Name: "sidecar",
+				},
+			},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:         "web",
+					RestartCount: 2,
+					LastTerminationState: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled"},
+					},
+				},
+				{
+					Name:         "sidecar",
+					RestartCount: 1,
+					LastTerminationState: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{Reason: "Error"},
+					},
+				},
+			},
+		},
+	}
+	metrics := Containers{
+		"web-1234": {
+			"web": corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("100m"),
+				corev1.ResourceMemory: resource.MustParse("95Mi"),
+			},
+			"sidecar": corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("5m"),
+				corev1.ResourceMemory: resource.MustParse("10Mi"),
+			},
+		},
+	}
+
+	assert.Equal(t, v1.Usage{
+		CPU:               100,
+		Memory:            95 << 20,
+		MemoryLimit:       100 << 20,
+		NearMemoryLimit:   true,
+		OOMKilledRestarts: 2,
+	}, ForContainer(pod, "web", metrics))
+
+	// The limit of the init container doesn't count towards the limit of the pod
+	podUsage := ForPod(pod, metrics)
+	assert.Equal(t, v1.Usage{
+		CPU:               105,
+		Memory:            105 << 20,
+		MemoryLimit:       100 << 20,
+		NearMemoryLimit:   true,
+		OOMKilledRestarts: 2,
+	}, podUsage)
+	assert.True(t, podUsage.RepeatedlyOOMKilled())
+
+	// Without metrics only the limits and restarts from the pod are known
+	assert.Equal(t, v1.Usage{
+		MemoryLimit:       100 << 20,
+		OOMKilledRestarts: 2,
+	}, ForContainer(pod, "web", nil))
+}
@@ -10,6 +10,7 @@ import (
 	apiv1 "github.com/acorn-io/runtime/pkg/apis/api.acorn.io/v1"
 	v1 "github.com/acorn-io/runtime/pkg/apis/internal.acorn.io/v1"
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
 )
 
 func VCS(filePath, buildContextPath string) (result v1.VCS) {
@@ -65,6 +66,7 @@ func VCS(filePath, buildContextPath string) (result v1.VCS) {
 
 	result = v1.VCS{
 		Revision:     head.Hash().String(),
+		Branch:       branch(head),
 		Clean:        !modified && !untracked,
 		Modified:     modified,
 		Untracked:    untracked,
@@ -85,6 +87,37 @@ func VCS(filePath, buildContextPath string) (result v1.VCS) {
 	return
 }
 
+// Worktree returns the name of the directory of the git worktree containing path and the branch checked out in it.
+// Empty strings are returned if path is not in a git repository, and the branch is empty if HEAD is detached.
+func Worktree(path string) (name, branchName string) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return
+	}
+	repo, err := git.PlainOpenWithOptions(path, &git.PlainOpenOptions{
+		DetectDotGit: true,
+	})
+	if err != nil {
+		return
+	}
+	w, err := repo.Worktree()
+	if err != nil {
+		return
+	}
+	name = filepath.Base(w.Filesystem.Root())
+	if head, err := repo.Head(); err == nil {
+		branchName = branch(head)
+	}
+	return
+}
+
+func branch(head *plumbing.Reference) string {
+	if head.Name().IsBranch() {
+		return head.Name().Short()
+	}
+	return ""
+}
+
 func ImageInfoFromApp(ctx context.Context, app *apiv1.App, cloneDir string) (string, string, error) {
 	vcs := app.Status.AppImage.VCS
 	if len(vcs.Remotes) == 0 {
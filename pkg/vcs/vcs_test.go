@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -98,3 +100,37 @@ func TestVCS(t *testing.T) {
 		})
 	}
 }
+
+func TestWorktree(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "my-app")
+	repo, err := git.PlainInit(dir, false)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Acornfile"), []byte("containers: {}"), 0644))
+
+	w, err := repo.Worktree()
+	require.NoError(t, err)
+	_, err = w.Add("Acornfile")
+	require.NoError(t, err)
+	hash, err := w.Commit("initial", &git.CommitOptions{
+		Author: &object.Signature{Name: "test", Email: "test@example.com"},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, w.Checkout(&git.CheckoutOptions{
+		Branch: plumbing.NewBranchReferenceName("feature/login"),
+		Create: true,
+	}))
+	name, branch := Worktree(filepath.Join(dir, "Acornfile"))
+	assert.Equal(t, "my-app", name)
+	assert.Equal(t, "feature/login", branch)
+	assert.Equal(t, "feature/login", VCS(filepath.Join(dir, "Acornfile"), dir).Branch)
+
+	require.NoError(t, w.Checkout(&git.CheckoutOptions{Hash: hash}))
+	name, branch = Worktree(dir)
+	assert.Equal(t, "my-app", name)
+	assert.Equal(t, "", branch)
+
+	name, branch = Worktree(t.TempDir())
+	assert.Equal(t, "", name)
+	assert.Equal(t, "", branch)
+}
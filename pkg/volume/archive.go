@@ -0,0 +1,198 @@
+package volume
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+)
+
+// PushArchive pushes the content of dir as a single layer OCI artifact to ref.
+func PushArchive(ctx context.Context, dir, ref string, opts ...name.Option) error {
+	tag, err := name.NewTag(ref, opts...)
+	if err != nil {
+		return err
+	}
+
+	layer, err := tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+		r, w := io.Pipe()
+		go func() {
+			_ = w.CloseWithError(writeArchive(dir, w))
+		}()
+		return r, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	img, err := mutate.AppendLayers(empty.Image, layer)
+	if err != nil {
+		return err
+	}
+
+	return remote.Write(tag, mutate.MediaType(img, types.OCIManifestSchema1), remote.WithContext(ctx))
+}
+
+// PullArchive extracts the artifact pushed with PushArchive at ref into dir. Nothing is extracted if dir is not empty,
+// so restoring a volume that was already restored does not overwrite data written since.
+func PullArchive(ctx context.Context, ref, dir string, opts ...name.Option) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		// lost+found is created by mkfs on new volumes
+		if entry.Name() != "lost+found" {
+			return nil
+		}
+	}
+
+	parsed, err := name.ParseReference(ref, opts...)
+	if err != nil {
+		return err
+	}
+
+	img, err := remote.Image(parsed, remote.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	layers, err := img.Layers()
+	if err != nil {
+		return err
+	}
+	if len(layers) != 1 {
+		return fmt.Errorf("invalid volume archive %s, expected 1 layer, found %d", ref, len(layers))
+	}
+
+	r, err := layers[0].Uncompressed()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	return extractArchive(r, dir)
+}
+
+func writeArchive(dir string, out io.Writer) error {
+	tw := tar.NewWriter(out)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil || rel == "." {
+			return err
+		}
+
+		var link string
+		if info.Mode()&os.ModeSymlink != 0 {
+			if link, err = os.Readlink(path); err != nil {
+				return err
+			}
+		}
+
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(rel)
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+func extractArchive(in io.Reader, dir string) error {
+	dir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return err
+	}
+
+	tr := tar.NewReader(in)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		target := filepath.Join(dir, filepath.FromSlash(header.Name))
+		if !within(dir, target) {
+			return fmt.Errorf("invalid path %s in volume archive", header.Name)
+		}
+		// Don't follow symlinks extracted earlier out of dir
+		if parent, err := filepath.EvalSymlinks(filepath.Dir(target)); err == nil && !within(dir, parent) {
+			return fmt.Errorf("invalid path %s in volume archive", header.Name)
+		}
+
+		mode := os.FileMode(header.Mode).Perm()
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, mode); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if err := os.Symlink(header.Linkname, target); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := writeFile(target, mode, tr); err != nil {
+				return err
+			}
+		default:
+			continue
+		}
+
+		if err := os.Lchown(target, header.Uid, header.Gid); err != nil && !os.IsPermission(err) {
+			return err
+		}
+	}
+}
+
+func within(dir, path string) bool {
+	dir = filepath.Clean(dir)
+	return path == dir || strings.HasPrefix(path, dir+string(filepath.Separator))
+}
+
+func writeFile(path string, mode os.FileMode, content io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, content); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,53 @@
+package volume
+
+import (
+	"archive/tar"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveRoundTrip(t *testing.T) {
+	src := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "sub"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "sub", "file"), []byte("content"), 0o600))
+	require.NoError(t, os.Symlink("sub/file", filepath.Join(src, "link")))
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, writeArchive(src, buf))
+
+	dst := t.TempDir()
+	require.NoError(t, extractArchive(buf, dst))
+
+	data, err := os.ReadFile(filepath.Join(dst, "sub", "file"))
+	require.NoError(t, err)
+	assert.Equal(t, "content", string(data))
+
+	info, err := os.Stat(filepath.Join(dst, "sub", "file"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	link, err := os.Readlink(filepath.Join(dst, "link"))
+	require.NoError(t, err)
+	assert.Equal(t, "sub/file", link)
+}
+
+func TestExtractArchiveOutsideDir(t *testing.T) {
+	for _, entries := range [][]*tar.Header{
+		{{Name: "../escape", Typeflag: tar.TypeReg, Mode: 0o644}},
+		{{Name: "link", Typeflag: tar.TypeSymlink, Linkname: "/tmp"}, {Name: "link/escape", Typeflag: tar.TypeReg, Mode: 0o644}},
+	} {
+		buf := &bytes.Buffer{}
+		tw := tar.NewWriter(buf)
+		for _, header := range entries {
+			require.NoError(t, tw.WriteHeader(header))
+		}
+		require.NoError(t, tw.Close())
+
+		assert.Error(t, extractArchive(buf, t.TempDir()))
+	}
+}
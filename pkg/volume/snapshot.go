@@ -0,0 +1,175 @@
+package volume
+
+import (
+	"context"
+
+	"github.com/acorn-io/baaah/pkg/uncached"
+	"github.com/acorn-io/runtime/pkg/system"
+	"github.com/acorn-io/z"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	SnapshotAPIGroup = "snapshot.storage.k8s.io"
+	// ArchivePath is where the volume is mounted in the jobs that archive and restore volume snapshots
+	ArchivePath = "/data"
+
+	defaultSnapshotClassAnnotation = "snapshot.storage.kubernetes.io/is-default-class"
+)
+
+var (
+	VolumeSnapshotGVK          = schema.GroupVersionKind{Group: SnapshotAPIGroup, Version: "v1", Kind: "VolumeSnapshot"}
+	VolumeSnapshotContentGVK   = schema.GroupVersionKind{Group: SnapshotAPIGroup, Version: "v1", Kind: "VolumeSnapshotContent"}
+	VolumeSnapshotClassListGVK = schema.GroupVersionKind{Group: SnapshotAPIGroup, Version: "v1", Kind: "VolumeSnapshotClassList"}
+)
+
+// SnapshotClassForDriver returns the name of the VolumeSnapshotClass for the CSI driver. The default class is preferred
+// if there is more than one. An empty string is returned if there is no class for the driver or the snapshot CRDs are not
+// installed in the cluster.
+func SnapshotClassForDriver(ctx context.Context, c client.Reader, driver string) (string, error) {
+	classes := &unstructured.UnstructuredList{}
+	classes.SetGroupVersionKind(VolumeSnapshotClassListGVK)
+	if err := c.List(ctx, uncached.List(classes)); meta.IsNoMatchError(err) || apierrors.IsNotFound(err) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	var result string
+	for _, class := range classes.Items {
+		if d, _, _ := unstructured.NestedString(class.Object, "driver"); d != driver {
+			continue
+		}
+		if class.GetAnnotations()[defaultSnapshotClassAnnotation] == "true" {
+			return class.GetName(), nil
+		}
+		if result == "" {
+			result = class.GetName()
+		}
+	}
+	return result, nil
+}
+
+// NewVolumeSnapshot returns a CSI VolumeSnapshot of the PersistentVolumeClaim pvcName.
+func NewVolumeSnapshot(namespace, name, snapshotClass, pvcName string, objLabels map[string]string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{
+		Object: map[string]any{
+			"spec": map[string]any{
+				"volumeSnapshotClassName": snapshotClass,
+				"source": map[string]any{
+					"persistentVolumeClaimName": pvcName,
+				},
+			},
+		},
+	}
+	obj.SetGroupVersionKind(VolumeSnapshotGVK)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	obj.SetLabels(objLabels)
+	return obj
+}
+
+// NewPreProvisionedVolumeSnapshot returns a CSI VolumeSnapshot and the VolumeSnapshotContent it is bound to for an
+// existing snapshot in the CSI driver. The content retains the snapshot when it is deleted, because the snapshot is
+// still owned by the VolumeSnapshot it was originally taken with.
+func NewPreProvisionedVolumeSnapshot(namespace, name, contentName, driver, snapshotHandle string, objLabels map[string]string) (*unstructured.Unstructured, *unstructured.Unstructured) {
+	content := &unstructured.Unstructured{
+		Object: map[string]any{
+			"spec": map[string]any{
+				"deletionPolicy": "Retain",
+				"driver":         driver,
+				"source": map[string]any{
+					"snapshotHandle": snapshotHandle,
+				},
+				"volumeSnapshotRef": map[string]any{
+					"name":      name,
+					"namespace": namespace,
+				},
+			},
+		},
+	}
+	content.SetGroupVersionKind(VolumeSnapshotContentGVK)
+	content.SetName(contentName)
+	content.SetLabels(objLabels)
+
+	snapshot := &unstructured.Unstructured{
+		Object: map[string]any{
+			"spec": map[string]any{
+				"source": map[string]any{
+					"volumeSnapshotContentName": contentName,
+				},
+			},
+		},
+	}
+	snapshot.SetGroupVersionKind(VolumeSnapshotGVK)
+	snapshot.SetNamespace(namespace)
+	snapshot.SetName(name)
+	snapshot.SetLabels(objLabels)
+
+	return snapshot, content
+}
+
+// SnapshotDataSource returns the data source of a PersistentVolumeClaim restored from the CSI VolumeSnapshot name.
+func SnapshotDataSource(name string) *corev1.TypedLocalObjectReference {
+	return &corev1.TypedLocalObjectReference{
+		APIGroup: z.Pointer(SnapshotAPIGroup),
+		Kind:     VolumeSnapshotGVK.Kind,
+		Name:     name,
+	}
+}
+
+// NewArchiveJob returns a job that runs "acorn volume archive" with args against the PersistentVolumeClaim pvcName
+// mounted at ArchivePath.
+func NewArchiveJob(namespace, name, pvcName string, readOnly bool, objLabels map[string]string, args ...string) *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    objLabels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: z.Pointer[int32](3),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: objLabels,
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy:                corev1.RestartPolicyNever,
+					AutomountServiceAccountToken: z.Pointer(false),
+					Containers: []corev1.Container{
+						{
+							Name:    "archive",
+							Image:   system.DefaultImage(),
+							Command: append([]string{"acorn", "volume", "archive"}, args...),
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "data",
+									MountPath: ArchivePath,
+									ReadOnly:  readOnly,
+								},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "data",
+							VolumeSource: corev1.VolumeSource{
+								PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+									ClaimName: pvcName,
+									ReadOnly:  readOnly,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -151,6 +151,27 @@ func getVolumeClassNames(volumeClasses map[string]adminv1.ProjectVolumeClassInst
 	return typed.SortedKeys(storageClassName)
 }
 
+// IsStateful returns whether the container mounts a persistent volume that only one node can mount at a time. Such
+// containers run as StatefulSets.
+func IsStateful(volumes map[string]v1.VolumeRequest, container v1.Container) bool {
+	for _, dir := range container.Dirs {
+		if dir.Secret.Name != "" {
+			continue
+		}
+		for volName, vol := range volumes {
+			if vol.Class == "ephemeral" {
+				continue
+			}
+			if dir.Volume == volName {
+				if len(vol.AccessModes) == 0 || (len(vol.AccessModes) == 1 && vol.AccessModes[0] == v1.AccessModeReadWriteOnce) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
 func CopyVolumeDefaults(volumeRequest v1.VolumeRequest, volumeBinding v1.VolumeBinding, volumeDefaults v1.VolumeDefault) v1.VolumeRequest {
 	bind := volumeBinding.Volume != ""
 	if volumeBinding.Class != "" {